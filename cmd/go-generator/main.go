@@ -1,20 +1,36 @@
 package main
 
 import (
-	"fmt"
 	"github.com/spf13/cobra"
+	cmdAdd "github.com/wilian746/go-generator/internal/commands/add"
+	cmdClient "github.com/wilian746/go-generator/internal/commands/client"
+	cmdCollection "github.com/wilian746/go-generator/internal/commands/collection"
+	cmdDocs "github.com/wilian746/go-generator/internal/commands/docs"
+	cmdDoctor "github.com/wilian746/go-generator/internal/commands/doctor"
+	cmdERD "github.com/wilian746/go-generator/internal/commands/erd"
 	cmdHelp "github.com/wilian746/go-generator/internal/commands/help"
 	cmdInit "github.com/wilian746/go-generator/internal/commands/init"
+	cmdLintArch "github.com/wilian746/go-generator/internal/commands/lintarch"
+	cmdList "github.com/wilian746/go-generator/internal/commands/list"
+	cmdRemove "github.com/wilian746/go-generator/internal/commands/remove"
+	cmdTypeScript "github.com/wilian746/go-generator/internal/commands/typescript"
 	cmdVersion "github.com/wilian746/go-generator/internal/commands/version"
 	"github.com/wilian746/go-generator/internal/utils/logger"
 	"github.com/wilian746/go-generator/internal/utils/prompt"
 	"os"
 )
 
+var quiet bool
+var verbosity int
+
 var rootCmd = &cobra.Command{
-	Use: "go-generator",
+	Use:   "go-generator",
+	Short: "GO Generator is an command line interface to create your API using some databases more facility.",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		logger.SetLevel(logger.GetLevelByFlags(quiet, verbosity))
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		logger.PRINT("GO Generator is an command line interface to create your API using some databases more facility.")
+		logger.PRINT(cmd.Short)
 		logger.PRINT("")
 		logger.PRINT("Welcome to the go-generator. Use the `go-generator help` command to view the available commands.")
 		return nil
@@ -26,14 +42,27 @@ func init() {
 	rootCmd.SetUsageFunc(func(command *cobra.Command) error {
 		return nil
 	})
+	rootCmd.SilenceErrors = true
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Show only errors")
+	rootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "Show more details, use -vv to show debug logs")
 	rootCmd.AddCommand(cmdInit.NewInitCommand(prompt.NewPrompt()).Cmd())
+	rootCmd.AddCommand(cmdList.NewListCommand().CmdList())
 	rootCmd.AddCommand(cmdVersion.NewVersionCommand().CmdVersion())
 	rootCmd.AddCommand(cmdHelp.NewHelpCommand(rootCmd).CmdHelp())
+	rootCmd.AddCommand(cmdDocs.NewDocsCommand(rootCmd).CmdDocs())
+	rootCmd.AddCommand(cmdDoctor.NewDoctorCommand().CmdDoctor())
+	rootCmd.AddCommand(cmdClient.NewClientCommand().CmdClient())
+	rootCmd.AddCommand(cmdTypeScript.NewTypeScriptCommand().CmdTypeScript())
+	rootCmd.AddCommand(cmdCollection.NewCollectionCommand().CmdCollection())
+	rootCmd.AddCommand(cmdERD.NewERDCommand().CmdERD())
+	rootCmd.AddCommand(cmdLintArch.NewLintArchCommand().CmdLintArch())
+	rootCmd.AddCommand(cmdRemove.NewRemoveCommand(prompt.NewPrompt()).CmdRemove())
+	rootCmd.AddCommand(cmdAdd.NewAddCommand().CmdAdd())
 }
 
 func main() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		logger.ERROR("Error on execute command", err)
 		os.Exit(1)
 	}
 }
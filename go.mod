@@ -5,30 +5,42 @@ go 1.14
 require (
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
 	github.com/asaskevich/govalidator v0.0.0-20200428143746-21a406dcc535 // indirect
-	github.com/denisenkom/go-mssqldb v0.0.0-20200428022330-06a60b6afbbc // indirect
+	github.com/cpuguy83/go-md2man/v2 v2.0.0 // indirect
+	github.com/denisenkom/go-mssqldb v0.0.0-20200428022330-06a60b6afbbc
+	github.com/gin-gonic/gin v1.6.3
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.1.1
 	github.com/go-openapi/spec v0.19.8 // indirect
 	github.com/go-openapi/swag v0.19.9 // indirect
 	github.com/go-ozzo/ozzo-validation/v4 v4.2.1
-	github.com/go-sql-driver/mysql v1.5.0 // indirect
-	github.com/google/uuid v1.1.1
+	github.com/go-sql-driver/mysql v1.5.0
+	github.com/golang/protobuf v1.4.3
+	github.com/google/uuid v1.1.2
+	github.com/graph-gophers/graphql-go v1.0.0
 	github.com/jedib0t/go-pretty/v6 v6.0.4
 	github.com/jinzhu/gorm v1.9.12
-	github.com/lib/pq v1.7.0 // indirect
+	github.com/labstack/echo/v4 v4.1.17
+	github.com/lib/pq v1.7.0
 	github.com/lunixbochs/vtclean v1.0.0 // indirect
 	github.com/mailru/easyjson v0.7.1 // indirect
 	github.com/manifoldco/promptui v0.7.0
-	github.com/mattn/go-colorable v0.1.6 // indirect
+	github.com/mattn/go-isatty v0.0.12
+	github.com/mattn/go-sqlite3 v2.0.1+incompatible
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/stretchr/testify v1.6.1
 	github.com/swaggo/http-swagger v0.0.0-20200308142732-58ac5e232fba
 	github.com/swaggo/swag v1.6.7
-	golang.org/x/crypto v0.0.0-20200604202706-70a84ac30bf9 // indirect
-	golang.org/x/net v0.0.0-20200602114024-627f9648deb9 // indirect
-	golang.org/x/sys v0.0.0-20200610111108-226ff32320da // indirect
+	go.etcd.io/bbolt v1.3.5
+	golang.org/x/mod v0.3.0
 	golang.org/x/tools v0.0.0-20200612220849-54c614fe050c // indirect
+	google.golang.org/grpc v1.35.0
+	google.golang.org/protobuf v1.25.0
 	gopkg.in/yaml.v2 v2.3.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20200605160147-a5ece683394c // indirect
+	gorm.io/driver/mysql v1.0.4
+	gorm.io/driver/postgres v1.0.8
+	gorm.io/driver/sqlite v1.1.4
+	gorm.io/driver/sqlserver v1.0.6
+	gorm.io/gorm v1.20.12
 )
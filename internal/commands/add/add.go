@@ -0,0 +1,76 @@
+package add
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	ControllerAdd "github.com/wilian746/go-generator/internal/controllers/add"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/middlewares"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"strings"
+)
+
+type IAdd interface {
+	CmdAdd() *cobra.Command
+}
+
+type Add struct {
+	controller ControllerAdd.Interface
+	template   string
+}
+
+func NewAddCommand() IAdd {
+	return &Add{
+		controller: ControllerAdd.NewAdd(),
+	}
+}
+
+func (a *Add) CmdAdd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add",
+		Short:   "Add parts to a project generated",
+		Example: "go-generator add middleware auth ./my-project --template auth",
+	}
+	cmd.AddCommand(a.cmdMiddleware())
+	return cmd
+}
+
+func (a *Add) cmdMiddleware() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "middleware [NAME] [PATH]",
+		Short: "Add a middleware to the routes of a project generated",
+		Long: "Create the package of the middleware in internal/middlewares with a wrapper of http.Handler and your " +
+			"test, and enable it in the setConfigsRouters of the Router of internal/routes with a method EnableX",
+		Example: "go-generator add middleware rate-limit ./my-project --template ratelimit",
+		Args:    cobra.RangeArgs(1, 2),
+		Annotations: map[string]string{
+			"NAME": "Name of the middleware, like auth or rate_limit",
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 2 {
+				pathProject = args[1]
+			}
+			return a.addMiddleware(pathProject, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&a.template, "template", middlewares.Empty.String(),
+		"Template of the middleware: "+strings.Join(middlewares.ValuesNames(), ", "))
+	return cmd
+}
+
+func (a *Add) addMiddleware(pathProject, name string) error {
+	if !middlewares.Valid(a.template) {
+		return errors.ErrAddMiddlewareTemplateInvalid
+	}
+	files, err := a.controller.AddMiddleware(pathProject, name, middlewares.ValueOf(a.template))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		logger.INFO("Created or updated: " + file)
+	}
+	logger.INFO(fmt.Sprintf("Middleware %s added with success", name))
+	return nil
+}
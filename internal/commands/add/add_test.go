@@ -0,0 +1,30 @@
+package add
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"testing"
+)
+
+func TestAddCommand_Execute(t *testing.T) {
+	t.Run("Should have the subcommand middleware", func(t *testing.T) {
+		cobraCmd := NewAddCommand().CmdAdd()
+		middleware, _, err := cobraCmd.Find([]string{"middleware"})
+		assert.NoError(t, err)
+		assert.Equal(t, "middleware", middleware.Name())
+		assert.Equal(t, "empty", middleware.Flags().Lookup("template").DefValue)
+	})
+	t.Run("Should return error when template is invalid", func(t *testing.T) {
+		cobraCmd := NewAddCommand().CmdAdd()
+		middleware, _, _ := cobraCmd.Find([]string{"middleware"})
+		assert.NoError(t, middleware.Flags().Set("template", "jwt"))
+		err := middleware.RunE(middleware, []string{"auth", "../../../pkg/standart-gorm"})
+		assert.Equal(t, errors.ErrAddMiddlewareTemplateInvalid, err)
+	})
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		cobraCmd := NewAddCommand().CmdAdd()
+		middleware, _, _ := cobraCmd.Find([]string{"middleware"})
+		err := middleware.RunE(middleware, []string{"auth", "../../../pkg/standart-gorm"})
+		assert.Equal(t, errors.ErrAddModuleNotFound, err)
+	})
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	ControllerClient "github.com/wilian746/go-generator/internal/controllers/client"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"path/filepath"
+)
+
+type IClient interface {
+	CmdClient() *cobra.Command
+}
+
+type Client struct {
+	controller ControllerClient.Interface
+}
+
+func NewClientCommand() IClient {
+	return &Client{
+		controller: ControllerClient.NewClient(),
+	}
+}
+
+func (c *Client) CmdClient() *cobra.Command {
+	return &cobra.Command{
+		Use:   "client [PATH]",
+		Short: "Generate the client in go of the API of a project generated by go-generator",
+		Long: "Generate the package pkg/client with one method typed by route of each resource, errors typed by " +
+			"status 400, 404 and 500, context, retries and tests with httptest",
+		Example: "go-generator client ./my-project",
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 1 {
+				pathProject = args[0]
+			}
+			return c.generate(pathProject)
+		},
+	}
+}
+
+func (c *Client) generate(pathProject string) error {
+	generated, err := c.controller.Generate(pathProject)
+	if err != nil {
+		return err
+	}
+	for _, file := range generated {
+		logger.INFO("File generated with success: " + filepath.Join(pathProject, file))
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestClientCommand_Execute(t *testing.T) {
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "client")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewClientCommand().CmdClient()
+		assert.Equal(t, errors.ErrClientModuleNotFound, cobraCmd.RunE(cobraCmd, []string{dir}))
+	})
+}
@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"github.com/spf13/cobra"
+	ControllerCollection "github.com/wilian746/go-generator/internal/controllers/collection"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"path/filepath"
+)
+
+type ICollection interface {
+	CmdCollection() *cobra.Command
+}
+
+type Collection struct {
+	controller ControllerCollection.Interface
+	out        string
+}
+
+func NewCollectionCommand() ICollection {
+	return &Collection{
+		controller: ControllerCollection.NewCollection(),
+	}
+}
+
+func (c *Collection) CmdCollection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection [PATH]",
+		Short: "Generate the requests of the API to test by hand from the swagger docs of a project generated",
+		Long: "Generate a .http file of the REST clients of the editors and a collection of postman v2.1, " +
+			"with the bodies from the Rules.GetMock of each resource and the files of the variables host, port " +
+			"and basePath, the IDs of the routes are variables set by the response of the create",
+		Example: "go-generator collection ./my-project --out ./my-project/collections",
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 1 {
+				pathProject = args[0]
+			}
+			return c.generate(pathProject)
+		},
+	}
+	cmd.Flags().StringVar(&c.out, "out", "collections",
+		"Directory of the files generated, the relative path is from the [PATH] of the project")
+	return cmd
+}
+
+func (c *Collection) generate(pathProject string) error {
+	out := c.out
+	if !filepath.IsAbs(out) {
+		out = filepath.Join(pathProject, out)
+	}
+	generated, err := c.controller.Generate(pathProject, out)
+	if err != nil {
+		return err
+	}
+	for _, file := range generated {
+		logger.INFO("File generated with success: " + file)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package collection
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCollectionCommand_Execute(t *testing.T) {
+	t.Run("Should return error when project not have swagger docs", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "collection")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewCollectionCommand().CmdCollection()
+		assert.Equal(t, errors.ErrCollectionSwaggerNotFound, cobraCmd.RunE(cobraCmd, []string{dir}))
+	})
+}
@@ -0,0 +1,68 @@
+package docs
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"os"
+)
+
+const (
+	FormatMarkdown = "markdown"
+	FormatMan      = "man"
+)
+
+type IDocs interface {
+	CmdDocs() *cobra.Command
+}
+
+type Docs struct {
+	rootCmd *cobra.Command
+	format  string
+	out     string
+}
+
+func NewDocsCommand(rootCmd *cobra.Command) IDocs {
+	return &Docs{
+		rootCmd: rootCmd,
+	}
+}
+
+func (d *Docs) CmdDocs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "docs",
+		Short:   "Generate the reference docs of all commands",
+		Example: "go-generator docs --format markdown --out ./docs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return d.generate()
+		},
+	}
+	cmd.Flags().StringVar(&d.format, "format", FormatMarkdown, "Format of the docs: markdown or man")
+	cmd.Flags().StringVar(&d.out, "out", "docs", "Directory where the docs will be generated")
+	return cmd
+}
+
+func (d *Docs) generate() error {
+	if d.format != FormatMarkdown && d.format != FormatMan {
+		return errors.ErrDocsFormatInvalid
+	}
+	if err := os.MkdirAll(d.out, os.ModePerm); err != nil {
+		return err
+	}
+	d.rootCmd.DisableAutoGenTag = true
+	if err := d.factoryGenerate(); err != nil {
+		return err
+	}
+	logger.INFO("Docs generated with success in: " + d.out)
+	return nil
+}
+
+func (d *Docs) factoryGenerate() error {
+	switch d.format {
+	case FormatMan:
+		return doc.GenManTree(d.rootCmd, &doc.GenManHeader{Title: "GO-GENERATOR", Section: "1"}, d.out)
+	default:
+		return doc.GenMarkdownTree(d.rootCmd, d.out)
+	}
+}
@@ -0,0 +1,45 @@
+package docs
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{Use: "go-generator", Short: "Generator"}
+	root.AddCommand(&cobra.Command{Use: "test", Short: "Test", Run: func(*cobra.Command, []string) {}})
+	return root
+}
+
+func TestDocsCommand_Execute(t *testing.T) {
+	t.Run("Should generate markdown docs of all commands", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "docs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewDocsCommand(newRootCommand()).CmdDocs()
+		assert.NoError(t, cobraCmd.Flags().Set("out", dir))
+		assert.NoError(t, cobraCmd.RunE(cobraCmd, []string{}))
+		assert.FileExists(t, filepath.Join(dir, "go-generator.md"))
+		assert.FileExists(t, filepath.Join(dir, "go-generator_test.md"))
+	})
+	t.Run("Should generate man docs of all commands", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "docs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewDocsCommand(newRootCommand()).CmdDocs()
+		assert.NoError(t, cobraCmd.Flags().Set("out", dir))
+		assert.NoError(t, cobraCmd.Flags().Set("format", "man"))
+		assert.NoError(t, cobraCmd.RunE(cobraCmd, []string{}))
+		assert.FileExists(t, filepath.Join(dir, "go-generator-test.1"))
+	})
+	t.Run("Should return error when format is invalid", func(t *testing.T) {
+		cobraCmd := NewDocsCommand(newRootCommand()).CmdDocs()
+		assert.NoError(t, cobraCmd.Flags().Set("format", "pdf"))
+		assert.Equal(t, errors.ErrDocsFormatInvalid, cobraCmd.RunE(cobraCmd, []string{}))
+	})
+}
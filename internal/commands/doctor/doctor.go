@@ -0,0 +1,75 @@
+package doctor
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	ControllerDoctor "github.com/wilian746/go-generator/internal/controllers/doctor"
+	EntitiesDoctor "github.com/wilian746/go-generator/internal/entities/doctor"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"os"
+)
+
+type IDoctor interface {
+	CmdDoctor() *cobra.Command
+}
+
+type Doctor struct {
+	controller ControllerDoctor.Interface
+}
+
+func NewDoctorCommand() IDoctor {
+	return &Doctor{
+		controller: ControllerDoctor.NewDoctor(),
+	}
+}
+
+func (d *Doctor) CmdDoctor() *cobra.Command {
+	return &cobra.Command{
+		Use:     "doctor [PATH]",
+		Short:   "Check if a project generated by go-generator is consistent",
+		Long:    "Check folders, files, module, migrations, routes and swagger docs of a project generated",
+		Example: "go-generator doctor ./my-project",
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 1 {
+				pathProject = args[0]
+			}
+			return d.printDiagnostics(d.controller.Diagnose(pathProject))
+		},
+	}
+}
+
+func (d *Doctor) printDiagnostics(diagnostics []EntitiesDoctor.Diagnostic) error {
+	logTable := table.NewWriter()
+	logTable.SetOutputMirror(os.Stdout)
+	logTable.AppendHeader(table.Row{"Check", "Status", "Problem", "Fix"})
+	for _, check := range ControllerDoctor.Checks() {
+		d.appendCheckRows(logTable, check, diagnostics)
+	}
+	logger.PRINT("Diagnostics:")
+	logTable.Render()
+	if len(diagnostics) > 0 {
+		return errors.ErrDoctorFoundProblems
+	}
+	logger.INFO("No problems found in the project")
+	return nil
+}
+
+func (d *Doctor) appendCheckRows(logTable table.Writer, check string, diagnostics []EntitiesDoctor.Diagnostic) {
+	status := "OK"
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Check == check {
+			status = "FAIL"
+			logTable.AppendRow(table.Row{check, status, diagnostic.Problem, diagnostic.Fix})
+		}
+	}
+	if status == "OK" {
+		logTable.AppendRow(table.Row{check, status, "", ""})
+	}
+	logTable.AppendSeparator()
+}
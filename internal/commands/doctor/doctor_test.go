@@ -0,0 +1,19 @@
+package doctor
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestDoctorCommand_Execute(t *testing.T) {
+	t.Run("Should return error when project has problems", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "doctor")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewDoctorCommand().CmdDoctor()
+		assert.Equal(t, errors.ErrDoctorFoundProblems, cobraCmd.RunE(cobraCmd, []string{dir}))
+	})
+}
@@ -0,0 +1,99 @@
+package erd
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	ControllerERD "github.com/wilian746/go-generator/internal/controllers/erd"
+	"github.com/wilian746/go-generator/internal/enums/diagrams"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+type IERD interface {
+	CmdERD() *cobra.Command
+}
+
+type ERD struct {
+	controller ControllerERD.Interface
+	format     string
+	out        string
+	readme     bool
+}
+
+func NewERDCommand() IERD {
+	return &ERD{
+		controller: ControllerERD.NewERD(),
+	}
+}
+
+func (e *ERD) CmdERD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erd [PATH]",
+		Short: "Generate the entity relationship diagram of the tables of a project generated",
+		Long: "Generate the diagram of the tables, columns and relationships of the entities with the method " +
+			"TableName, the relationships come from the tags gorm foreignkey and association_foreignkey and from " +
+			"the tags sql REFERENCES",
+		Example: "go-generator erd ./my-project --format mermaid --readme",
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 1 {
+				pathProject = args[0]
+			}
+			return e.generate(cmd, pathProject)
+		},
+	}
+	cmd.Flags().StringVar(&e.format, "format", diagrams.Mermaid.String(),
+		"Format of the diagram: mermaid, dot or plantuml")
+	cmd.Flags().StringVar(&e.out, "out", "",
+		"File of the diagram generated, the relative path is from the [PATH] of the project, "+
+			"empty print the diagram")
+	cmd.Flags().BoolVar(&e.readme, "readme", false,
+		"Replace the diagram of the README.md of the project, the section is added when not found")
+	return cmd
+}
+
+func (e *ERD) generate(cmd *cobra.Command, pathProject string) error {
+	if !diagrams.Valid(e.format) {
+		return errors.ErrERDFormatInvalid
+	}
+	format := diagrams.ValueOf(e.format)
+	diagram, err := e.controller.Generate(pathProject, format)
+	if err != nil {
+		return err
+	}
+	if e.readme {
+		if err := e.controller.UpdateReadme(pathProject, format, diagram); err != nil {
+			return err
+		}
+		logger.INFO("Diagram updated with success in: " + filepath.Join(pathProject, ControllerERD.ReadmeFile))
+	}
+	if e.out != "" {
+		return e.write(pathProject, diagram)
+	}
+	if !e.readme {
+		_, err = fmt.Fprint(cmd.OutOrStdout(), diagram)
+	}
+	return err
+}
+
+func (e *ERD) write(pathProject, diagram string) error {
+	out := e.out
+	if !filepath.IsAbs(out) {
+		out = filepath.Join(pathProject, out)
+	}
+	if err := os.MkdirAll(filepath.Dir(out), os.ModePerm); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(out, []byte(diagram), 0600); err != nil {
+		return err
+	}
+	logger.INFO("Diagram generated with success in: " + out)
+	return nil
+}
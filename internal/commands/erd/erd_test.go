@@ -0,0 +1,24 @@
+package erd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestERDCommand_Execute(t *testing.T) {
+	t.Run("Should return error when format is invalid", func(t *testing.T) {
+		cobraCmd := NewERDCommand().CmdERD()
+		assert.NoError(t, cobraCmd.Flags().Set("format", "svg"))
+		assert.Equal(t, errors.ErrERDFormatInvalid, cobraCmd.RunE(cobraCmd, []string{"."}))
+	})
+	t.Run("Should return error when project not have tables", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "erd")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewERDCommand().CmdERD()
+		assert.Equal(t, errors.ErrERDTablesNotFound, cobraCmd.RunE(cobraCmd, []string{dir}))
+	})
+}
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
+	"github.com/wilian746/go-generator/internal/enums/errors"
 	"github.com/wilian746/go-generator/internal/enums/globals"
 	"github.com/wilian746/go-generator/internal/utils/logger"
 	"os"
+	"regexp"
 	"strings"
 )
 
+var argsRegex = regexp.MustCompile(`\[([A-Z_]+)\]`)
+
 type IHelp interface {
 	CmdHelp() *cobra.Command
 }
@@ -29,13 +33,16 @@ func NewHelpCommand(rootCmd *cobra.Command, infos ...string) IHelp {
 
 func (h *Help) CmdHelp() *cobra.Command {
 	return &cobra.Command{
-		Use:     "help",
+		Use:     "help [COMMAND]",
 		Short:   "Help about any command",
-		Example: "go-generator help",
+		Example: "go-generator help\ngo-generator help init",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return h.printCommandHelp(args)
+			}
 			h.printHeader()
 			h.printAvailableCommands()
-			h.printAvailableFlags()
+			h.printAvailableFlags(h.rootCmd)
 			h.printAdditionalInformation()
 			return nil
 		},
@@ -46,15 +53,26 @@ func (h *Help) printHeader() {
 	logHeader := fmt.Sprintf(`
 %s
 Usage:
-	go-generator init [REPOSITORY] [GENERATE_TYPE]
+	go-generator [COMMAND] [ARGS] [FLAGS]
 
 Examples:
-	go-generator init gorm app
-`, globals.GoGeneratorHeader)
+	%s
+
+Use "go-generator help [COMMAND]" to more information about a command.
+`, globals.GoGeneratorHeader, strings.Join(h.getExamples(), "\n\t"))
 
 	logger.PRINT(logHeader)
 }
 
+func (h *Help) getExamples() (examples []string) {
+	for _, command := range h.rootCmd.Commands() {
+		if command.Example != "" {
+			examples = append(examples, strings.Split(command.Example, "\n")...)
+		}
+	}
+	return examples
+}
+
 func (h *Help) printAvailableCommands() {
 	logTable := table.NewWriter()
 	logTable.SetOutputMirror(os.Stdout)
@@ -68,8 +86,8 @@ func (h *Help) addTableLogToCommands(logTable table.Writer) table.Writer {
 	logTable.AppendHeader(table.Row{"Command", "Example", "Description"})
 	availableCommands := []string{}
 	for _, command := range h.rootCmd.Commands() {
-		availableCommands = append(availableCommands, command.Name())
-		if len(availableCommands) == 0 || !h.checkIfExistCommandAvailableInList(availableCommands, command) {
+		if !h.checkIfExistCommandAvailableInList(availableCommands, command) {
+			availableCommands = append(availableCommands, command.Name())
 			logTable.AppendRow(table.Row{command.Name(), command.Example, command.Short})
 			logTable.AppendSeparator()
 		}
@@ -86,8 +104,47 @@ func (h *Help) checkIfExistCommandAvailableInList(availableCommands []string, co
 	return false
 }
 
-func (h *Help) printAvailableFlags() {
-	flags := h.rootCmd.Flags().FlagUsages()
+func (h *Help) printCommandHelp(args []string) error {
+	command, _, err := h.rootCmd.Find(args)
+	if err != nil || command == h.rootCmd {
+		return errors.ErrHelpCommandNotFound
+	}
+	description := command.Long
+	if description == "" {
+		description = command.Short
+	}
+	logger.PRINT(fmt.Sprintf(`
+%s
+
+Usage:
+	%s
+
+Examples:
+	%s
+`, description, command.UseLine(), strings.ReplaceAll(command.Example, "\n", "\n\t")))
+	h.printCommandArgs(command)
+	h.printAvailableFlags(command)
+	return nil
+}
+
+func (h *Help) printCommandArgs(command *cobra.Command) {
+	args := argsRegex.FindAllStringSubmatch(command.Use, -1)
+	if len(args) == 0 {
+		return
+	}
+	logTable := table.NewWriter()
+	logTable.SetOutputMirror(os.Stdout)
+	logTable.AppendHeader(table.Row{"Arg", "Values"})
+	for _, arg := range args {
+		logTable.AppendRow(table.Row{arg[1], command.Annotations[arg[1]]})
+		logTable.AppendSeparator()
+	}
+	logger.PRINT("Available Args:")
+	logTable.Render()
+}
+
+func (h *Help) printAvailableFlags(command *cobra.Command) {
+	flags := command.LocalFlags().FlagUsages() + command.InheritedFlags().FlagUsages()
 	allFlags := strings.Split(flags, "\n")
 	if len(allFlags) == 1 && strings.TrimSpace(allFlags[0]) == "" {
 		return
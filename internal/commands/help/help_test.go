@@ -1,11 +1,27 @@
 package help
 
 import (
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
 	"testing"
 )
 
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{Use: "go-generator"}
+	test := &cobra.Command{
+		Use:         "test [NAME]",
+		Short:       "Test command",
+		Example:     "go-generator test example",
+		Annotations: map[string]string{"NAME": "example"},
+		Run:         func(*cobra.Command, []string) {},
+	}
+	test.Flags().Bool("force", false, "Force test")
+	root.AddCommand(test)
+	return root
+}
+
 func TestVersionCommand_Execute(t *testing.T) {
 	t.Run("Should execute command exec without error", func(t *testing.T) {
 		assert.NotPanics(t, func() {
@@ -15,4 +31,24 @@ func TestVersionCommand_Execute(t *testing.T) {
 			cobraCmd.CmdHelp()
 		})
 	})
+	t.Run("Should print help of all commands without error", func(t *testing.T) {
+		cobraCmd := NewHelpCommand(newRootCommand()).CmdHelp()
+		assert.NoError(t, cobraCmd.RunE(cobraCmd, []string{}))
+	})
+	t.Run("Should print help of one command without error", func(t *testing.T) {
+		cobraCmd := NewHelpCommand(newRootCommand()).CmdHelp()
+		assert.NoError(t, cobraCmd.RunE(cobraCmd, []string{"test"}))
+	})
+	t.Run("Should return error when command not exists", func(t *testing.T) {
+		cobraCmd := NewHelpCommand(newRootCommand()).CmdHelp()
+		assert.Equal(t, errors.ErrHelpCommandNotFound, cobraCmd.RunE(cobraCmd, []string{"other"}))
+	})
+}
+
+func TestHelp_addTableLogToCommands(t *testing.T) {
+	t.Run("Should add one row by command", func(t *testing.T) {
+		h := &Help{rootCmd: newRootCommand()}
+		logTable := h.addTableLogToCommands(table.NewWriter())
+		assert.Equal(t, 1, logTable.Length())
+	})
 }
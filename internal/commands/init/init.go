@@ -4,13 +4,20 @@ import (
 	"fmt"
 	"github.com/spf13/cobra"
 	"github.com/wilian746/go-generator/internal/controllers/generate/app"
+	EnumsDialects "github.com/wilian746/go-generator/internal/enums/dialects"
 	"github.com/wilian746/go-generator/internal/enums/errors"
+	EnumsFeatures "github.com/wilian746/go-generator/internal/enums/features"
 	EnumsRepository "github.com/wilian746/go-generator/internal/enums/repository"
 	EnumsRepositoryCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
+	EnumsRouters "github.com/wilian746/go-generator/internal/enums/routers"
 	UseCaseRepository "github.com/wilian746/go-generator/internal/usecase/repository"
+	"github.com/wilian746/go-generator/internal/utils/directory"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
 	"github.com/wilian746/go-generator/internal/utils/logger"
 	"github.com/wilian746/go-generator/internal/utils/prompt"
 	"os"
+	"path"
+	"path/filepath"
 	"strings"
 )
 
@@ -20,8 +27,12 @@ type ICommand interface {
 }
 
 type Command struct {
-	cmd    *cobra.Command
-	prompt prompt.Interface
+	cmd      *cobra.Command
+	prompt   prompt.Interface
+	router   string
+	dialects []string
+	without  []string
+	resource string
 }
 
 func NewInitCommand(p prompt.Interface) ICommand {
@@ -37,18 +48,24 @@ func (c *Command) Cmd() *cobra.Command {
 }
 
 func (c *Command) Execute(_ *cobra.Command, args []string) error {
-	switch EnumsRepository.ValueOf(args[0]) {
-	case EnumsRepository.Gorm:
-		return c.gormInit(EnumsRepositoryCommands.ValueOf(args[1]))
-	default:
+	if !UseCaseRepository.IsValidRepositoryAndCommand(args[0], args[1]) {
 		return errors.ErrInitTypeInvalid
 	}
-}
-
-func (c *Command) gormInit(value EnumsRepositoryCommands.Command) error {
-	switch value {
-	case EnumsRepositoryCommands.App:
-		return c.initApp(EnumsRepository.Gorm)
+	if err := c.validateRouter(EnumsRepositoryCommands.ValueOf(args[1])); err != nil {
+		return err
+	}
+	if err := c.validateDialects(EnumsRepository.ValueOf(args[0])); err != nil {
+		return err
+	}
+	if err := c.validateWithout(); err != nil {
+		return err
+	}
+	if err := c.validateResource(EnumsRepositoryCommands.ValueOf(args[1])); err != nil {
+		return err
+	}
+	switch EnumsRepositoryCommands.ValueOf(args[1]) {
+	case EnumsRepositoryCommands.App, EnumsRepositoryCommands.GRPC, EnumsRepositoryCommands.GraphQL:
+		return c.initApp(EnumsRepository.ValueOf(args[0]), EnumsRepositoryCommands.ValueOf(args[1]))
 	default:
 		return errors.ErrInitTypeInvalid
 	}
@@ -56,25 +73,59 @@ func (c *Command) gormInit(value EnumsRepositoryCommands.Command) error {
 
 func (c *Command) Init() {
 	c.cmd = &cobra.Command{
-		Use:     "init",
+		Use:     "init [REPOSITORY] [GENERATE_TYPE]",
 		Short:   "Initialize template application using selected repository",
-		Example: "go-generator init gorm app",
+		Long:    "Get base of the project, handlers, controllers, repository using selected database",
+		Example: strings.Join(UseCaseRepository.GetAvailableCommandsExamples(), "\n"),
 		Args:    c.validateArgs,
 		RunE:    c.Execute,
+		Annotations: map[string]string{
+			"REPOSITORY":    strings.Join(UseCaseRepository.GetRepositoriesNames(), ", "),
+			"GENERATE_TYPE": strings.Join(UseCaseRepository.GetCommandsNames(), ", "),
+		},
 	}
+	c.cmd.Flags().StringVar(&c.router, "router", EnumsRouters.Chi.String(),
+		"Router used in the routes: "+strings.Join(EnumsRouters.ValuesNames(), ", "))
+	c.cmd.Flags().StringSliceVar(&c.dialects, "dialects", nil,
+		"Dialects with migrations, drivers and services generated, separated by comma. Default all. "+
+			"sqlite3 is added only when the tests are kept because is used by the tests")
+	c.cmd.Flags().StringSliceVar(&c.without, "without", nil,
+		"Features not generated, separated by comma: "+strings.Join(EnumsFeatures.ValuesNames(), ", "))
+	c.cmd.Flags().StringVar(&c.resource, "resource", app.ResourceTemplateName,
+		"Name of the resource generated with entity, table, routes and migrations, like invoice or shipment_item")
 	c.setUsageCommand()
 }
 
-func (c *Command) initApp(db EnumsRepository.Repository) error {
-	pathDestiny, err := c.askPathDestiny()
+func (c *Command) initApp(db EnumsRepository.Repository, command EnumsRepositoryCommands.Command) error {
+	generator := app.NewApp().SetCommand(command).SetRouter(EnumsRouters.ValueOf(c.router)).
+		SetDialects(c.getDialects()).SetWithout(c.getWithout()).SetResource(c.resource)
+	pathDestiny, moduleName, err := c.resolveDestiny(generator)
 	if err != nil {
 		return err
 	}
-	moduleName, err := c.prompt.Ask("Enter module of golang project", "github.com/wilian746/go-generator/tmp")
-	if err != nil || moduleName == "" {
-		return errors.ErrModuleNameInvalid
+	defer logger.PrintTimings()
+	return generator.CreateFoldersAndFiles(pathDestiny, moduleName, db)
+}
+
+func (c *Command) resolveDestiny(generator app.Interface) (pathDestiny, moduleName string, err error) {
+	defer logger.StartPhase("resolve")()
+	pathDestiny, err = c.askPathDestiny()
+	if err != nil {
+		return "", "", err
+	}
+	moduleName, err = c.askModuleName()
+	if err != nil {
+		return "", "", err
+	}
+	pathDestiny, moduleName, err = c.resolveExistingModule(generator, pathDestiny, moduleName)
+	if err != nil {
+		return "", "", err
+	}
+	if err := directory.CheckWritable(pathDestiny); err != nil {
+		return "", "", fmt.Errorf("%w: %v", errors.ErrDirectoryNotWritable, err)
 	}
-	return app.NewApp().CreateFoldersAndFiles(pathDestiny, moduleName, db)
+	logger.VERBOSE("Destiny resolved", pathDestiny, moduleName)
+	return pathDestiny, moduleName, nil
 }
 
 func (c *Command) askPathDestiny() (string, error) {
@@ -83,22 +134,82 @@ func (c *Command) askPathDestiny() (string, error) {
 		return "", err
 	}
 	pathDestiny, err := c.prompt.Ask("Enter the full path of the directory destiny!", actualDirectory)
-	if err != nil {
+	if err != nil || strings.TrimSpace(pathDestiny) == "" {
 		return "", errors.ErrDirectoryPathInvalid
 	}
-	lastChar := pathDestiny[len(pathDestiny)-1:]
-	if lastChar == "/" {
-		pathDestiny = strings.TrimSuffix(pathDestiny, lastChar)
+	return filepath.Clean(strings.TrimSpace(pathDestiny)), nil
+}
+
+func (c *Command) askModuleName() (string, error) {
+	moduleName, err := c.prompt.Ask("Enter module of golang project", "github.com/wilian746/go-generator/tmp")
+	if err != nil {
+		return "", errors.ErrModuleNameInvalid
+	}
+	moduleName = strings.TrimSpace(moduleName)
+	if err := gomod.CheckModulePath(moduleName); err != nil {
+		return "", fmt.Errorf("%w: %v", errors.ErrModuleNameInvalid, err)
 	}
-	return pathDestiny, nil
+	return moduleName, nil
+}
+
+func (c *Command) resolveExistingModule(
+	generator app.Interface, pathDestiny, moduleName string) (string, string, error) {
+	absPathDestiny, err := filepath.Abs(pathDestiny)
+	if err != nil {
+		return "", "", errors.ErrDirectoryPathInvalid
+	}
+	root, existingModule := gomod.FindModuleRoot(absPathDestiny)
+	if root == "" || existingModule == moduleName {
+		return pathDestiny, moduleName, nil
+	}
+	subdirectory, _ := filepath.Rel(root, absPathDestiny)
+	if subdirectory == "." {
+		logger.WARN(fmt.Sprintf("%s already contains a go.mod with module %s", root, existingModule))
+		if !c.askConfirm(fmt.Sprintf("Generate into a subdirectory of module %s without a new go.mod?", existingModule)) {
+			return "", "", errors.ErrDirectoryContainsOtherModule
+		}
+		return c.askSubdirectoryOfModule(generator, root, existingModule, path.Base(moduleName))
+	}
+	if !c.askConfirm(fmt.Sprintf("%s is inside module %s, generate without a new go.mod?", root, existingModule)) {
+		return pathDestiny, moduleName, nil
+	}
+	return c.useExistingModule(generator, root, existingModule, subdirectory)
+}
+
+func (c *Command) askSubdirectoryOfModule(
+	generator app.Interface, root, existingModule, defaultValue string) (string, string, error) {
+	subdirectory, err := c.prompt.Ask("Enter the subdirectory of module "+existingModule, defaultValue)
+	subdirectory = filepath.Clean(strings.TrimSpace(subdirectory))
+	if err != nil || subdirectory == "." || filepath.IsAbs(subdirectory) || strings.HasPrefix(subdirectory, "..") {
+		return "", "", errors.ErrDirectoryPathInvalid
+	}
+	return c.useExistingModule(generator, root, existingModule, subdirectory)
+}
+
+func (c *Command) useExistingModule(
+	generator app.Interface, root, existingModule, subdirectory string) (string, string, error) {
+	moduleName := existingModule + "/" + filepath.ToSlash(subdirectory)
+	if err := gomod.CheckModulePath(moduleName); err != nil {
+		return "", "", fmt.Errorf("%w: %v", errors.ErrModuleNameInvalid, err)
+	}
+	generator.SetSkipGoMod(true)
+	logger.INFO(fmt.Sprintf("go.mod will not be generated, run `go mod tidy` in %s after generation", root))
+	return filepath.Join(root, subdirectory), moduleName, nil
+}
+
+func (c *Command) askConfirm(label string) bool {
+	answer, err := c.prompt.Ask(label+" [y/N]", "N")
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return err == nil && (answer == "y" || answer == "yes")
 }
 
 func (c *Command) setUsageCommand() {
 	c.cmd.SetUsageFunc(func(command *cobra.Command) error {
-		logger.PRINT("Get base of the project, handlers, controllers, repository using selected database")
+		logger.PRINT(command.Long)
 		logger.PRINT(fmt.Sprintf(`
 Usage:
-	go-generator init [REPOSITORY] [GENERATE_TYPE]
+	go-generator init [REPOSITORY] [GENERATE_TYPE] [--router chi|stdlib|gin|echo] [--dialects postgres,sqlite3]
+		[--without swagger,docker-compose,tests,health,cors] [--resource invoice]
 
 Examples:
 	%s
@@ -107,6 +218,79 @@ Examples:
 	})
 }
 
+func (c *Command) validateRouter(command EnumsRepositoryCommands.Command) error {
+	if c.router == "" {
+		c.router = EnumsRouters.Chi.String()
+	}
+	if !EnumsRouters.Valid(c.router) {
+		return errors.ErrRouterInvalid
+	}
+	if !app.IsRouterSupported(command, EnumsRouters.ValueOf(c.router)) {
+		return errors.ErrRouterNotSupported
+	}
+	return nil
+}
+
+func (c *Command) validateDialects(db EnumsRepository.Repository) error {
+	if len(c.dialects) == 0 {
+		return nil
+	}
+	if !app.IsDialectsSupported(db) {
+		return errors.ErrDialectsNotSupported
+	}
+	for _, dialect := range c.dialects {
+		if !EnumsDialects.Valid(strings.TrimSpace(dialect)) {
+			return errors.ErrDialectsInvalid
+		}
+	}
+	return nil
+}
+
+// getDialects return the dialects selected, the App generate the sqlite3 too when the tests are generated
+func (c *Command) getDialects() []EnumsDialects.Dialect {
+	if len(c.dialects) == 0 {
+		return nil
+	}
+	dialects := []EnumsDialects.Dialect{}
+	for _, dialect := range c.dialects {
+		dialects = append(dialects, EnumsDialects.ValueOf(strings.TrimSpace(dialect)))
+	}
+	if _, added := app.AddDialectOfTests(dialects, c.getWithout()); added {
+		logger.WARN("sqlite3 is generated too because is used by the tests")
+	}
+	return dialects
+}
+
+func (c *Command) validateResource(command EnumsRepositoryCommands.Command) error {
+	if c.resource == "" || c.resource == app.ResourceTemplateName {
+		return nil
+	}
+	if !app.IsResourceSupported(command) {
+		return errors.ErrResourceNotSupported
+	}
+	if !app.IsResourceValid(c.resource) {
+		return errors.ErrResourceInvalid
+	}
+	return nil
+}
+
+func (c *Command) validateWithout() error {
+	for _, feature := range c.without {
+		if !EnumsFeatures.Valid(strings.TrimSpace(feature)) {
+			return errors.ErrFeaturesInvalid
+		}
+	}
+	return nil
+}
+
+func (c *Command) getWithout() []EnumsFeatures.Feature {
+	features := []EnumsFeatures.Feature{}
+	for _, feature := range c.without {
+		features = append(features, EnumsFeatures.ValueOf(strings.TrimSpace(feature)))
+	}
+	return features
+}
+
 func (c *Command) validateArgs(_ *cobra.Command, args []string) error {
 	if len(args) != 2 {
 		return errors.ErrInitArgsInvalid
@@ -1,8 +1,16 @@
 package init
 
 import (
+	goErrors "errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/controllers/generate/app"
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/features"
 	"github.com/wilian746/go-generator/internal/utils/prompt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -17,7 +25,9 @@ func TestCommand_Execute(t *testing.T) {
 	path := "./tmp"
 	t.Run("Should execute command exec without error", func(t *testing.T) {
 		promptMock := &prompt.Mock{}
-		promptMock.On("Ask").Return(path, nil)
+		promptMock.On("Ask").Return(path, nil).Once()
+		promptMock.On("Ask").Return("github.com/wilian746/tmp", nil).Once()
+		promptMock.On("Ask").Return("N", nil).Once()
 		cobraCmd := NewInitCommand(promptMock)
 		assert.NoError(t, cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"}))
 	})
@@ -33,4 +43,157 @@ func TestCommand_Execute(t *testing.T) {
 		cobraCmd := NewInitCommand(promptMock)
 		assert.Error(t, cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "other-cmd"}))
 	})
+	t.Run("Should return error when router is invalid", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("router", "other-router"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"})
+		assert.Equal(t, errors.ErrRouterInvalid, err)
+	})
+	t.Run("Should return error when router is not supported by generate type", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("router", "gin"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "graphql"})
+		assert.Equal(t, errors.ErrRouterNotSupported, err)
+	})
+	t.Run("Should return error when dialects are invalid", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("dialects", "postgres,other-dialect"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"})
+		assert.Equal(t, errors.ErrDialectsInvalid, err)
+	})
+	t.Run("Should return error when dialects are not supported by repository", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("dialects", "postgres"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"bolt", "app"})
+		assert.Equal(t, errors.ErrDialectsNotSupported, err)
+	})
+	t.Run("Should return error when features of without are invalid", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("without", "swagger,other-feature"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"})
+		assert.Equal(t, errors.ErrFeaturesInvalid, err)
+	})
+	t.Run("Should return error when resource is invalid", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("resource", "health"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"})
+		assert.Equal(t, errors.ErrResourceInvalid, err)
+	})
+	t.Run("Should return error when resource is not supported by generate type", func(t *testing.T) {
+		cobraCmd := NewInitCommand(&prompt.Mock{})
+		assert.NoError(t, cobraCmd.Cmd().Flags().Set("resource", "invoice"))
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "grpc"})
+		assert.Equal(t, errors.ErrResourceNotSupported, err)
+	})
+	t.Run("Should return error when path destiny is empty", func(t *testing.T) {
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return("", nil)
+		cobraCmd := NewInitCommand(promptMock)
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"})
+		assert.Equal(t, errors.ErrDirectoryPathInvalid, err)
+	})
+	t.Run("Should return error when module name is invalid", func(t *testing.T) {
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return(path, nil)
+		cobraCmd := NewInitCommand(promptMock)
+		err := cobraCmd.Execute(cobraCmd.Cmd(), []string{"gorm", "app"})
+		assert.True(t, goErrors.Is(err, errors.ErrModuleNameInvalid))
+	})
+}
+
+func TestCommand_getDialects(t *testing.T) {
+	t.Run("Should return nil when dialects are not selected", func(t *testing.T) {
+		assert.Nil(t, (&Command{}).getDialects())
+	})
+	t.Run("Should return dialects selected", func(t *testing.T) {
+		c := &Command{dialects: []string{"postgres", " sqlite3"}}
+		assert.Equal(t, []dialects.Dialect{dialects.Postgres, dialects.SQLite3}, c.getDialects())
+	})
+	t.Run("Should not add sqlite3 because it is added by the app only when tests are generated", func(t *testing.T) {
+		c := &Command{dialects: []string{"mysql"}, without: []string{"tests"}}
+		assert.Equal(t, []dialects.Dialect{dialects.MySQL}, c.getDialects())
+	})
+}
+
+func TestCommand_getWithout(t *testing.T) {
+	t.Run("Should return empty when features are not selected", func(t *testing.T) {
+		assert.Empty(t, (&Command{}).getWithout())
+	})
+	t.Run("Should return features selected", func(t *testing.T) {
+		c := &Command{without: []string{"swagger", " docker-compose"}}
+		assert.Equal(t, []features.Feature{features.Swagger, features.DockerCompose}, c.getWithout())
+	})
+}
+
+func TestCommand_resolveExistingModule(t *testing.T) {
+	newModuleDirectory := func(t *testing.T) string {
+		dir, err := ioutil.TempDir("", "init")
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/root\n"), 0600))
+		return dir
+	}
+	t.Run("Should keep path and module when go.mod has the same module", func(t *testing.T) {
+		dir := newModuleDirectory(t)
+		defer os.RemoveAll(dir)
+		c := &Command{prompt: &prompt.Mock{}}
+		pathDestiny, moduleName, err := c.resolveExistingModule(app.NewApp(), dir, "example.com/root")
+		assert.NoError(t, err)
+		assert.Equal(t, dir, pathDestiny)
+		assert.Equal(t, "example.com/root", moduleName)
+	})
+	t.Run("Should return error when go.mod has other module and user not accept", func(t *testing.T) {
+		dir := newModuleDirectory(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return("N", nil)
+		c := &Command{prompt: promptMock}
+		_, _, err := c.resolveExistingModule(app.NewApp(), dir, "github.com/wilian746/tmp")
+		assert.Equal(t, errors.ErrDirectoryContainsOtherModule, err)
+	})
+	t.Run("Should generate into subdirectory when go.mod has other module and user accept", func(t *testing.T) {
+		dir := newModuleDirectory(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return("y", nil).Once()
+		promptMock.On("Ask").Return("api", nil).Once()
+		c := &Command{prompt: promptMock}
+		pathDestiny, moduleName, err := c.resolveExistingModule(app.NewApp(), dir, "github.com/wilian746/tmp")
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "api"), pathDestiny)
+		assert.Equal(t, "example.com/root/api", moduleName)
+	})
+	t.Run("Should return error when subdirectory is outside of module", func(t *testing.T) {
+		dir := newModuleDirectory(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return("y", nil).Once()
+		promptMock.On("Ask").Return("../api", nil).Once()
+		c := &Command{prompt: promptMock}
+		_, _, err := c.resolveExistingModule(app.NewApp(), dir, "github.com/wilian746/tmp")
+		assert.Equal(t, errors.ErrDirectoryPathInvalid, err)
+	})
+	t.Run("Should use package of existing module when destiny is inside it and user accept", func(t *testing.T) {
+		dir := newModuleDirectory(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return("yes", nil)
+		c := &Command{prompt: promptMock}
+		destiny := filepath.Join(dir, "services", "api")
+		pathDestiny, moduleName, err := c.resolveExistingModule(app.NewApp(), destiny, "github.com/wilian746/tmp")
+		assert.NoError(t, err)
+		assert.Equal(t, destiny, pathDestiny)
+		assert.Equal(t, "example.com/root/services/api", moduleName)
+	})
+	t.Run("Should keep new module when destiny is inside other module and user not accept", func(t *testing.T) {
+		dir := newModuleDirectory(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Ask").Return("", nil)
+		c := &Command{prompt: promptMock}
+		destiny := filepath.Join(dir, "api")
+		pathDestiny, moduleName, err := c.resolveExistingModule(app.NewApp(), destiny, "github.com/wilian746/tmp")
+		assert.NoError(t, err)
+		assert.Equal(t, destiny, pathDestiny)
+		assert.Equal(t, "github.com/wilian746/tmp", moduleName)
+	})
 }
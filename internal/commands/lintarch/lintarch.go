@@ -0,0 +1,91 @@
+package lintarch
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	ControllerLintArch "github.com/wilian746/go-generator/internal/controllers/lintarch"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"path/filepath"
+)
+
+type ILintArch interface {
+	CmdLintArch() *cobra.Command
+}
+
+type LintArch struct {
+	controller ControllerLintArch.Interface
+	config     string
+	tests      bool
+	init       bool
+}
+
+func NewLintArchCommand() ILintArch {
+	return &LintArch{
+		controller: ControllerLintArch.NewLintArch(),
+	}
+}
+
+func (l *LintArch) CmdLintArch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint-arch [PATH]",
+		Short: "Check if the imports of a project generated respect the dependency matrix of the layers",
+		Long: "Check the imports of the layers internal/handlers, internal/controllers, internal/rules, " +
+			"internal/entities and pkg/repository with the dependency matrix of the " + ControllerLintArch.ConfigFile +
+			" of the project, or the matrix of the templates when it not exists",
+		Example: "go-generator lint-arch ./my-project",
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 1 {
+				pathProject = args[0]
+			}
+			if l.init {
+				return l.writeConfig(pathProject)
+			}
+			return l.lint(cmd, pathProject)
+		},
+	}
+	cmd.Flags().StringVar(&l.config, "config", ControllerLintArch.ConfigFile,
+		"File of the dependency matrix, the relative path is from the [PATH] of the project")
+	cmd.Flags().BoolVar(&l.tests, "tests", false, "Check the imports of the files of tests too")
+	cmd.Flags().BoolVar(&l.init, "init", false, "Write the dependency matrix of the templates in the --config")
+	return cmd
+}
+
+func (l *LintArch) getPathConfig(pathProject string) string {
+	if filepath.IsAbs(l.config) {
+		return l.config
+	}
+	return filepath.Join(pathProject, l.config)
+}
+
+func (l *LintArch) writeConfig(pathProject string) error {
+	if err := l.controller.WriteConfig(l.getPathConfig(pathProject)); err != nil {
+		return err
+	}
+	logger.INFO("Dependency matrix written with success in: " + l.getPathConfig(pathProject))
+	return nil
+}
+
+func (l *LintArch) lint(cmd *cobra.Command, pathProject string) error {
+	config, err := l.controller.LoadConfig(l.getPathConfig(pathProject))
+	if err != nil {
+		return err
+	}
+	violations, err := l.controller.Lint(pathProject, config, l.tests)
+	if err != nil {
+		return err
+	}
+	for _, violation := range violations {
+		fmt.Fprintln(cmd.OutOrStdout(), violation.String())
+	}
+	if len(violations) > 0 {
+		return errors.ErrLintArchViolations
+	}
+	logger.INFO("Imports of the layers respect the dependency matrix")
+	return nil
+}
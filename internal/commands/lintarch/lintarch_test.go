@@ -0,0 +1,19 @@
+package lintarch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestLintArchCommand_Execute(t *testing.T) {
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "lintarch")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewLintArchCommand().CmdLintArch()
+		assert.Equal(t, errors.ErrLintArchModuleNotFound, cobraCmd.RunE(cobraCmd, []string{dir}))
+	})
+}
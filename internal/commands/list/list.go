@@ -0,0 +1,123 @@
+package list
+
+import (
+	"fmt"
+	prettyList "github.com/jedib0t/go-pretty/v6/list"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/wilian746/go-generator/internal/controllers/generate/app"
+	entitiesRepository "github.com/wilian746/go-generator/internal/entities/repository"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	enumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
+	UseCaseRepository "github.com/wilian746/go-generator/internal/usecase/repository"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+type IList interface {
+	CmdList() *cobra.Command
+}
+
+type List struct {
+	tree bool
+}
+
+func NewListCommand() IList {
+	return &List{}
+}
+
+func (l *List) CmdList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the templates available to generate",
+		Example: "go-generator list --tree",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if l.tree {
+				l.printFoldersTree()
+				return nil
+			}
+			l.printAvailableTemplates()
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&l.tree, "tree", false, "Show the folder layout created by each template")
+	return cmd
+}
+
+func (l *List) printAvailableTemplates() {
+	logTable := table.NewWriter()
+	logTable.SetOutputMirror(os.Stdout)
+	logTable.AppendHeader(table.Row{"Repository", "Generate Type", "Dialects", "Template", "Version", "Files"})
+	for _, repository := range UseCaseRepository.GetRepositories() {
+		for _, command := range repository.Commands {
+			logTable.AppendRow(l.getTemplateRow(repository, command))
+			logTable.AppendSeparator()
+		}
+	}
+	logger.PRINT("Available Templates:")
+	logTable.Render()
+}
+
+func (l *List) getTemplateRow(repository entitiesRepository.Repository, command enumsCommands.Command) table.Row {
+	return table.Row{
+		repository.Name.String(),
+		command.String(),
+		strings.Join(repository.GetListDialects(), "\n"),
+		app.GetTemplateSource(repository.Name, command),
+		app.GetTemplateVersion(),
+		strings.Join(l.getFilesNames(repository, command), "\n"),
+	}
+}
+
+func (l *List) getFilesNames(
+	repository entitiesRepository.Repository, command enumsCommands.Command) (names []string) {
+	for _, file := range app.GetFilesByRepository(repository.Name, command) {
+		names = append(names, string(file))
+	}
+	for _, file := range files.ValuesNoGO() {
+		names = append(names, string(file))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (l *List) printFoldersTree() {
+	for _, repository := range UseCaseRepository.GetRepositories() {
+		for _, command := range repository.Commands {
+			logger.PRINT(fmt.Sprintf("Folders created by `go-generator init %s %s`:", repository.Name, command))
+			l.renderTree(l.getFoldersNames(repository, command))
+			logger.PRINT("")
+		}
+	}
+}
+
+func (l *List) getFoldersNames(
+	repository entitiesRepository.Repository, command enumsCommands.Command) (names []string) {
+	for _, folder := range app.GetFoldersByRepository(repository.Name, command) {
+		names = append(names, string(folder))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (l *List) renderTree(folders []string) {
+	tree := prettyList.NewWriter()
+	tree.SetOutputMirror(os.Stdout)
+	tree.SetStyle(prettyList.StyleConnectedRounded)
+	tree.AppendItem(".")
+	level := 0
+	for _, folder := range folders {
+		depth := strings.Count(folder, "/") + 1
+		for ; level < depth; level++ {
+			tree.Indent()
+		}
+		for ; level > depth; level-- {
+			tree.UnIndent()
+		}
+		tree.AppendItem(path.Base(folder))
+	}
+	tree.Render()
+}
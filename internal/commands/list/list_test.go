@@ -0,0 +1,18 @@
+package list
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestListCommand_Execute(t *testing.T) {
+	t.Run("Should execute command list without error", func(t *testing.T) {
+		cobraCmd := NewListCommand().CmdList()
+		assert.NoError(t, cobraCmd.RunE(cobraCmd, []string{}))
+	})
+	t.Run("Should execute command list with tree without error", func(t *testing.T) {
+		cobraCmd := NewListCommand().CmdList()
+		assert.NoError(t, cobraCmd.Flags().Set("tree", "true"))
+		assert.NoError(t, cobraCmd.RunE(cobraCmd, []string{}))
+	})
+}
@@ -0,0 +1,101 @@
+package remove
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	ControllerRemove "github.com/wilian746/go-generator/internal/controllers/remove"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"github.com/wilian746/go-generator/internal/utils/prompt"
+	"strings"
+)
+
+type IRemove interface {
+	CmdRemove() *cobra.Command
+}
+
+type Remove struct {
+	controller ControllerRemove.Interface
+	prompt     prompt.Interface
+	migration  bool
+}
+
+func NewRemoveCommand(p prompt.Interface) IRemove {
+	return &Remove{
+		controller: ControllerRemove.NewRemove(),
+		prompt:     p,
+	}
+}
+
+func (r *Remove) CmdRemove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove",
+		Short:   "Remove parts of a project generated",
+		Example: "go-generator remove resource product ./my-project",
+	}
+	cmd.AddCommand(r.cmdResource())
+	return cmd
+}
+
+func (r *Remove) cmdResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource [NAME] [PATH]",
+		Short: "Remove a resource of a project generated",
+		Long: "Delete the packages of the resource of internal/entities, internal/rules, internal/controllers and " +
+			"internal/handlers, unregister your routes of internal/routes and your AutoMigrate of cmd/main.go. " +
+			"The migrations that created the table are kept, a migration to drop the table is generated instead",
+		Example: "go-generator remove resource product ./my-project --migration",
+		Args:    cobra.RangeArgs(1, 2),
+		Annotations: map[string]string{
+			"NAME": "Name of the resource, like product or shipment_item",
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 2 {
+				pathProject = args[1]
+			}
+			return r.removeResource(cmd, pathProject, args[0])
+		},
+	}
+	cmd.Flags().BoolVar(&r.migration, "migration", false,
+		"Generate the migrations to drop the table, when not informed it is asked")
+	return cmd
+}
+
+func (r *Remove) removeResource(cmd *cobra.Command, pathProject, name string) error {
+	table, err := r.controller.GetTable(pathProject, name)
+	if err != nil {
+		return err
+	}
+	migration := r.isMigrationConfirmed(cmd, pathProject, table)
+	removed, err := r.controller.RemoveResource(pathProject, name)
+	if err != nil {
+		return err
+	}
+	for _, file := range removed {
+		logger.INFO("Removed or updated: " + file)
+	}
+	if migration {
+		generated, err := r.controller.GenerateDropMigrations(pathProject, table)
+		if err != nil {
+			return err
+		}
+		for _, file := range generated {
+			logger.INFO("Migration generated: " + file)
+		}
+	}
+	logger.INFO(fmt.Sprintf("Resource %s removed with success, run `swag init -g ./cmd/main.go` to update the docs", name))
+	return nil
+}
+
+func (r *Remove) isMigrationConfirmed(cmd *cobra.Command, pathProject, table string) bool {
+	if cmd.Flags().Changed("migration") {
+		return r.migration
+	}
+	if len(r.controller.GetMigrationsFolders(pathProject)) == 0 {
+		return false
+	}
+	answer, err := r.prompt.Ask(fmt.Sprintf("Generate the migrations to drop the table %s? [y/N]", table), "N")
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return err == nil && (answer == "y" || answer == "yes")
+}
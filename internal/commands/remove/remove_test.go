@@ -0,0 +1,37 @@
+package remove
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/prompt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestRemoveCommand_Execute(t *testing.T) {
+	t.Run("Should have the subcommand resource", func(t *testing.T) {
+		cobraCmd := NewRemoveCommand(&prompt.Mock{}).CmdRemove()
+		resource, _, err := cobraCmd.Find([]string{"resource"})
+		assert.NoError(t, err)
+		assert.Equal(t, "resource", resource.Name())
+		assert.NotNil(t, resource.Flags().Lookup("migration"))
+	})
+	t.Run("Should return error when resource not exists", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "remove")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewRemoveCommand(&prompt.Mock{}).CmdRemove()
+		resource, _, _ := cobraCmd.Find([]string{"resource"})
+		assert.Equal(t, errors.ErrRemoveResourceNotFound, resource.RunE(resource, []string{"product", dir}))
+	})
+	t.Run("Should return error when project not have go.mod and not ask about the migrations", func(t *testing.T) {
+		promptMock := &prompt.Mock{}
+		cobraCmd := NewRemoveCommand(promptMock).CmdRemove()
+		resource, _, _ := cobraCmd.Find([]string{"resource"})
+		assert.NoError(t, resource.Flags().Set("migration", "false"))
+		err := resource.RunE(resource, []string{"product", "../../../pkg/standart-gorm"})
+		assert.Equal(t, errors.ErrRemoveModuleNotFound, err)
+		promptMock.AssertNotCalled(t, "Ask")
+	})
+}
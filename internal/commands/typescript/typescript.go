@@ -0,0 +1,62 @@
+package typescript
+
+import (
+	"github.com/spf13/cobra"
+	ControllerTypeScript "github.com/wilian746/go-generator/internal/controllers/typescript"
+	"github.com/wilian746/go-generator/internal/utils/logger"
+	"path/filepath"
+)
+
+type ITypeScript interface {
+	CmdTypeScript() *cobra.Command
+}
+
+type TypeScript struct {
+	controller ControllerTypeScript.Interface
+	out        string
+	basePath   string
+}
+
+func NewTypeScriptCommand() ITypeScript {
+	return &TypeScript{
+		controller: ControllerTypeScript.NewTypeScript(),
+	}
+}
+
+func (t *TypeScript) CmdTypeScript() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "typescript [PATH]",
+		Short: "Generate the client in typescript of the API from the swagger docs of a project generated",
+		Long: "Generate a client with fetch and the interfaces of the definitions of docs/swagger.json, " +
+			"without the need of node installed",
+		Example: "go-generator typescript ./my-project --out ./web/src/api/client.ts",
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"PATH": "Directory of the project, by default the current directory",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathProject := "."
+			if len(args) == 1 {
+				pathProject = args[0]
+			}
+			return t.generate(pathProject)
+		},
+	}
+	cmd.Flags().StringVar(&t.out, "out", "clients/typescript/client.ts",
+		"File of the client generated, the relative path is from the [PATH] of the project")
+	cmd.Flags().StringVar(&t.basePath, "base-path", ControllerTypeScript.DefaultBasePath,
+		"Prefix of the routes when the swagger docs not have basePath")
+	return cmd
+}
+
+func (t *TypeScript) generate(pathProject string) error {
+	out := t.out
+	if !filepath.IsAbs(out) {
+		out = filepath.Join(pathProject, out)
+	}
+	if err := t.controller.Generate(pathProject, out, t.basePath); err != nil {
+		return err
+	}
+	logger.INFO("File generated with success: " + out)
+	return nil
+}
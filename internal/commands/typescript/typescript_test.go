@@ -0,0 +1,19 @@
+package typescript
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestTypeScriptCommand_Execute(t *testing.T) {
+	t.Run("Should return error when project not have swagger docs", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "typescript")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		cobraCmd := NewTypeScriptCommand().CmdTypeScript()
+		assert.Equal(t, errors.ErrTypeScriptSwaggerNotFound, cobraCmd.RunE(cobraCmd, []string{dir}))
+	})
+}
@@ -0,0 +1,257 @@
+package add
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/enums/middlewares"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
+	"github.com/wilian746/go-generator/internal/utils/inflection"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// templatesMiddlewares are the file and the test of the package of each middleware
+var templatesMiddlewares = map[middlewares.Middleware][2]string{
+	middlewares.Empty:     {templateEmpty, templateEmptyTest},
+	middlewares.Auth:      {templateAuth, templateAuthTest},
+	middlewares.RateLimit: {templateRateLimit, templateRateLimitTest},
+	middlewares.Logging:   {templateLogging, templateLoggingTest},
+	middlewares.BodyLimit: {templateBodyLimit, templateBodyLimitTest},
+}
+
+type Interface interface {
+	AddMiddleware(pathProject, name string, middleware middlewares.Middleware) ([]string, error)
+}
+
+type Add struct{}
+
+func NewAdd() Interface {
+	return &Add{}
+}
+
+// middleware are the values of the templates of the middleware, like the package ratelimit and the env RATE_LIMIT
+type middleware struct {
+	Package string
+	Pascal  string
+	Words   string
+	Alias   string
+	Env     string
+	Module  string
+}
+
+// AddMiddleware create the package of the middleware in internal/middlewares and enable it in the setConfigsRouters
+// of the routers of internal/routes, it return the files created and changed
+func (a *Add) AddMiddleware(pathProject, name string, kind middlewares.Middleware) ([]string, error) {
+	resource := inflection.NewName(name)
+	if resource.IsEmpty() {
+		return nil, errors.ErrAddMiddlewareInvalid
+	}
+	templates, ok := templatesMiddlewares[kind]
+	if !ok {
+		return nil, errors.ErrAddMiddlewareTemplateInvalid
+	}
+	module, err := gomod.GetModulePath(pathProject)
+	if err != nil || module == "" {
+		return nil, errors.ErrAddModuleNotFound
+	}
+	data := middleware{
+		Package: resource.Package(), Pascal: resource.Pascal(), Words: resource.Words(),
+		Alias: resource.Pascal() + "Middleware", Env: strings.ToUpper(resource.Snake()), Module: module,
+	}
+	routes, err := getRoutes(pathProject, "Enable"+data.Pascal)
+	if err != nil {
+		return nil, err
+	}
+	folder := filepath.Join(pathProject, string(folders.InternalMiddlewares), data.Package)
+	if _, err := os.Stat(folder); err == nil {
+		return nil, errors.ErrAddMiddlewareAlreadyExists
+	}
+	files, err := writeMiddleware(folder, templates, data)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range getSortedKeys(routes) {
+		if err := enableMiddleware(file, routes[file], data); err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		files = append(files, file)
+	}
+	if kind == middlewares.Auth {
+		return authorizeRoutesTests(pathProject, files, data)
+	}
+	return files, nil
+}
+
+// getRoutes return the files of the routers with the setConfigsRouters and if the router wrap the handler with the
+// field middlewares, like the gin, echo and stdlib, or register the middlewares with Use, like the chi
+func getRoutes(pathProject, method string) (map[string]bool, error) {
+	files, _ := filepath.Glob(filepath.Join(pathProject, string(folders.InternalRoutes), "*.go"))
+	routes := map[string]bool{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if getFunc(parsed, method) != nil {
+			return nil, errors.ErrAddMiddlewareAlreadyExists
+		}
+		if getFunc(parsed, "setConfigsRouters") != nil {
+			routes[file] = hasField(parsed, "Router", "middlewares")
+		}
+	}
+	if len(routes) == 0 {
+		return nil, errors.ErrAddRoutesNotFound
+	}
+	return routes, nil
+}
+
+func writeMiddleware(folder string, templates [2]string, data middleware) ([]string, error) {
+	if err := os.MkdirAll(folder, os.ModePerm); err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for index, suffix := range []string{".go", "_test.go"} {
+		content, err := execute(templates[index], data)
+		if err != nil {
+			return nil, err
+		}
+		file := filepath.Join(folder, data.Package+suffix)
+		if err := ioutil.WriteFile(file, content, 0600); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func enableMiddleware(file string, useMiddlewares bool, data middleware) error {
+	enable := templateEnableUse
+	if useMiddlewares {
+		enable = templateEnableAppend
+	}
+	method, err := execute(enable, data)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	importPath := data.Module + "/" + path.Join(string(folders.InternalMiddlewares), data.Package)
+	content, err = goast.NewEditor(content).AddImport(importPath, data.Alias).AddFunc(string(method)).
+		AddCall("setConfigsRouters", "r.Enable"+data.Pascal+"()").Bytes()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, content, 0600)
+}
+
+// authorizeRoutesTests set the token of the auth in the tests of the routes with t.Setenv and send it in the requests
+// of the serve, because the auth reject the requests without the token. The routes without tests are not changed
+func authorizeRoutesTests(pathProject string, files []string, data middleware) ([]string, error) {
+	file := filepath.Join(pathProject, string(folders.InternalRoutes), "routes_test.go")
+	content, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return files, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parser.ParseFile(token.NewFileSet(), file, content, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	env := strconv.Quote(data.Env + "_TOKEN")
+	editor := goast.NewEditor(content).AddImport("os", "")
+	for _, decl := range parsed.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Recv != nil {
+			continue
+		}
+		if name := getParamName(function, "*testing.T"); strings.HasPrefix(function.Name.Name, "Test") && name != "" {
+			editor.AddCallFirst(function.Name.Name, name+".Setenv("+env+", \"token-of-the-tests\")")
+		}
+		if name := getParamName(function, "*http.Request"); function.Name.Name == "serve" && name != "" {
+			editor.AddCallFirst("serve", name+".Header.Set(\"Authorization\", \"Bearer \"+os.Getenv("+env+"))")
+		}
+	}
+	content, err = editor.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if err := ioutil.WriteFile(file, content, 0600); err != nil {
+		return nil, err
+	}
+	return append(files, file), nil
+}
+
+// getParamName return the name of the first param of the function with the type, like the t of the *testing.T
+func getParamName(function *ast.FuncDecl, paramType string) string {
+	for _, field := range function.Type.Params.List {
+		if types.ExprString(field.Type) == paramType && len(field.Names) > 0 {
+			return field.Names[0].Name
+		}
+	}
+	return ""
+}
+
+func execute(text string, data middleware) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := template.Must(template.New("middleware").Parse(text)).Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buffer.Bytes())
+}
+
+func getFunc(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if function, ok := decl.(*ast.FuncDecl); ok && function.Name.Name == name {
+			return function
+		}
+	}
+	return nil
+}
+
+func hasField(file *ast.File, structName, fieldName string) bool {
+	object := file.Scope.Lookup(structName)
+	if object == nil || object.Kind != ast.Typ {
+		return false
+	}
+	structType, ok := object.Decl.(*ast.TypeSpec).Type.(*ast.StructType)
+	if !ok {
+		return false
+	}
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getSortedKeys(values map[string]bool) (keys []string) {
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,123 @@
+package add
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/middlewares"
+	"github.com/wilian746/go-generator/internal/testutil"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readFile(t *testing.T, elem ...string) string {
+	content, err := ioutil.ReadFile(filepath.Join(elem...))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestAdd_AddMiddleware(t *testing.T) {
+	t.Run("Should create the package and enable the middleware in all routers", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		changed, err := NewAdd().AddMiddleware(dir, "rate-limit", middlewares.RateLimit)
+		assert.NoError(t, err)
+		folder := filepath.Join(dir, "internal", "middlewares", "ratelimit")
+		assert.Contains(t, changed, filepath.Join(folder, "ratelimit.go"))
+		assert.Contains(t, changed, filepath.Join(folder, "ratelimit_test.go"))
+		assert.Contains(t, readFile(t, folder, "ratelimit.go"), "package ratelimit")
+		assert.Contains(t, readFile(t, folder, "ratelimit.go"), "RATE_LIMIT_REQUESTS")
+		assert.Contains(t, readFile(t, folder, "ratelimit_test.go"), "func TestNewMiddleware(t *testing.T)")
+		for _, file := range []string{"routes.go", "routes_gin.go", "routes_echo.go", "routes_stdlib.go"} {
+			content := readFile(t, dir, "internal", "routes", file)
+			assert.Contains(t, changed, filepath.Join(dir, "internal", "routes", file))
+			assert.Contains(t, content,
+				"RateLimitMiddleware \""+testutil.TemplateModule+"/internal/middlewares/ratelimit\"", file)
+			assert.Contains(t, content, "func (r *Router) EnableRateLimit() *Router {", file)
+			assert.Contains(t, content, "\tr.EnableRateLimit()\n}", file)
+		}
+		assert.Contains(t, readFile(t, dir, "internal", "routes", "routes.go"),
+			"r.router.Use(RateLimitMiddleware.NewMiddleware())")
+		assert.Contains(t, readFile(t, dir, "internal", "routes", "routes_gin.go"),
+			"r.middlewares = append(r.middlewares, RateLimitMiddleware.NewMiddleware())")
+	})
+	t.Run("Should set the token of the auth in the tests of the routes and send it in the requests", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		changed, err := NewAdd().AddMiddleware(dir, "auth", middlewares.Auth)
+		assert.NoError(t, err)
+		test := filepath.Join(dir, "internal", "routes", "routes_test.go")
+		assert.Contains(t, changed, test)
+		content := readFile(t, test)
+		assert.Contains(t, content, "\t\"os\"\n")
+		assert.Contains(t, content,
+			"func TestNewRouter(t *testing.T) {\n\tt.Setenv(\"AUTH_TOKEN\", \"token-of-the-tests\")\n")
+		assert.Contains(t, content, "func TestRouter_SetRouters(t *testing.T) {\n\tt.Setenv(\"AUTH_TOKEN\",")
+		assert.Contains(t, content, "\tr.Header.Set(\"Authorization\", \"Bearer \"+os.Getenv(\"AUTH_TOKEN\"))\n")
+	})
+	t.Run("Should create all the templates of middlewares", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		for _, value := range middlewares.Values() {
+			_, err := NewAdd().AddMiddleware(dir, "my-"+value.String(), value)
+			assert.NoError(t, err, value.String())
+		}
+		content := readFile(t, dir, "internal", "routes", "routes_echo.go")
+		assert.Equal(t, 1, strings.Count(content, "r.EnableMyAuth()"))
+		assert.Contains(t, readFile(t, dir, "internal", "middlewares", "myauth", "myauth.go"), "MY_AUTH_TOKEN")
+	})
+	t.Run("Should return error when middleware already exists", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		_, err := NewAdd().AddMiddleware(dir, "auth", middlewares.Auth)
+		assert.NoError(t, err)
+		_, err = NewAdd().AddMiddleware(dir, "auth", middlewares.Empty)
+		assert.Equal(t, errors.ErrAddMiddlewareAlreadyExists, err)
+		_, err = NewAdd().AddMiddleware(dir, "logger", middlewares.Logging)
+		assert.Equal(t, errors.ErrAddMiddlewareAlreadyExists, err)
+	})
+	t.Run("Should return error when name or template is invalid", func(t *testing.T) {
+		_, err := NewAdd().AddMiddleware(".", "_", middlewares.Empty)
+		assert.Equal(t, errors.ErrAddMiddlewareInvalid, err)
+		_, err = NewAdd().AddMiddleware(".", "auth", middlewares.Unknown)
+		assert.Equal(t, errors.ErrAddMiddlewareTemplateInvalid, err)
+	})
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		_, err := NewAdd().AddMiddleware("../../../pkg/standart-gorm", "auth", middlewares.Auth)
+		assert.Equal(t, errors.ErrAddModuleNotFound, err)
+	})
+	t.Run("Should return error when project not have routes", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "add")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n"), 0600))
+		_, err = NewAdd().AddMiddleware(dir, "auth", middlewares.Auth)
+		assert.Equal(t, errors.ErrAddRoutesNotFound, err)
+	})
+}
+
+func TestAdd_AddMiddlewareRunTests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("the tests of the project are run only without -short")
+	}
+	for _, value := range middlewares.Values() {
+		name := "Should pass the tests of the routes with the middleware of the template " + value.String()
+		t.Run(name, func(t *testing.T) {
+			dir := testutil.CopyTemplate(t)
+			defer os.RemoveAll(dir)
+			testutil.WriteGoModOfRoot(t, dir)
+			_, err := NewAdd().AddMiddleware(dir, "my-"+value.String(), value)
+			assert.NoError(t, err)
+			for _, router := range []string{"", "gin", "echo", "stdlib"} {
+				cmd := exec.Command("go", "test", "-mod=mod", "-tags", router, "./internal/routes/...",
+					"./internal/middlewares/...")
+				cmd.Dir = dir
+				output, err := cmd.CombinedOutput()
+				assert.NoError(t, err, "router %q: %s", router, output)
+			}
+		})
+	}
+}
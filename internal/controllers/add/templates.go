@@ -0,0 +1,483 @@
+package add
+
+// templateEnableUse is the method of the Router of the chi, that register the middlewares with Use
+const templateEnableUse = `func (r *Router) Enable{{.Pascal}}() *Router {
+	r.router.Use({{.Alias}}.NewMiddleware())
+	return r
+}`
+
+// templateEnableAppend is the method of the Routers of the gin, echo and stdlib, that wrap the handler with the
+// middlewares in the order that was enabled
+const templateEnableAppend = `func (r *Router) Enable{{.Pascal}}() *Router {
+	r.middlewares = append(r.middlewares, {{.Alias}}.NewMiddleware())
+	return r
+}`
+
+// templateSendError write the error with the body of the ResponseError of the internal/utils/http
+const templateSendError = `
+func sendError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"status": status,
+		"result": map[string]string{"error": message},
+	})
+}
+`
+
+const templateEmpty = `package {{.Package}}
+
+import (
+	"net/http"
+)
+
+// NewMiddleware return the middleware of {{.Words}} that run before the handlers of the routes
+func NewMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+`
+
+const templateEmptyTest = `package {{.Package}}
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	tests := []struct {
+		name   string
+		method string
+		status int
+	}{
+		{name: "Should call the next handler on get", method: http.MethodGet, status: http.StatusOK},
+		{name: "Should call the next handler on post", method: http.MethodPost, status: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			NewMiddleware()(next).ServeHTTP(w, httptest.NewRequest(test.method, "/", nil))
+			assert.Equal(t, test.status, w.Code)
+		})
+	}
+}
+`
+
+const templateAuth = `package {{.Package}}
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"{{.Module}}/internal/utils/environment"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// publicPaths are the routes of the health used by the probes, compared with the path exactly so the routes of the
+// resources ending with /health are not public
+var publicPaths = map[string]bool{"/api/v1/health": true, "/api/v1/health/": true}
+
+// NewMiddleware return the middleware that allow only the requests with the header Authorization: Bearer with the
+// token of the env {{.Env}}_TOKEN, while the env is empty all the requests are unauthorized. The swagger, the health
+// and the preflight of the cors are public
+func NewMiddleware() func(http.Handler) http.Handler {
+	token := environment.GetEnvString("{{.Env}}_TOKEN", "")
+	if token == "" {
+		log.Println("The env {{.Env}}_TOKEN is empty, the middleware of {{.Words}} reject all the requests")
+	}
+	expected := []byte("Bearer " + token)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodOptions || isPublic(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			authorization := []byte(r.Header.Get("Authorization"))
+			if token == "" || subtle.ConstantTimeCompare(authorization, expected) != 1 {
+				sendError(w, http.StatusUnauthorized, "token of the header Authorization is invalid")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isPublic(path string) bool {
+	return publicPaths[path] || strings.HasPrefix(path, "/swagger/")
+}
+` + templateSendError
+
+const templateAuthTest = `package {{.Package}}
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	t.Setenv("{{.Env}}_TOKEN", "secret")
+	middleware := NewMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		authorization string
+		status        int
+	}{
+		{name: "Should return ok when token is valid", method: http.MethodGet, path: "/api/v1/product",
+			authorization: "Bearer secret", status: http.StatusOK},
+		{name: "Should return unauthorized when token is invalid", method: http.MethodGet, path: "/api/v1/product",
+			authorization: "Bearer wrong", status: http.StatusUnauthorized},
+		{name: "Should return unauthorized when token is without bearer", method: http.MethodGet,
+			path: "/api/v1/product", authorization: "secret", status: http.StatusUnauthorized},
+		{name: "Should return unauthorized when not have token", method: http.MethodPost, path: "/api/v1/product",
+			status: http.StatusUnauthorized},
+		{name: "Should return ok when call health", method: http.MethodGet, path: "/api/v1/health",
+			status: http.StatusOK},
+		{name: "Should return unauthorized when call route ending with health", method: http.MethodGet,
+			path: "/api/v1/product/health", status: http.StatusUnauthorized},
+		{name: "Should return ok when call swagger", method: http.MethodGet, path: "/swagger/index.html",
+			status: http.StatusOK},
+		{name: "Should return ok when call options", method: http.MethodOptions, path: "/api/v1/product",
+			status: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, test.path, nil)
+			r.Header.Set("Authorization", test.authorization)
+			w := httptest.NewRecorder()
+			middleware.ServeHTTP(w, r)
+			assert.Equal(t, test.status, w.Code)
+		})
+	}
+}
+
+func TestNewMiddlewareWithoutToken(t *testing.T) {
+	t.Setenv("{{.Env}}_TOKEN", "")
+	middleware := NewMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Run("Should return unauthorized when the env of the token is empty", func(t *testing.T) {
+		for _, authorization := range []string{"", "Bearer ", "Bearer"} {
+			r := httptest.NewRequest(http.MethodPost, "/api/v1/product", nil)
+			r.Header.Set("Authorization", authorization)
+			w := httptest.NewRecorder()
+			middleware.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusUnauthorized, w.Code, authorization)
+		}
+	})
+	t.Run("Should return ok when call health and the env of the token is empty", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		middleware.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/health", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+`
+
+const templateRateLimit = `package {{.Package}}
+
+import (
+	"encoding/json"
+	"{{.Module}}/internal/utils/environment"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// NewMiddleware return the middleware that allow by client the requests of the env {{.Env}}_REQUESTS in the seconds
+// of the env {{.Env}}_WINDOW, the client is the IP of the request
+func NewMiddleware() func(http.Handler) http.Handler {
+	limiter := newLimiter(environment.GetEnvAndParseToInt("{{.Env}}_REQUESTS", 100),
+		time.Duration(environment.GetEnvAndParseToInt("{{.Env}}_WINDOW", 60))*time.Second)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if wait, ok := limiter.allow(getClient(r), time.Now()); !ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				sendError(w, http.StatusTooManyRequests, "too many requests, try again later")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type window struct {
+	start    time.Time
+	requests int
+}
+
+type limiter struct {
+	mutex    sync.Mutex
+	limit    int
+	duration time.Duration
+	windows  map[string]*window
+}
+
+func newLimiter(limit int, duration time.Duration) *limiter {
+	return &limiter{limit: limit, duration: duration, windows: map[string]*window{}}
+}
+
+// allow count the request of the client in your window and return the time until the next window when the limit
+// of the window is reached
+func (l *limiter) allow(client string, now time.Time) (time.Duration, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	current, ok := l.windows[client]
+	if !ok || now.Sub(current.start) >= l.duration {
+		l.removeExpired(now)
+		current = &window{start: now}
+		l.windows[client] = current
+	}
+	if current.requests >= l.limit {
+		return current.start.Add(l.duration).Sub(now), false
+	}
+	current.requests++
+	return 0, true
+}
+
+func (l *limiter) removeExpired(now time.Time) {
+	for client, value := range l.windows {
+		if now.Sub(value.start) >= l.duration {
+			delete(l.windows, client)
+		}
+	}
+}
+
+func getClient(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+` + templateSendError
+
+const templateRateLimitTest = `package {{.Package}}
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	assert.NoError(t, os.Setenv("{{.Env}}_REQUESTS", "2"))
+	defer os.Unsetenv("{{.Env}}_REQUESTS")
+	middleware := NewMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	tests := []struct {
+		name       string
+		remoteAddr string
+		status     int
+	}{
+		{name: "Should return ok on first request", remoteAddr: "10.0.0.1:1234", status: http.StatusOK},
+		{name: "Should return ok on second request", remoteAddr: "10.0.0.1:1235", status: http.StatusOK},
+		{name: "Should return too many requests when limit is reached", remoteAddr: "10.0.0.1:1236",
+			status: http.StatusTooManyRequests},
+		{name: "Should return ok to other client", remoteAddr: "10.0.0.2:1234", status: http.StatusOK},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = test.remoteAddr
+			w := httptest.NewRecorder()
+			middleware.ServeHTTP(w, r)
+			assert.Equal(t, test.status, w.Code)
+		})
+	}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Run("Should allow again in the next window", func(t *testing.T) {
+		now := time.Now()
+		limiter := newLimiter(1, time.Minute)
+		_, ok := limiter.allow("client", now)
+		assert.True(t, ok)
+		wait, ok := limiter.allow("client", now.Add(time.Second))
+		assert.False(t, ok)
+		assert.Equal(t, 59*time.Second, wait)
+		_, ok = limiter.allow("client", now.Add(time.Minute))
+		assert.True(t, ok)
+	})
+}
+`
+
+const templateLogging = `package {{.Package}}
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"time"
+)
+
+// NewMiddleware return the middleware that log the method, the path, the status, the bytes written and the duration
+// of the requests
+func NewMiddleware() func(http.Handler) http.Handler {
+	return newMiddleware(log.New(os.Stdout, "", log.LstdFlags))
+}
+
+func newMiddleware(logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+			logger.Printf("%s %s %d %dB %s", r.Method, r.URL.RequestURI(), recorder.status, recorder.size,
+				time.Since(start))
+		})
+	}
+}
+
+// responseRecorder keep the status and the size of the response written by the handlers
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(content []byte) (int, error) {
+	size, err := r.ResponseWriter.Write(content)
+	r.size += size
+	return size, err
+}
+`
+
+const templateLoggingTest = `package {{.Package}}
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	assert.NotNil(t, NewMiddleware())
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		status   int
+		expected string
+	}{
+		{name: "Should log request with status ok", method: http.MethodGet, path: "/api/v1/product?page=1",
+			status: http.StatusOK, expected: "GET /api/v1/product?page=1 200 2B"},
+		{name: "Should log request with status not found", method: http.MethodDelete, path: "/api/v1/product/1",
+			status: http.StatusNotFound, expected: "DELETE /api/v1/product/1 404 2B"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			middleware := newMiddleware(log.New(&buffer, "", 0))(http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte("{}"))
+				}))
+			w := httptest.NewRecorder()
+			middleware.ServeHTTP(w, httptest.NewRequest(test.method, test.path, nil))
+			assert.Equal(t, test.status, w.Code)
+			assert.Contains(t, buffer.String(), test.expected)
+		})
+	}
+}
+`
+
+const templateBodyLimit = `package {{.Package}}
+
+import (
+	"encoding/json"
+	"{{.Module}}/internal/utils/environment"
+	"net/http"
+)
+
+// NewMiddleware return the middleware that reject the requests with the body bigger than the bytes of the env
+// {{.Env}}_BYTES, the body without Content-Length return error to the handler when read more than the limit
+func NewMiddleware() func(http.Handler) http.Handler {
+	limit := int64(environment.GetEnvAndParseToInt("{{.Env}}_BYTES", 1<<20))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > limit {
+				sendError(w, http.StatusRequestEntityTooLarge, "body of the request is too large")
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+` + templateSendError
+
+const templateBodyLimitTest = `package {{.Package}}
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	assert.NoError(t, os.Setenv("{{.Env}}_BYTES", "10"))
+	defer os.Unsetenv("{{.Env}}_BYTES")
+	middleware := NewMiddleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := ioutil.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	tests := []struct {
+		name          string
+		body          string
+		contentLength int64
+		status        int
+	}{
+		{name: "Should return ok when body is in the limit", body: "{}", contentLength: 2, status: http.StatusOK},
+		{name: "Should return too large when content length is bigger than limit", body: "{\"name\": \"value\"}",
+			contentLength: 17, status: http.StatusRequestEntityTooLarge},
+		{name: "Should return error to handler when body without length is bigger than limit",
+			body: "{\"name\": \"value\"}", contentLength: -1, status: http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(test.body))
+			r.ContentLength = test.contentLength
+			w := httptest.NewRecorder()
+			middleware.ServeHTTP(w, r)
+			assert.Equal(t, test.status, w.Code)
+		})
+	}
+}
+`
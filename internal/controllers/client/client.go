@@ -0,0 +1,305 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/entities/client"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
+	"github.com/wilian746/go-generator/internal/utils/inflection"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const codeGeneratedHeader = "// Code generated by go-generator client. DO NOT EDIT."
+
+// fieldsTypesAllowed are the types of packages that the client can import, the fields with other types of packages
+// are decoded as json.RawMessage
+var fieldsTypesAllowed = map[string]string{
+	"time.Time":       "time",
+	"uuid.UUID":       "github.com/google/uuid",
+	"json.RawMessage": "encoding/json",
+}
+
+var templates = template.Must(template.New("client").Funcs(template.FuncMap{
+	"quote": func(tag string) string { return "`" + tag + "`" },
+}).Parse(templateClient))
+
+func init() {
+	template.Must(templates.New("client_test").Parse(templateClientTest))
+	template.Must(templates.New("resource").Parse(templateResource))
+	template.Must(templates.New("resource_test").Parse(templateResourceTest))
+}
+
+type Interface interface {
+	Generate(pathProject string) ([]string, error)
+}
+
+type Client struct {
+	path     string
+	module   string
+	basePath string
+}
+
+func NewClient() Interface {
+	return &Client{}
+}
+
+// Generate write the package pkg/client with the methods of the routes of each resource of the project and return
+// the files generated
+func (c *Client) Generate(pathProject string) ([]string, error) {
+	module, err := gomod.GetModulePath(pathProject)
+	if err != nil || module == "" {
+		return nil, errors.ErrClientModuleNotFound
+	}
+	c.path, c.module, c.basePath = pathProject, module, ""
+	resources, err := c.getResources()
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, errors.ErrClientResourcesNotFound
+	}
+	if err := c.removeFilesGenerated(); err != nil {
+		return nil, err
+	}
+	return c.writeFiles(resources)
+}
+
+func (c *Client) join(elem ...string) string {
+	return filepath.Join(append([]string{c.path}, elem...)...)
+}
+
+func (c *Client) getResources() (resources []client.Resource, err error) {
+	routesFiles, _ := filepath.Glob(c.join(string(folders.InternalRoutes), "*.go"))
+	for _, routesFile := range routesFiles {
+		if !goast.IsSourceFile(routesFile) || !goast.MatchBuildContext(routesFile) {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), routesFile, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		c.setBasePath(file)
+		resources = append(resources, c.getResourcesOfRoutes(file)...)
+	}
+	return resources, nil
+}
+
+func (c *Client) setBasePath(file *ast.File) {
+	if object := file.Scope.Lookup("BasePath"); object != nil && object.Kind == ast.Con {
+		if spec, ok := object.Decl.(*ast.ValueSpec); ok && len(spec.Values) == 1 {
+			c.basePath = goast.GetString(spec.Values[0])
+		}
+	}
+}
+
+// getResourcesOfRoutes return the resources of the functions of the router that register a handler of the project
+// in a route of the BasePath, like the RouterProduct
+func (c *Client) getResourcesOfRoutes(file *ast.File) (resources []client.Resource) {
+	imports := goast.GetImportsByName(file)
+	handlers := c.module + "/" + string(folders.InternalHandlers) + "/"
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Body == nil {
+			continue
+		}
+		handler, route := getHandlerAndRoute(function, imports)
+		if route == "" || !strings.HasPrefix(handler, handlers) {
+			continue
+		}
+		if resource, ok := c.getResource(strings.TrimPrefix(handler, handlers), route); ok {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+func getHandlerAndRoute(function *ast.FuncDecl, imports map[string]string) (handler, route string) {
+	ast.Inspect(function.Body, func(node ast.Node) bool {
+		switch value := node.(type) {
+		case *ast.SelectorExpr:
+			if ident, ok := value.X.(*ast.Ident); ok && value.Sel.Name == "NewHandler" {
+				handler = imports[ident.Name]
+			}
+		case *ast.BinaryExpr:
+			if ident, ok := value.X.(*ast.Ident); ok && ident.Name == "BasePath" && route == "" {
+				route = goast.GetString(value.Y)
+			}
+		}
+		return true
+	})
+	return handler, route
+}
+
+// getResource return the entity of the folder of the handler, the entity is the struct with the method TableName
+func (c *Client) getResource(folder, route string) (client.Resource, bool) {
+	entities := c.parseDir(path.Join(string(folders.InternalEntities), folder))
+	for _, file := range entities {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Name.Name != "TableName" || function.Recv == nil || len(function.Recv.List) != 1 {
+				continue
+			}
+			name := goast.GetReceiverName(function.Recv.List[0].Type)
+			if entityFile, structType := findStruct(entities, name); structType != nil {
+				return client.NewResource(name, route, c.getFields(entityFile, structType, false)), true
+			}
+		}
+	}
+	return client.Resource{}, false
+}
+
+func (c *Client) parseDir(folder string) []*ast.File {
+	files, _ := goast.ParseDir(c.join(folder), parser.ParseComments)
+	return files
+}
+
+func findStruct(files []*ast.File, name string) (*ast.File, *ast.StructType) {
+	for _, file := range files {
+		object := file.Scope.Lookup(name)
+		if object == nil || object.Kind != ast.Typ {
+			continue
+		}
+		if structType, ok := object.Decl.(*ast.TypeSpec).Type.(*ast.StructType); ok {
+			return file, structType
+		}
+	}
+	return nil, nil
+}
+
+func (c *Client) getFields(file *ast.File, structType *ast.StructType, base bool) (fields []client.Field) {
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			fields = append(fields, c.getEmbeddedFields(file, field.Type)...)
+			continue
+		}
+		tag := getJSONTag(field.Tag)
+		if tag == `json:"-"` {
+			continue
+		}
+		for _, name := range field.Names {
+			if name.IsExported() {
+				fields = append(fields, client.NewField(name.Name, getFieldType(field.Type), tag, base))
+			}
+		}
+	}
+	return fields
+}
+
+// getEmbeddedFields return the fields of the structs of the project embedded in the entity, like the entities.Base
+func (c *Client) getEmbeddedFields(file *ast.File, expr ast.Expr) []client.Field {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	ident, ok := selector.X.(*ast.Ident)
+	if !ok || !strings.HasPrefix(goast.GetImportsByName(file)[ident.Name], c.module+"/") {
+		return nil
+	}
+	folder := strings.TrimPrefix(goast.GetImportsByName(file)[ident.Name], c.module+"/")
+	embeddedFile, structType := findStruct(c.parseDir(folder), selector.Sel.Name)
+	if structType == nil {
+		return nil
+	}
+	return c.getFields(embeddedFile, structType, true)
+}
+
+func getFieldType(expr ast.Expr) string {
+	allowed := true
+	ast.Inspect(expr, func(node ast.Node) bool {
+		switch value := node.(type) {
+		case *ast.SelectorExpr:
+			_, ok := fieldsTypesAllowed[types.ExprString(value)]
+			allowed = allowed && ok
+			return false
+		case *ast.Ident:
+			allowed = allowed && types.Universe.Lookup(value.Name) != nil
+		case *ast.StructType, *ast.FuncType, *ast.ChanType:
+			allowed = false
+		}
+		return true
+	})
+	if !allowed {
+		return "json.RawMessage"
+	}
+	return types.ExprString(expr)
+}
+
+func getJSONTag(tag *ast.BasicLit) string {
+	if tag == nil {
+		return ""
+	}
+	value, _ := strconv.Unquote(tag.Value)
+	if name, ok := reflect.StructTag(value).Lookup("json"); ok {
+		return fmt.Sprintf("json:%q", name)
+	}
+	return ""
+}
+
+// removeFilesGenerated remove the files generated before, so the resources removed of the project are removed of
+// the client, the files written by hand are kept
+func (c *Client) removeFilesGenerated() error {
+	goFiles, _ := filepath.Glob(c.join(string(folders.PkgClient), "*.go"))
+	for _, goFile := range goFiles {
+		content, err := ioutil.ReadFile(goFile)
+		if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(content, []byte(codeGeneratedHeader)) {
+			if err := os.Remove(goFile); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) writeFiles(resources []client.Resource) ([]string, error) {
+	if err := os.MkdirAll(c.join(string(folders.PkgClient)), os.ModePerm); err != nil {
+		return nil, err
+	}
+	data := map[string]string{"Module": c.module, "BasePath": c.basePath}
+	generated := []string{}
+	for _, file := range [][2]string{{"client.go", "client"}, {"client_test.go", "client_test"}} {
+		if err := c.writeFile(file[0], file[1], data); err != nil {
+			return nil, err
+		}
+		generated = append(generated, path.Join(string(folders.PkgClient), file[0]))
+	}
+	for _, resource := range resources {
+		snake := inflection.NewName(resource.Name).Snake()
+		for _, file := range [][2]string{{snake + ".go", "resource"}, {snake + "_test.go", "resource_test"}} {
+			if err := c.writeFile(file[0], file[1], newResourceData(resource)); err != nil {
+				return nil, err
+			}
+			generated = append(generated, path.Join(string(folders.PkgClient), file[0]))
+		}
+	}
+	return generated, nil
+}
+
+func (c *Client) writeFile(name, templateName string, data interface{}) error {
+	var buffer bytes.Buffer
+	if err := templates.ExecuteTemplate(&buffer, templateName, data); err != nil {
+		return err
+	}
+	content, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return ioutil.WriteFile(c.join(string(folders.PkgClient), name), content, 0600)
+}
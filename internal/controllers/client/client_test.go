@@ -0,0 +1,120 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/testutil"
+	"go/parser"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readGenerated(t *testing.T, dir, file string) string {
+	content, err := ioutil.ReadFile(filepath.Join(dir, "pkg", "client", file))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestClient_Generate(t *testing.T) {
+	t.Run("Should generate client with the methods of the routes of product", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		generated, err := NewClient().Generate(dir)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"pkg/client/client.go", "pkg/client/client_test.go", "pkg/client/product.go",
+			"pkg/client/product_test.go"}, generated)
+		assert.Contains(t, readGenerated(t, dir, "client.go"), `const BasePath = "/api/v1"`)
+		product := readGenerated(t, dir, "product.go")
+		assert.Contains(t, product, "	ID        uuid.UUID `json:\"id\"`")
+		assert.Contains(t, product, "type RequestBodyToCreateOrUpdateProduct struct {\n\tName string `json:\"name\"`\n}")
+		assert.Contains(t, product, "func (c *Client) ListAllProducts(ctx context.Context) ([]Product, error) {")
+		assert.Contains(t, product, `c.do(ctx, http.MethodDelete, fmt.Sprintf("/product/%v", ID), nil, nil)`)
+		assert.NotContains(t, product, testutil.TemplateModule)
+	})
+	t.Run("Should generate client with the route and the fields of entity changed", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		replaceInFile(t, filepath.Join(dir, "internal", "routes", "routes.go"), `"/product"`, `"/products"`)
+		replaceInFile(t, filepath.Join(dir, "internal", "entities", "product", "product.go"), "Name string `json:\"name\"`",
+			"Name string `json:\"name\"`\n\tPrice float64 `json:\"price\" gorm:\"not null\"`\n\tSecret string `json:\"-\"`\n"+
+				"\tOwner entities.Base")
+		_, err := NewClient().Generate(dir)
+		assert.NoError(t, err)
+		product := readGenerated(t, dir, "product.go")
+		assert.Contains(t, product, `http.MethodGet, "/products", nil, &list)`)
+		assert.Contains(t, product, "Price float64 `json:\"price\"`")
+		assert.Contains(t, product, "Owner json.RawMessage")
+		assert.NotContains(t, product, "Secret")
+	})
+	t.Run("Should remove only the files generated before", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "pkg", "client"), os.ModePerm))
+		old := filepath.Join(dir, "pkg", "client", "order.go")
+		assert.NoError(t, ioutil.WriteFile(old, []byte(codeGeneratedHeader+"\n\npackage client\n"), 0600))
+		custom := filepath.Join(dir, "pkg", "client", "custom.go")
+		assert.NoError(t, ioutil.WriteFile(custom, []byte("package client\n"), 0600))
+		_, err := NewClient().Generate(dir)
+		assert.NoError(t, err)
+		assert.NoFileExists(t, old)
+		assert.FileExists(t, custom)
+	})
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "client")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		_, err = NewClient().Generate(dir)
+		assert.Equal(t, errors.ErrClientModuleNotFound, err)
+	})
+	t.Run("Should return error when project not have resources in routes", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.RemoveAll(filepath.Join(dir, "internal", "entities", "product")))
+		_, err := NewClient().Generate(dir)
+		assert.Equal(t, errors.ErrClientResourcesNotFound, err)
+	})
+}
+
+func TestClient_GenerateTests(t *testing.T) {
+	if testing.Short() {
+		t.Skip("run the tests of the client generated")
+	}
+	t.Run("Should generate client with tests passing against the httptest server", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		testutil.WriteGoModOfRoot(t, dir)
+		_, err := NewClient().Generate(dir)
+		assert.NoError(t, err)
+		cmd := exec.Command("go", "test", "-mod=mod", "./pkg/client/")
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(output))
+	})
+}
+
+func TestGetFieldType(t *testing.T) {
+	t.Run("Should keep types of the standard library allowed", func(t *testing.T) {
+		for _, fieldType := range []string{"string", "*int64", "[]time.Time", "map[string]uuid.UUID", "interface{}"} {
+			expr, err := parser.ParseExpr(fieldType)
+			assert.NoError(t, err)
+			assert.Equal(t, fieldType, getFieldType(expr))
+		}
+	})
+	t.Run("Should return raw message to the types of the project", func(t *testing.T) {
+		for _, fieldType := range []string{"order.Order", "[]Item", "struct{ Name string }", "func()"} {
+			expr, err := parser.ParseExpr(fieldType)
+			assert.NoError(t, err)
+			assert.Equal(t, "json.RawMessage", getFieldType(expr))
+		}
+	})
+}
+
+func replaceInFile(t *testing.T, path, old, new string) {
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(path, []byte(strings.Replace(string(content), old, new, 1)), 0600))
+}
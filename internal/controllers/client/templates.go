@@ -0,0 +1,490 @@
+package client
+
+import (
+	"github.com/wilian746/go-generator/internal/entities/client"
+	"github.com/wilian746/go-generator/internal/utils/inflection"
+	"sort"
+	"strings"
+)
+
+type resourceData struct {
+	client.Resource
+	Plural      string
+	Words       string
+	PluralWords string
+	IDType      string
+	IDValue     string
+	BodyFields  []client.Field
+	Imports     []string
+	// ImportsExternal are the imports of packages that are not of the standard library
+	ImportsExternal []string
+	UsesUUID        bool
+}
+
+func newResourceData(resource client.Resource) *resourceData {
+	name := inflection.NewName(resource.Name)
+	return &resourceData{
+		Resource:        resource,
+		Plural:          name.Plural().Pascal(),
+		Words:           name.Words(),
+		PluralWords:     name.Plural().Words(),
+		IDType:          resource.GetIDType(),
+		IDValue:         getIDValue(resource.GetIDType()),
+		BodyFields:      resource.GetBodyFields(),
+		Imports:         getResourceImports(resource, false),
+		ImportsExternal: getResourceImports(resource, true),
+		UsesUUID:        resource.GetIDType() == "uuid.UUID",
+	}
+}
+
+// getIDValue return the value of the ID used in the tests of the routes with {ID}
+func getIDValue(idType string) string {
+	switch idType {
+	case "uuid.UUID":
+		return "uuid.New()"
+	case "string":
+		return `"1"`
+	default:
+		return idType + "(1)"
+	}
+}
+
+func getResourceImports(resource client.Resource, external bool) (imports []string) {
+	if !external {
+		imports = []string{"context", "fmt", "net/http"}
+	}
+	for selector, importPath := range fieldsTypesAllowed {
+		if strings.Contains(importPath, ".") != external {
+			continue
+		}
+		for _, field := range resource.Fields {
+			if strings.Contains(field.Type, selector) {
+				imports = append(imports, importPath)
+				break
+			}
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+// templateClient is the client with the errors, retries and decode of the response shared by all resources
+const templateClient = `// Code generated by go-generator client. DO NOT EDIT.
+
+// Package client call the routes of the API {{.Module}} with the entities typed
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const BasePath = "{{.BasePath}}"
+
+// BadRequestError is returned when the API respond with status 400
+type BadRequestError struct {
+	Message string
+}
+
+func (e *BadRequestError) Error() string {
+	return "bad request: " + e.Message
+}
+
+// NotFoundError is returned when the API respond with status 404
+type NotFoundError struct {
+	Message string
+}
+
+func (e *NotFoundError) Error() string {
+	return "not found: " + e.Message
+}
+
+// InternalServerError is returned when the API respond with status 500
+type InternalServerError struct {
+	Message string
+}
+
+func (e *InternalServerError) Error() string {
+	return "internal server error: " + e.Message
+}
+
+// StatusError is returned when the API respond with other status of error
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Message)
+}
+
+type Option func(c *Client)
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries retry the requests that failed by network or with status 500 or more, except the post that is not
+// idempotent
+func WithRetries(retries int, wait time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.wait = wait
+	}
+}
+
+type Client struct {
+	host       string
+	httpClient *http.Client
+	retries    int
+	wait       time.Duration
+}
+
+// NewClient return the client of the API running in the host, like http://localhost:8080
+func NewClient(host string, options ...Option) *Client {
+	c := &Client{
+		host:       strings.TrimSuffix(host, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+type response struct {
+	Status int
+	Result json.RawMessage
+}
+
+type responseError struct {
+	Result struct {
+		Error string
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var payload []byte
+	if body != nil {
+		marshaled, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = marshaled
+	}
+	statusCode, content, err := c.send(ctx, method, path, payload)
+	for attempt := 0; attempt < c.retries && isRetryable(method, statusCode, err); attempt++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.wait):
+		}
+		statusCode, content, err = c.send(ctx, method, path, payload)
+	}
+	if err != nil {
+		return err
+	}
+	return decodeResponse(statusCode, content, result)
+}
+
+func (c *Client) send(ctx context.Context, method, path string, payload []byte) (int, []byte, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, c.host+BasePath+path, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	res, err := c.httpClient.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer res.Body.Close()
+	content, err := ioutil.ReadAll(res.Body)
+	return res.StatusCode, content, err
+}
+
+func isRetryable(method string, statusCode int, err error) bool {
+	return method != http.MethodPost && (err != nil || statusCode >= http.StatusInternalServerError)
+}
+
+func decodeResponse(statusCode int, content []byte, result interface{}) error {
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return newError(statusCode, content)
+	}
+	if statusCode == http.StatusNoContent || result == nil {
+		return nil
+	}
+	decoded := response{}
+	if err := json.Unmarshal(content, &decoded); err != nil {
+		return err
+	}
+	return json.Unmarshal(decoded.Result, result)
+}
+
+func newError(statusCode int, content []byte) error {
+	decoded := responseError{}
+	_ = json.Unmarshal(content, &decoded)
+	switch statusCode {
+	case http.StatusBadRequest:
+		return &BadRequestError{Message: decoded.Result.Error}
+	case http.StatusNotFound:
+		return &NotFoundError{Message: decoded.Result.Error}
+	case http.StatusInternalServerError:
+		return &InternalServerError{Message: decoded.Result.Error}
+	default:
+		return &StatusError{StatusCode: statusCode, Message: decoded.Result.Error}
+	}
+}
+`
+
+const templateClientTest = `// Code generated by go-generator client. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newServer(t *testing.T, method, path string, status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, method, r.Method)
+		assert.Equal(t, BasePath+path, r.URL.Path)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func newServerWithStatus(attempts *int, status ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status[*attempts])
+		_, _ = w.Write([]byte("{\"status\":200,\"result\":\"ok\"}"))
+		*attempts++
+	}))
+}
+
+func TestClient_do(t *testing.T) {
+	t.Run("Should return error typed by status of the response", func(t *testing.T) {
+		for status, expected := range map[int]error{
+			http.StatusBadRequest:          &BadRequestError{Message: "invalid"},
+			http.StatusNotFound:            &NotFoundError{Message: "invalid"},
+			http.StatusInternalServerError: &InternalServerError{Message: "invalid"},
+			http.StatusConflict:            &StatusError{StatusCode: http.StatusConflict, Message: "invalid"},
+		} {
+			server := newServer(t, http.MethodGet, "/", status, "{\"status\":0,\"result\":{\"error\":\"invalid\"}}")
+			err := NewClient(server.URL).do(context.Background(), http.MethodGet, "/", nil, nil)
+			server.Close()
+			assert.Equal(t, expected, err)
+		}
+	})
+	t.Run("Should retry the requests that failed with status of server error", func(t *testing.T) {
+		attempts := 0
+		server := newServerWithStatus(&attempts, http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK)
+		defer server.Close()
+		result := ""
+		err := NewClient(server.URL, WithRetries(2, time.Millisecond)).
+			do(context.Background(), http.MethodGet, "/", nil, &result)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", result)
+		assert.Equal(t, 3, attempts)
+	})
+	t.Run("Should not retry the requests of post", func(t *testing.T) {
+		attempts := 0
+		server := newServerWithStatus(&attempts, http.StatusInternalServerError, http.StatusOK)
+		defer server.Close()
+		err := NewClient(server.URL, WithRetries(2, time.Millisecond)).
+			do(context.Background(), http.MethodPost, "/", nil, nil)
+		assert.IsType(t, &InternalServerError{}, err)
+		assert.Equal(t, 1, attempts)
+	})
+	t.Run("Should return error when context is canceled", func(t *testing.T) {
+		attempts := 0
+		server := newServerWithStatus(&attempts, http.StatusOK)
+		defer server.Close()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := NewClient(server.URL, WithRetries(2, time.Millisecond)).do(ctx, http.MethodGet, "/", nil, nil)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, 0, attempts)
+	})
+}
+`
+
+// templateResource is the entity, the body and the methods of the routes of one resource
+const templateResource = `// Code generated by go-generator client. DO NOT EDIT.
+
+package client
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+{{if .ImportsExternal}}
+{{- range .ImportsExternal}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+
+// {{.Name}} is the entity of the routes of {{.Path}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}}{{with .Tag}} {{quote .}}{{end}}
+{{- end}}
+}
+
+// RequestBodyToCreateOrUpdate{{.Name}} is the body sent to create or update the {{.Words}}
+type RequestBodyToCreateOrUpdate{{.Name}} struct {
+{{- range .BodyFields}}
+	{{.Name}} {{.Type}}{{with .Tag}} {{quote .}}{{end}}
+{{- end}}
+}
+
+// ListAll{{.Plural}} call GET {{.Path}}
+func (c *Client) ListAll{{.Plural}}(ctx context.Context) ([]{{.Name}}, error) {
+	list := []{{.Name}}{}
+	if err := c.do(ctx, http.MethodGet, "{{.Path}}", nil, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ListOne{{.Name}} call GET {{.Path}}/{ID}
+func (c *Client) ListOne{{.Name}}(ctx context.Context, ID {{.IDType}}) (*{{.Name}}, error) {
+	entity := &{{.Name}}{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("{{.Path}}/%v", ID), nil, entity); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// Create{{.Name}} call POST {{.Path}} and return the ID created
+func (c *Client) Create{{.Name}}(ctx context.Context, body *RequestBodyToCreateOrUpdate{{.Name}}) ({{.IDType}}, error) {
+	created := struct {
+		ID {{.IDType}}
+	}{}
+	err := c.do(ctx, http.MethodPost, "{{.Path}}", body, &created)
+	return created.ID, err
+}
+
+// Update{{.Name}} call PUT {{.Path}}/{ID}
+func (c *Client) Update{{.Name}}(ctx context.Context, ID {{.IDType}}, body *RequestBodyToCreateOrUpdate{{.Name}}) error {
+	return c.do(ctx, http.MethodPut, fmt.Sprintf("{{.Path}}/%v", ID), body, nil)
+}
+
+// Delete{{.Name}} call DELETE {{.Path}}/{ID}
+func (c *Client) Delete{{.Name}}(ctx context.Context, ID {{.IDType}}) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("{{.Path}}/%v", ID), nil, nil)
+}
+`
+
+const templateResourceTest = `// Code generated by go-generator client. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+{{if .UsesUUID}}
+	"github.com/google/uuid"
+{{- end}}
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ListAll{{.Plural}}(t *testing.T) {
+	t.Run("Should return the {{.PluralWords}} of the result of the response", func(t *testing.T) {
+		server := newServer(t, http.MethodGet, "{{.Path}}", http.StatusOK, "{\"status\":200,\"result\":[{}]}")
+		defer server.Close()
+		list, err := NewClient(server.URL).ListAll{{.Plural}}(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+	})
+}
+
+func TestClient_ListOne{{.Name}}(t *testing.T) {
+	t.Run("Should return the {{.Words}} of the result of the response", func(t *testing.T) {
+		ID := {{.IDValue}}
+		server := newServer(t, http.MethodGet, fmt.Sprintf("{{.Path}}/%v", ID), http.StatusOK,
+			"{\"status\":200,\"result\":{}}")
+		defer server.Close()
+		entity, err := NewClient(server.URL).ListOne{{.Name}}(context.Background(), ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, entity)
+	})
+	t.Run("Should return not found error when the {{.Words}} not exists", func(t *testing.T) {
+		ID := {{.IDValue}}
+		server := newServer(t, http.MethodGet, fmt.Sprintf("{{.Path}}/%v", ID), http.StatusNotFound,
+			"{\"status\":404,\"result\":{\"error\":\"record not found\"}}")
+		defer server.Close()
+		_, err := NewClient(server.URL).ListOne{{.Name}}(context.Background(), ID)
+		assert.Equal(t, &NotFoundError{Message: "record not found"}, err)
+	})
+}
+
+func TestClient_Create{{.Name}}(t *testing.T) {
+	t.Run("Should return the ID of the {{.Words}} created", func(t *testing.T) {
+		ID := {{.IDValue}}
+		content, _ := json.Marshal(map[string]interface{}{"status": http.StatusOK, "result": map[string]interface{}{"id": ID}})
+		server := newServer(t, http.MethodPost, "{{.Path}}", http.StatusOK, string(content))
+		defer server.Close()
+		created, err := NewClient(server.URL).Create{{.Name}}(context.Background(), &RequestBodyToCreateOrUpdate{{.Name}}{})
+		assert.NoError(t, err)
+		assert.Equal(t, ID, created)
+	})
+	t.Run("Should return bad request error when the body is invalid", func(t *testing.T) {
+		server := newServer(t, http.MethodPost, "{{.Path}}", http.StatusBadRequest,
+			"{\"status\":400,\"result\":{\"error\":\"body is invalid\"}}")
+		defer server.Close()
+		_, err := NewClient(server.URL).Create{{.Name}}(context.Background(), &RequestBodyToCreateOrUpdate{{.Name}}{})
+		assert.Equal(t, &BadRequestError{Message: "body is invalid"}, err)
+	})
+}
+
+func TestClient_Update{{.Name}}(t *testing.T) {
+	t.Run("Should not return error when the {{.Words}} is updated", func(t *testing.T) {
+		ID := {{.IDValue}}
+		server := newServer(t, http.MethodPut, fmt.Sprintf("{{.Path}}/%v", ID), http.StatusNoContent, "")
+		defer server.Close()
+		err := NewClient(server.URL).Update{{.Name}}(context.Background(), ID, &RequestBodyToCreateOrUpdate{{.Name}}{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestClient_Delete{{.Name}}(t *testing.T) {
+	t.Run("Should not return error when the {{.Words}} is deleted", func(t *testing.T) {
+		ID := {{.IDValue}}
+		server := newServer(t, http.MethodDelete, fmt.Sprintf("{{.Path}}/%v", ID), http.StatusNoContent, "")
+		defer server.Close()
+		assert.NoError(t, NewClient(server.URL).Delete{{.Name}}(context.Background(), ID))
+	})
+	t.Run("Should return internal server error when the API fail", func(t *testing.T) {
+		ID := {{.IDValue}}
+		server := newServer(t, http.MethodDelete, fmt.Sprintf("{{.Path}}/%v", ID), http.StatusInternalServerError,
+			"{\"status\":500,\"result\":{\"error\":\"database is down\"}}")
+		defer server.Close()
+		err := NewClient(server.URL).Delete{{.Name}}(context.Background(), ID)
+		assert.Equal(t, &InternalServerError{Message: "database is down"}, err)
+	})
+}
+`
@@ -0,0 +1,322 @@
+package collection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/entities/collection"
+	"github.com/wilian746/go-generator/internal/entities/swagger"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	FileHTTP               = "api.http"
+	FileHTTPEnvironment    = "http-client.env.json"
+	FilePostmanCollection  = "postman_collection.json"
+	FilePostmanEnvironment = "postman_environment.json"
+)
+
+var (
+	pathParamRegex = regexp.MustCompile(`{([^}]+)}`)
+	packageRegex   = regexp.MustCompile(`[^a-z0-9]+`)
+	methodsOrder   = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+)
+
+var templates = template.Must(template.New("http").Parse(templateHTTP))
+
+type Interface interface {
+	Generate(pathProject, out string) ([]string, error)
+}
+
+type Collection struct {
+	path   string
+	spec   *swagger.Swagger
+	mocks  map[string]mock
+	params []string
+}
+
+func NewCollection() Interface {
+	return &Collection{}
+}
+
+// Generate write in the out the .http file and the postman collection of the routes of docs/swagger.json of the
+// project, with the files of the variables of both, and return the files generated
+func (c *Collection) Generate(pathProject, out string) ([]string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(pathProject, string(files.DocsSwaggerJSON)))
+	if err != nil {
+		return nil, errors.ErrCollectionSwaggerNotFound
+	}
+	if c.spec, err = swagger.NewSwagger(content); err != nil {
+		return nil, fmt.Errorf("%s: %w", files.DocsSwaggerJSON, err)
+	}
+	c.path, c.mocks, c.params = pathProject, map[string]mock{}, nil
+	requests, err := c.getRequests()
+	if err != nil {
+		return nil, err
+	}
+	variables := collection.NewVariables(collection.DefaultHost, c.getPort(), c.getBasePath(), c.params)
+	if err := os.MkdirAll(out, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return writeFiles(out, c.getName(), variables, requests)
+}
+
+func (c *Collection) join(elem ...string) string {
+	return filepath.Join(append([]string{c.path}, elem...)...)
+}
+
+func (c *Collection) getName() string {
+	if c.spec.Info.Title != "" {
+		return c.spec.Info.Title
+	}
+	absolute, _ := filepath.Abs(c.path)
+	return filepath.Base(absolute)
+}
+
+func (c *Collection) getRequests() (requests []collection.Request, err error) {
+	for _, route := range getSortedKeys(c.spec.Paths) {
+		for _, method := range methodsOrder {
+			operation, ok := c.spec.Paths[route][method]
+			if !ok {
+				continue
+			}
+			request, err := c.getRequest(route, method, &operation)
+			if err != nil {
+				return nil, err
+			}
+			requests = append(requests, request)
+		}
+	}
+	return requests, nil
+}
+
+func (c *Collection) getRequest(route, method string, operation *swagger.Operation) (collection.Request, error) {
+	folder := "Default"
+	if len(operation.Tags) > 0 {
+		folder = operation.Tags[0]
+	}
+	name := operation.Summary
+	if name == "" {
+		name = operation.Description
+	}
+	if name == "" {
+		name = strings.ToUpper(method) + " " + route
+	}
+	body, err := c.getBody(operation.Parameters)
+	if err != nil {
+		return collection.Request{}, err
+	}
+	request := collection.NewRequest(folder, name, strings.ToUpper(method), c.getPath(route), body)
+	if method == "post" {
+		request.Variable = c.getVariableOfCreate(route)
+	}
+	return request, nil
+}
+
+// getPath replace the params of the path by the variables of the resource of the route, like the {ID} of the
+// /product/{ID} by the {{productId}}, the variables are kept in the order found to the files of variables
+func (c *Collection) getPath(route string) string {
+	resource := getPackageOfRoute(route)
+	return pathParamRegex.ReplaceAllStringFunc(route, func(param string) string {
+		variable := getVariableOfParam(resource, strings.Trim(param, "{}"))
+		if !contains(c.params, variable) {
+			c.params = append(c.params, variable)
+		}
+		return "{{" + variable + "}}"
+	})
+}
+
+// getVariableOfCreate return the variable of the id of the route created by the post, like the productId of the
+// /product/{ID} to the post of the /product, and empty when the route not have a route by id
+func (c *Collection) getVariableOfCreate(route string) string {
+	for _, path := range getSortedKeys(c.spec.Paths) {
+		param := strings.TrimPrefix(path, strings.TrimSuffix(route, "/")+"/")
+		if param != path && pathParamRegex.FindString(param) == param {
+			return getVariableOfParam(getPackageOfRoute(route), strings.Trim(param, "{}"))
+		}
+	}
+	return ""
+}
+
+// getVariableOfParam return the name of the variable of the param of the resource, the param ID is prefixed by the
+// resource like productId to not conflict between the resources
+func getVariableOfParam(resource, param string) string {
+	if strings.EqualFold(param, "id") {
+		return resource + "Id"
+	}
+	return param
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// getPackageOfRoute return the package of the resource of the route, like shipmentitem to /shipment-item/{ID}
+func getPackageOfRoute(route string) string {
+	segment := strings.Split(strings.TrimPrefix(route, "/"), "/")[0]
+	return packageRegex.ReplaceAllString(strings.ToLower(segment), "")
+}
+
+// getBody return the example of the body of the route, the values of the fields come from the Rules.GetMock of the
+// package of the definition and the fields not found in the mock come from the swagger docs
+func (c *Collection) getBody(parameters []swagger.Parameter) (json.RawMessage, error) {
+	for index := range parameters {
+		if parameters[index].In != "body" {
+			continue
+		}
+		value := c.getExample(parameters[index].Schema, 0)
+		body, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return body, nil
+	}
+	return nil, nil
+}
+
+func (c *Collection) getExample(schema *swagger.Schema, depth int) interface{} {
+	if definition := c.spec.GetDefinition(schema); definition != nil && depth < 5 {
+		name := strings.TrimPrefix(schema.Ref, swagger.RefPrefix)
+		return c.getExampleOfDefinition(name, definition, depth+1)
+	}
+	switch {
+	case schema == nil:
+		return nil
+	case schema.Example != nil:
+		return schema.Example
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	}
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	case "array":
+		return []interface{}{c.getExample(schema.Items, depth+1)}
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+// getExampleOfDefinition return the object in the order of the fields of the struct of the definition, like the
+// product.RequestBodyToCreateOrUpdateProduct of the internal/entities/product
+func (c *Collection) getExampleOfDefinition(name string, definition *swagger.Schema, depth int) object {
+	pkg, structName := "", name
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		pkg, structName = name[:index], name[index+1:]
+	}
+	mock := c.getMock(pkg)
+	fields := c.getJSONFields(pkg, structName)
+	if len(fields) == 0 {
+		for _, property := range getSortedKeys(definition.Properties) {
+			fields = append(fields, [2]string{property, property})
+		}
+	}
+	example := object{}
+	for _, field := range fields {
+		property, ok := definition.Properties[field[1]]
+		if !ok {
+			continue
+		}
+		value, ok := mock.values[field[0]]
+		if !ok {
+			value = c.getExample(property, depth)
+		}
+		example = append(example, [2]interface{}{field[1], value})
+	}
+	return example
+}
+
+func (c *Collection) getMock(pkg string) mock {
+	if _, ok := c.mocks[pkg]; !ok && pkg != "" {
+		c.mocks[pkg] = c.parseMock(path.Join(string(folders.InternalRules), pkg))
+	}
+	return c.mocks[pkg]
+}
+
+// object is a json object that keep the order of the keys
+type object [][2]interface{}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteString("{")
+	for index, value := range o {
+		if index > 0 {
+			buffer.WriteString(",")
+		}
+		key, _ := json.Marshal(value[0])
+		content, err := json.Marshal(value[1])
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key)
+		buffer.WriteString(":")
+		buffer.Write(content)
+	}
+	buffer.WriteString("}")
+	return buffer.Bytes(), nil
+}
+
+func writeFiles(out, name string, variables collection.Variables, requests []collection.Request) ([]string, error) {
+	var buffer bytes.Buffer
+	if err := templates.Execute(&buffer, map[string]interface{}{"Requests": requests}); err != nil {
+		return nil, err
+	}
+	environment := map[string]map[string]string{"local": {}}
+	for _, value := range variables.GetValues() {
+		environment["local"][value[0]] = value[1]
+	}
+	contents := map[string]interface{}{
+		FileHTTPEnvironment:    environment,
+		FilePostmanCollection:  collection.NewPostmanCollection(name, variables, requests),
+		FilePostmanEnvironment: collection.NewPostmanEnvironment(name, variables),
+	}
+	generated := []string{filepath.Join(out, FileHTTP)}
+	if err := ioutil.WriteFile(generated[0], buffer.Bytes(), 0600); err != nil {
+		return nil, err
+	}
+	for _, file := range []string{FileHTTPEnvironment, FilePostmanCollection, FilePostmanEnvironment} {
+		content, err := json.MarshalIndent(contents[file], "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(filepath.Join(out, file), append(content, '\n'), 0600); err != nil {
+			return nil, err
+		}
+		generated = append(generated, filepath.Join(out, file))
+	}
+	return generated, nil
+}
+
+func getSortedKeys(values interface{}) (keys []string) {
+	switch value := values.(type) {
+	case map[string]*swagger.Schema:
+		for key := range value {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]swagger.Operation:
+		for key := range value {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,141 @@
+package collection
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/entities/collection"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"go/ast"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var pathTemplate = filepath.Join("..", "..", "..", "pkg", "standart-gorm")
+
+func readFile(t *testing.T, dir, file string) string {
+	content, err := ioutil.ReadFile(filepath.Join(dir, file))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestCollection_Generate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "collection")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	generated, err := NewCollection().Generate(pathTemplate, dir)
+	assert.NoError(t, err)
+	t.Run("Should return the files generated", func(t *testing.T) {
+		assert.Equal(t, []string{
+			filepath.Join(dir, FileHTTP),
+			filepath.Join(dir, FileHTTPEnvironment),
+			filepath.Join(dir, FilePostmanCollection),
+			filepath.Join(dir, FilePostmanEnvironment),
+		}, generated)
+	})
+	t.Run("Should generate the .http with the body and the variable of the ID set by the create", func(t *testing.T) {
+		content := readFile(t, dir, FileHTTP)
+		assert.Contains(t, content, "### Product: Create an product\n"+
+			"POST http://{{host}}:{{port}}{{basePath}}/product\nContent-Type: application/json\n\n{\n  \"name\": \""+
+			uuid.Nil.String()+"\"\n}\n\n"+
+			"> {% client.global.set(\"productId\", (response.body.result || response.body).id); %}\n")
+		assert.Contains(t, content, "GET http://{{host}}:{{port}}{{basePath}}/health")
+		assert.Contains(t, content, "DELETE http://{{host}}:{{port}}{{basePath}}/product/{{productId}}\n")
+		assert.NotContains(t, content, "{ID}")
+	})
+	t.Run("Should generate the same files in each run", func(t *testing.T) {
+		other, err := ioutil.TempDir("", "collection")
+		assert.NoError(t, err)
+		defer os.RemoveAll(other)
+		_, err = NewCollection().Generate(pathTemplate, other)
+		assert.NoError(t, err)
+		for _, file := range []string{FileHTTP, FileHTTPEnvironment, FilePostmanCollection, FilePostmanEnvironment} {
+			assert.Equal(t, readFile(t, dir, file), readFile(t, other, file), file)
+		}
+	})
+	t.Run("Should generate the variables with the port of the configs and the BasePath of the routes", func(t *testing.T) {
+		environment := map[string]map[string]string{}
+		assert.NoError(t, json.Unmarshal([]byte(readFile(t, dir, FileHTTPEnvironment)), &environment))
+		assert.Equal(t, map[string]string{"host": "localhost", "port": "8080", "basePath": "/api/v1", "productId": ""},
+			environment["local"])
+		postmanEnvironment := collection.PostmanEnvironment{}
+		assert.NoError(t, json.Unmarshal([]byte(readFile(t, dir, FilePostmanEnvironment)), &postmanEnvironment))
+		assert.Len(t, postmanEnvironment.Values, 4)
+	})
+	t.Run("Should generate the postman collection with a folder by tag", func(t *testing.T) {
+		postman := collection.PostmanCollection{}
+		assert.NoError(t, json.Unmarshal([]byte(readFile(t, dir, FilePostmanCollection)), &postman))
+		assert.Equal(t, collection.PostmanSchema, postman.Info.Schema)
+		assert.Equal(t, "Standart Gorm", postman.Info.Name)
+		assert.Len(t, postman.Item, 2)
+		assert.Equal(t, "Product", postman.Item[1].Name)
+		assert.Len(t, postman.Item[1].Item, 5)
+		create := postman.Item[1].Item[1].Request
+		assert.Equal(t, "POST", create.Method)
+		body := map[string]string{}
+		assert.NoError(t, json.Unmarshal([]byte(create.Body.Raw), &body))
+		assert.Equal(t, uuid.Nil.String(), body["name"])
+		assert.Len(t, postman.Item[1].Item[1].Event, 1)
+		assert.Equal(t, "http://{{host}}:{{port}}{{basePath}}/product/{{productId}}", postman.Item[1].Item[4].Request.URL)
+	})
+	t.Run("Should return error when not found the swagger docs", func(t *testing.T) {
+		_, err := NewCollection().Generate(dir, dir)
+		assert.Equal(t, errors.ErrCollectionSwaggerNotFound, err)
+	})
+}
+
+func TestCollection_parseMock(t *testing.T) {
+	t.Run("Should return the values of the literals and of the calls known", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "collection")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "rules"), os.ModePerm))
+		source := `package rules
+
+func (r *Rules) GetMock() *item.Item {
+	return &item.Item{
+		Base:     entities.Base{ID: uuid.New(), CreatedAt: time.Now()},
+		Name:     "item",
+		Quantity: -2,
+		Price:    1.5,
+		Active:   true,
+		Owner:    getOwner(),
+	}
+}
+`
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "rules", "rules.go"), []byte(source), 0600))
+		mock := (&Collection{path: dir}).parseMock("rules")
+		assert.Equal(t, "item", mock.values["Name"])
+		assert.Equal(t, int64(-2), mock.values["Quantity"])
+		assert.Equal(t, 1.5, mock.values["Price"])
+		assert.Equal(t, true, mock.values["Active"])
+		assert.Equal(t, uuid.Nil.String(), mock.values["ID"])
+		assert.Equal(t, "1970-01-01T00:00:00Z", mock.values["CreatedAt"])
+		assert.NotContains(t, mock.values, "Owner")
+	})
+}
+
+func TestCollection_getJSONName(t *testing.T) {
+	t.Run("Should return the name of the tag json or the name of the field", func(t *testing.T) {
+		assert.Equal(t, "name", getJSONName("Name", &ast.BasicLit{Value: "`json:\"name,omitempty\"`"}))
+		assert.Equal(t, "-", getJSONName("Name", &ast.BasicLit{Value: "`json:\"-\"`"}))
+		assert.Equal(t, "Name", getJSONName("Name", &ast.BasicLit{Value: "`gorm:\"not null\"`"}))
+		assert.Equal(t, "Name", getJSONName("Name", nil))
+	})
+}
+
+func TestCollection_getVariableOfParam(t *testing.T) {
+	t.Run("Should prefix the param ID by the resource and keep the others params", func(t *testing.T) {
+		assert.Equal(t, "productId", getVariableOfParam("product", "ID"))
+		assert.Equal(t, "itemID", getVariableOfParam("product", "itemID"))
+	})
+}
+
+func TestCollection_getPackageOfRoute(t *testing.T) {
+	t.Run("Should return the package of the resource of the route", func(t *testing.T) {
+		assert.Equal(t, "product", getPackageOfRoute("/product/{ID}"))
+		assert.Equal(t, "shipmentitem", getPackageOfRoute("/shipment-item"))
+	})
+}
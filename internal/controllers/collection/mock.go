@@ -0,0 +1,180 @@
+package collection
+
+import (
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/internal/entities/collection"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mock are the values of the fields of the entity returned by the Rules.GetMock, by the name of the field in go
+type mock struct {
+	values map[string]interface{}
+}
+
+// parseMock read the Rules.GetMock of the folder, the values of the literals are kept and the calls known like
+// uuid.New() and time.Now() are replaced by stable values, so the files are the same in each run, the others
+// fields are ignored
+func (c *Collection) parseMock(folder string) mock {
+	mock := mock{values: map[string]interface{}{}}
+	for _, file := range c.parseDir(folder) {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Name.Name != "GetMock" || function.Recv == nil || function.Body == nil {
+				continue
+			}
+			ast.Inspect(function.Body, func(node ast.Node) bool {
+				if ret, ok := node.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+					mock.setValues(ret.Results[0])
+				}
+				return true
+			})
+		}
+	}
+	return mock
+}
+
+func (m mock) setValues(expr ast.Expr) {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	composite, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return
+	}
+	for _, element := range composite.Elts {
+		keyValue, ok := element.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := keyValue.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		if _, ok := keyValue.Value.(*ast.CompositeLit); ok {
+			m.setValues(keyValue.Value)
+		} else if value, ok := getValue(keyValue.Value); ok {
+			m.values[key.Name] = value
+		}
+	}
+}
+
+func getValue(expr ast.Expr) (interface{}, bool) {
+	switch value := expr.(type) {
+	case *ast.BasicLit:
+		return getValueOfLiteral(value)
+	case *ast.Ident:
+		if value.Name == "true" || value.Name == "false" {
+			return value.Name == "true", true
+		}
+	case *ast.UnaryExpr:
+		if literal, ok := value.X.(*ast.BasicLit); ok && value.Op == token.SUB {
+			return getValueOfLiteral(&ast.BasicLit{Kind: literal.Kind, Value: "-" + literal.Value})
+		}
+	case *ast.CallExpr:
+		return getValueOfCall(types.ExprString(value))
+	}
+	return nil, false
+}
+
+func getValueOfLiteral(literal *ast.BasicLit) (interface{}, bool) {
+	switch literal.Kind {
+	case token.STRING, token.CHAR:
+		value, err := strconv.Unquote(literal.Value)
+		return value, err == nil
+	case token.INT:
+		value, err := strconv.ParseInt(literal.Value, 0, 64)
+		return value, err == nil
+	case token.FLOAT:
+		value, err := strconv.ParseFloat(literal.Value, 64)
+		return value, err == nil
+	}
+	return nil, false
+}
+
+func getValueOfCall(call string) (interface{}, bool) {
+	switch call {
+	case "uuid.New()", "uuid.New().String()", "uuid.NewString()":
+		return uuid.Nil.String(), true
+	case "time.Now()", "time.Now().UTC()":
+		return time.Unix(0, 0).UTC().Format(time.RFC3339), true
+	}
+	return nil, false
+}
+
+// getJSONFields return the name in go and the name in json of the fields of the struct of the package of the
+// internal/entities, in the order declared
+func (c *Collection) getJSONFields(pkg, structName string) (fields [][2]string) {
+	for _, file := range c.parseDir(path.Join(string(folders.InternalEntities), pkg)) {
+		object := file.Scope.Lookup(structName)
+		if object == nil || object.Kind != ast.Typ {
+			continue
+		}
+		structType, ok := object.Decl.(*ast.TypeSpec).Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+		for _, field := range structType.Fields.List {
+			for _, name := range field.Names {
+				if jsonName := getJSONName(name.Name, field.Tag); name.IsExported() && jsonName != "-" {
+					fields = append(fields, [2]string{name.Name, jsonName})
+				}
+			}
+		}
+	}
+	return fields
+}
+
+func getJSONName(name string, tag *ast.BasicLit) string {
+	if tag == nil {
+		return name
+	}
+	value, _ := strconv.Unquote(tag.Value)
+	jsonName := strings.Split(reflect.StructTag(value).Get("json"), ",")[0]
+	if jsonName == "" {
+		return name
+	}
+	return jsonName
+}
+
+// getPort return the default of the env PORT of the configs of the project
+func (c *Collection) getPort() string {
+	port := collection.DefaultPort
+	for _, file := range c.parseDir(string(folders.Configs)) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if ok && len(call.Args) == 2 && goast.GetString(call.Args[0]) == "PORT" {
+				if literal, ok := call.Args[1].(*ast.BasicLit); ok {
+					port = strings.Trim(literal.Value, `"`)
+				}
+			}
+			return true
+		})
+	}
+	return port
+}
+
+// getBasePath return the const BasePath of the internal/routes, the prefix of all routes of the swagger docs
+func (c *Collection) getBasePath() string {
+	for _, file := range c.parseDir(string(folders.InternalRoutes)) {
+		if object := file.Scope.Lookup("BasePath"); object != nil && object.Kind == ast.Con {
+			if spec, ok := object.Decl.(*ast.ValueSpec); ok && len(spec.Values) == 1 {
+				return goast.GetString(spec.Values[0])
+			}
+		}
+	}
+	return c.spec.BasePath
+}
+
+func (c *Collection) parseDir(folder string) []*ast.File {
+	files, _ := goast.ParseDir(c.join(folder), 0)
+	return files
+}
@@ -0,0 +1,22 @@
+package collection
+
+// templateHTTP is the file of requests of the REST clients of the editors, like the REST Client of the vscode and the
+// HTTP Client of the jetbrains, the variables are read from the http-client.env.json and the variables of the ids are
+// set by the response handler of the create
+const templateHTTP = `# Code generated by go-generator collection. DO NOT EDIT.
+# The variables are in the http-client.env.json, the ids of the paths are set by the response of the create
+{{- range .Requests}}
+
+### {{.Folder}}: {{.Name}}
+{{.Method}} {{.GetURL}}
+{{- if .Body}}
+Content-Type: application/json
+
+{{printf "%s" .Body}}
+{{- end}}
+{{- if .Variable}}
+
+> {% {{.GetScriptOfHTTP}} %}
+{{- end}}
+{{- end}}
+`
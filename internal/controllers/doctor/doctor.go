@@ -0,0 +1,301 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/entities/doctor"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	CheckStructure  = "structure"
+	CheckModule     = "module"
+	CheckMigrations = "migrations"
+	CheckRoutes     = "routes"
+	CheckSwagger    = "swagger"
+)
+
+type Interface interface {
+	Diagnose(pathProject string) []doctor.Diagnostic
+}
+
+type Doctor struct {
+	path        string
+	module      string
+	diagnostics []doctor.Diagnostic
+}
+
+func NewDoctor() Interface {
+	return &Doctor{}
+}
+
+func Checks() []string {
+	return []string{
+		CheckStructure,
+		CheckModule,
+		CheckMigrations,
+		CheckRoutes,
+		CheckSwagger,
+	}
+}
+
+func (d *Doctor) Diagnose(pathProject string) []doctor.Diagnostic {
+	d.path = pathProject
+	d.module = ""
+	d.diagnostics = []doctor.Diagnostic{}
+	d.checkStructure()
+	d.checkModule()
+	d.checkMigrations()
+	d.checkRoutes()
+	d.checkSwagger()
+	return d.diagnostics
+}
+
+func (d *Doctor) addDiagnostic(check, problem, fix string) {
+	d.diagnostics = append(d.diagnostics, doctor.NewDiagnostic(check, problem, fix))
+}
+
+func (d *Doctor) join(elem ...string) string {
+	return filepath.Join(append([]string{d.path}, elem...)...)
+}
+
+func (d *Doctor) checkModule() {
+	module, err := gomod.GetModulePath(d.path)
+	if err != nil || module == "" {
+		d.addDiagnostic(CheckModule, "go.mod not found or without module",
+			"run `go mod init [MODULE]` in the root of the project")
+		return
+	}
+	d.module = module
+	packages := d.getPackagesFolders()
+	d.walkGoFiles(func(path string, file *ast.File) {
+		for _, importSpec := range file.Imports {
+			importPath, _ := strconv.Unquote(importSpec.Path.Value)
+			d.checkImportPath(path, importPath, packages)
+		}
+	})
+}
+
+func (d *Doctor) checkImportPath(path, importPath string, packages []string) {
+	for _, folder := range packages {
+		expected := d.module + "/" + folder
+		if strings.HasSuffix(importPath, "/"+folder) && importPath != expected {
+			d.addDiagnostic(CheckModule,
+				fmt.Sprintf("%s imports %s but module is %s", d.relative(path), importPath, d.module),
+				fmt.Sprintf("replace the import %s by %s", importPath, expected))
+			return
+		}
+	}
+}
+
+func (d *Doctor) getPackagesFolders() (packages []string) {
+	for _, folder := range folders.Values() {
+		goFiles, _ := filepath.Glob(d.join(string(folder), "*.go"))
+		if len(goFiles) > 0 {
+			packages = append(packages, string(folder))
+		}
+	}
+	return packages
+}
+
+func (d *Doctor) walkGoFiles(callback func(path string, file *ast.File)) {
+	_ = filepath.Walk(d.path, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".go" {
+			return nil
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+		if err == nil {
+			callback(path, file)
+		}
+		return nil
+	})
+}
+
+func (d *Doctor) relative(path string) string {
+	relative, err := filepath.Rel(d.path, path)
+	if err != nil {
+		return path
+	}
+	return filepath.ToSlash(relative)
+}
+
+func (d *Doctor) checkMigrations() {
+	dialects, _ := ioutil.ReadDir(d.join(string(folders.Migrations)))
+	tables := d.getEntitiesTables()
+	for _, dialect := range dialects {
+		if !dialect.IsDir() {
+			continue
+		}
+		for _, table := range tables {
+			d.checkMigrationOfTable(dialect.Name(), table)
+		}
+	}
+}
+
+func (d *Doctor) checkMigrationOfTable(dialect, table string) {
+	createTable := regexp.MustCompile(`(?i)create\s+table\s+(if\s+not\s+exists\s+)?[` + "`" + `"\[]?` +
+		regexp.QuoteMeta(table) + "[`" + `"\]]?[\s(]`)
+	migrations, _ := filepath.Glob(d.join(string(folders.Migrations), dialect, "*.up.sql"))
+	for _, migration := range migrations {
+		content, err := ioutil.ReadFile(migration)
+		if err == nil && createTable.Match(content) {
+			return
+		}
+	}
+	d.addDiagnostic(CheckMigrations, fmt.Sprintf("table %s without migration to %s", table, dialect),
+		fmt.Sprintf("create migrations/%s/%s_create_table_%s.up.sql and .down.sql",
+			dialect, time.Now().Format("20060102150405"), table))
+}
+
+func (d *Doctor) getEntitiesTables() (tables []string) {
+	_ = filepath.Walk(d.join(string(folders.InternalEntities)), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !goast.IsSourceFile(path) {
+			return nil
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err == nil {
+			tables = append(tables, getTablesNames(file)...)
+		}
+		return nil
+	})
+	return tables
+}
+
+func getTablesNames(file *ast.File) (tables []string) {
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Recv == nil || function.Name.Name != "TableName" || function.Body == nil {
+			continue
+		}
+		for _, stmt := range function.Body.List {
+			if table := getReturnedString(stmt); table != "" {
+				tables = append(tables, table)
+			}
+		}
+	}
+	return tables
+}
+
+func getReturnedString(stmt ast.Stmt) string {
+	returnStmt, ok := stmt.(*ast.ReturnStmt)
+	if !ok || len(returnStmt.Results) != 1 {
+		return ""
+	}
+	literal, ok := returnStmt.Results[0].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+	value, _ := strconv.Unquote(literal.Value)
+	return value
+}
+
+func (d *Doctor) checkRoutes() {
+	routesFiles, _ := filepath.Glob(d.join(string(folders.InternalRoutes), "*.go"))
+	for _, path := range routesFiles {
+		if !goast.IsSourceFile(path) || !goast.MatchBuildContext(path) {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			d.addDiagnostic(CheckRoutes, fmt.Sprintf("%s is invalid: %v", d.relative(path), err),
+				"fix the syntax of the file")
+			continue
+		}
+		d.checkHandlersOfRoutes(file)
+	}
+}
+
+func (d *Doctor) checkHandlersOfRoutes(file *ast.File) {
+	imports := goast.GetImportsByName(file)
+	ast.Inspect(file, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok || selector.Sel.Name != "NewHandler" {
+			return true
+		}
+		if ident, ok := selector.X.(*ast.Ident); ok {
+			d.checkHandlerExists(imports[ident.Name])
+		}
+		return true
+	})
+}
+
+func (d *Doctor) checkHandlerExists(importPath string) {
+	if d.module == "" || !strings.HasPrefix(importPath, d.module+"/") {
+		return
+	}
+	folder := strings.TrimPrefix(importPath, d.module+"/")
+	if !d.packageHasFunction(folder, "NewHandler") {
+		d.addDiagnostic(CheckRoutes, fmt.Sprintf("handler %s registered in routes.Router not found", folder),
+			fmt.Sprintf("create %s with func NewHandler or remove the route from internal/routes", folder))
+	}
+}
+
+func (d *Doctor) packageHasFunction(folder, name string) bool {
+	files, _ := goast.ParseDir(d.join(folder), 0)
+	for _, file := range files {
+		if object := file.Scope.Lookup(name); object != nil && object.Kind == ast.Fun {
+			return true
+		}
+	}
+	return false
+}
+
+var routerAnnotation = regexp.MustCompile(`@Router\s+(\S+)\s+\[(\w+)\]`)
+
+func (d *Doctor) checkSwagger() {
+	paths, ok := d.getSwaggerPaths()
+	if !ok {
+		return
+	}
+	_ = filepath.Walk(d.join(string(folders.InternalHandlers)), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !goast.IsSourceFile(path) {
+			return nil
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, match := range routerAnnotation.FindAllStringSubmatch(string(content), -1) {
+			d.checkRouteInSwagger(paths, path, match[1], strings.ToLower(match[2]))
+		}
+		return nil
+	})
+}
+
+func (d *Doctor) checkRouteInSwagger(paths map[string]map[string]json.RawMessage, path, route, method string) {
+	if _, ok := paths[route][method]; ok {
+		return
+	}
+	d.addDiagnostic(CheckSwagger, fmt.Sprintf("route %s %s of %s not found in %s",
+		strings.ToUpper(method), route, d.relative(path), files.DocsSwaggerJSON),
+		"run `swag init -g ./cmd/main.go` in the root of the project")
+}
+
+func (d *Doctor) getSwaggerPaths() (map[string]map[string]json.RawMessage, bool) {
+	content, err := ioutil.ReadFile(d.join(string(files.DocsSwaggerJSON)))
+	if err != nil {
+		return nil, false
+	}
+	swagger := struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}{}
+	if err := json.Unmarshal(content, &swagger); err != nil {
+		d.addDiagnostic(CheckSwagger, fmt.Sprintf("%s is invalid: %v", files.DocsSwaggerJSON, err),
+			"run `swag init -g ./cmd/main.go` in the root of the project")
+		return nil, false
+	}
+	return swagger.Paths, true
+}
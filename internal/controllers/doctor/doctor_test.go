@@ -0,0 +1,113 @@
+package doctor
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/entities/doctor"
+	"github.com/wilian746/go-generator/internal/testutil"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var pathGolden = filepath.Join("..", "generate", "app", "testdata", "golden")
+
+func getChecks(diagnostics []doctor.Diagnostic, check string) (problems []string) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Check == check {
+			problems = append(problems, diagnostic.Problem)
+		}
+	}
+	return problems
+}
+
+func TestDoctor_Diagnose(t *testing.T) {
+	t.Run("Should not return diagnostics when project is consistent", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.Empty(t, NewDoctor().Diagnose(dir))
+	})
+	t.Run("Should return diagnostic when file of the template not exists", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.Remove(filepath.Join(dir, "internal", "utils", "http", "response.go")))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckStructure)
+		assert.Equal(t, []string{"file internal/utils/http/response.go not found"}, problems)
+	})
+	t.Run("Should return diagnostic when file of the resource renamed not exists", func(t *testing.T) {
+		dir := testutil.CopyFolder(t, filepath.Join(pathGolden, "sql-gin-mysql-without-tests-health-docker-compose-invoice"))
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.Remove(filepath.Join(dir, "internal", "rules", "invoice", "invoice.go")))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckStructure)
+		assert.Equal(t, []string{"file internal/rules/invoice/invoice.go not found"}, problems)
+	})
+	t.Run("Should return diagnostic when go.mod not exists", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.Remove(filepath.Join(dir, "go.mod")))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckModule)
+		assert.Equal(t, []string{"go.mod not found or without module"}, problems)
+	})
+	t.Run("Should return diagnostics when module is different of the imports", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/api\n"), 0600))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckModule)
+		assert.NotEmpty(t, problems)
+		assert.Contains(t, problems[0], "but module is example.com/api")
+	})
+	t.Run("Should return diagnostics when table not have migration", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.Remove(filepath.Join(dir, "migrations", "postgres",
+			"20200607175350_create_table_products.up.sql")))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckMigrations)
+		assert.Equal(t, []string{"table products without migration to postgres"}, problems)
+	})
+	t.Run("Should return diagnostics when handler registered not exists", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.RemoveAll(filepath.Join(dir, "internal", "handlers", "health")))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckRoutes)
+		assert.Equal(t, []string{"handler internal/handlers/health registered in routes.Router not found"}, problems)
+	})
+	t.Run("Should return diagnostics when route of the handlers not exists in swagger", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		swagger := filepath.Join(dir, "docs", "swagger.json")
+		content, err := ioutil.ReadFile(swagger)
+		assert.NoError(t, err)
+		content = []byte(strings.Replace(string(content), `"/health": {`, `"/status": {`, 1))
+		assert.NoError(t, ioutil.WriteFile(swagger, content, 0600))
+		problems := getChecks(NewDoctor().Diagnose(dir), CheckSwagger)
+		assert.Equal(t, []string{"route GET /health of internal/handlers/health/health.go not found in docs/swagger.json"},
+			problems)
+	})
+}
+
+func TestDoctor_checkStructure(t *testing.T) {
+	goldens, err := ioutil.ReadDir(pathGolden)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, goldens)
+	for _, golden := range goldens {
+		t.Run("Should not return diagnostics of structure to the project generated "+golden.Name(), func(t *testing.T) {
+			problems := getChecks(NewDoctor().Diagnose(filepath.Join(pathGolden, golden.Name())), CheckStructure)
+			assert.Empty(t, problems)
+		})
+	}
+}
+
+func TestDoctor_DiagnoseGenerated(t *testing.T) {
+	goldens, err := ioutil.ReadDir(pathGolden)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, goldens)
+	for _, golden := range goldens {
+		t.Run("Should not return diagnostics to the project generated "+golden.Name(), func(t *testing.T) {
+			dir := testutil.CopyFolder(t, filepath.Join(pathGolden, golden.Name()))
+			defer os.RemoveAll(dir)
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/golden\n"), 0600))
+			assert.Empty(t, NewDoctor().Diagnose(dir))
+		})
+	}
+}
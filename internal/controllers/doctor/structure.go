@@ -0,0 +1,172 @@
+package doctor
+
+import (
+	"fmt"
+	"github.com/wilian746/go-generator/internal/controllers/generate/app"
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	EnumsFeatures "github.com/wilian746/go-generator/internal/enums/features"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	EnumsRepository "github.com/wilian746/go-generator/internal/enums/repository"
+	EnumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"go/parser"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var dialectOfConfigMap = regexp.MustCompile(`(?m)^  DATABASE_DIALECT: (\w+)$`)
+
+// repositoriesByImport are the imports of the database of the templates, the memory not import a database
+var repositoriesByImport = map[string]EnumsRepository.Repository{
+	"github.com/jinzhu/gorm": EnumsRepository.Gorm,
+	"gorm.io/gorm":           EnumsRepository.Gorm2,
+	"database/sql":           EnumsRepository.SQL,
+	"go.etcd.io/bbolt":       EnumsRepository.Bolt,
+}
+
+func (d *Doctor) checkStructure() {
+	foldersPaths, filesPaths := d.getFoldersAndFiles()
+	for _, folder := range foldersPaths {
+		if !hasFileInFolder(filesPaths, folder) {
+			continue
+		}
+		if info, err := os.Stat(d.join(folder)); err != nil || !info.IsDir() {
+			d.addDiagnostic(CheckStructure, fmt.Sprintf("folder %s not found", folder),
+				fmt.Sprintf("create the folder %s or generate the project again", folder))
+		}
+	}
+	for _, file := range filesPaths {
+		if !d.exists(file) {
+			d.addDiagnostic(CheckStructure, fmt.Sprintf("file %s not found", file),
+				fmt.Sprintf("restore the file %s from the template of `go-generator init`", file))
+		}
+	}
+}
+
+// getFoldersAndFiles return the folders and files generated by the init with the settings of the project, the
+// repository, the generate type, the dialects and the resource are found in the code and the features are excluded
+// when none of their files exist, like the project generated with --without
+func (d *Doctor) getFoldersAndFiles() (foldersPaths, filesPaths []string) {
+	imports := d.getImportsOfDatabase()
+	db, command := getRepository(imports), d.getCommand()
+	generator := app.NewApp().SetCommand(command).SetDialects(d.getDialects(imports))
+	if app.IsResourceSupported(command) {
+		generator.SetResource(d.getResource(generator, db))
+	}
+	return generator.SetWithout(d.getFeaturesExcluded(generator, db)).GetFoldersAndFiles(db)
+}
+
+// getDialects return the dialects of the drivers imported with the dialect of the ConfigMap first, because the
+// deployments are generated with the first dialect selected
+func (d *Doctor) getDialects(imports []string) (selected []dialects.Dialect) {
+	content, _ := ioutil.ReadFile(d.join(string(files.DeploymentsKubernetesConfigMap)))
+	first := dialectOfConfigMap.FindSubmatch(content)
+	for _, dialect := range app.GetDialectsByDrivers(imports) {
+		if first != nil && dialect.String() == string(first[1]) {
+			selected = append([]dialects.Dialect{dialect}, selected...)
+			continue
+		}
+		selected = append(selected, dialect)
+	}
+	return selected
+}
+
+func (d *Doctor) getImportsOfDatabase() (imports []string) {
+	files, _ := goast.ParseDir(d.join(string(folders.PkgRepositoryDatabase)), parser.ImportsOnly)
+	for _, file := range files {
+		for _, importSpec := range file.Imports {
+			importPath, _ := strconv.Unquote(importSpec.Path.Value)
+			imports = append(imports, importPath)
+		}
+	}
+	return imports
+}
+
+func getRepository(imports []string) EnumsRepository.Repository {
+	for _, importPath := range imports {
+		if repository, ok := repositoriesByImport[importPath]; ok {
+			return repository
+		}
+	}
+	return EnumsRepository.Memory
+}
+
+func (d *Doctor) getCommand() EnumsCommands.Command {
+	switch {
+	case d.exists(string(folders.InternalGrpc)):
+		return EnumsCommands.GRPC
+	case d.exists(string(folders.InternalGraphQL)):
+		return EnumsCommands.GraphQL
+	default:
+		return EnumsCommands.App
+	}
+}
+
+// getResource return the table of the entities that is the resource renamed by the init, it is the table with less
+// files not found, empty when the resource is the product of the templates
+func (d *Doctor) getResource(generator app.Interface, db EnumsRepository.Repository) (resource string) {
+	_, filesPaths := generator.GetFoldersAndFiles(db)
+	missing := d.countFilesNotFound(filesPaths)
+	for _, table := range d.getEntitiesTables() {
+		_, filesPaths := generator.SetResource(table).GetFoldersAndFiles(db)
+		if count := d.countFilesNotFound(filesPaths); count < missing {
+			resource, missing = table, count
+		}
+	}
+	return resource
+}
+
+// getFeaturesExcluded return the features that none of the files removed by the --without exist in the project
+func (d *Doctor) getFeaturesExcluded(generator app.Interface, db EnumsRepository.Repository) (
+	excluded []EnumsFeatures.Feature) {
+	_, all := generator.SetWithout(nil).GetFoldersAndFiles(db)
+	for _, feature := range EnumsFeatures.Values() {
+		_, kept := generator.SetWithout([]EnumsFeatures.Feature{feature}).GetFoldersAndFiles(db)
+		removed := getDifference(all, kept)
+		if len(removed) > 0 && d.countFilesNotFound(removed) == len(removed) {
+			excluded = append(excluded, feature)
+		}
+	}
+	return excluded
+}
+
+func (d *Doctor) countFilesNotFound(filesPaths []string) (count int) {
+	for _, file := range filesPaths {
+		if !d.exists(file) {
+			count++
+		}
+	}
+	return count
+}
+
+func (d *Doctor) exists(path string) bool {
+	_, err := os.Stat(d.join(path))
+	return err == nil
+}
+
+func getDifference(all, kept []string) (difference []string) {
+	keptByPath := map[string]bool{}
+	for _, path := range kept {
+		keptByPath[path] = true
+	}
+	for _, path := range all {
+		if !keptByPath[path] {
+			difference = append(difference, path)
+		}
+	}
+	return difference
+}
+
+// hasFileInFolder return false to the folders without files, because the empty folders are not kept by the git
+func hasFileInFolder(filesPaths []string, folder string) bool {
+	for _, file := range filesPaths {
+		if strings.HasPrefix(file, folder+"/") {
+			return true
+		}
+	}
+	return false
+}
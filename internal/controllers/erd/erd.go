@@ -0,0 +1,67 @@
+package erd
+
+import (
+	"bytes"
+	"github.com/wilian746/go-generator/internal/enums/diagrams"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const (
+	ReadmeFile        = "README.md"
+	ReadmeTitle       = "## Entity relationship diagram"
+	ReadmeMarkerBegin = "<!-- go-generator erd begin -->"
+	ReadmeMarkerEnd   = "<!-- go-generator erd end -->"
+)
+
+type Interface interface {
+	Generate(pathProject string, format diagrams.Diagram) (string, error)
+	UpdateReadme(pathProject string, format diagrams.Diagram, diagram string) error
+}
+
+type ERD struct{}
+
+func NewERD() Interface {
+	return &ERD{}
+}
+
+// Generate return the diagram of the tables of the entities of the project with the method TableName and the
+// relationships of the tags gorm and sql between them
+func (e *ERD) Generate(pathProject string, format diagrams.Diagram) (string, error) {
+	module, _ := gomod.GetModulePath(pathProject)
+	parser := &parserEntities{root: pathProject, module: module, entities: map[string]*entity{}}
+	if err := parser.parse(); err != nil {
+		return "", err
+	}
+	diagram := parser.getDiagram()
+	if len(diagram.Tables) == 0 {
+		return "", errors.ErrERDTablesNotFound
+	}
+	return render(diagram, format), nil
+}
+
+// UpdateReadme replace the diagram between the markers of the README.md of the project, when the README.md not have
+// the markers the section of the diagram is added in the end
+func (e *ERD) UpdateReadme(pathProject string, format diagrams.Diagram, diagram string) error {
+	readme := filepath.Join(pathProject, ReadmeFile)
+	content, err := ioutil.ReadFile(readme)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	block := ReadmeMarkerBegin + "\n```" + format.String() + "\n" + diagram + "```\n" + ReadmeMarkerEnd
+	begin, end := bytes.Index(content, []byte(ReadmeMarkerBegin)), bytes.Index(content, []byte(ReadmeMarkerEnd))
+	if begin == -1 || end < begin {
+		if len(content) > 0 {
+			content = append(bytes.TrimRight(content, "\n"), []byte("\n\n")...)
+		}
+		content = append(content, []byte(ReadmeTitle+"\n\n"+block+"\n")...)
+		return ioutil.WriteFile(readme, content, 0600)
+	}
+	updated := append([]byte{}, content[:begin]...)
+	updated = append(updated, block...)
+	updated = append(updated, content[end+len(ReadmeMarkerEnd):]...)
+	return ioutil.WriteFile(readme, updated, 0600)
+}
@@ -0,0 +1,125 @@
+package erd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/diagrams"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func getPathTemplate(database string) string {
+	return filepath.Join("..", "..", "..", "pkg", "standart-"+database)
+}
+
+// getPathGolden return the project generated, the templates of grpc and graphql have only the files changed of gorm
+func getPathGolden(name string) string {
+	return filepath.Join("..", "generate", "app", "testdata", "golden", name)
+}
+
+func TestERD_Generate(t *testing.T) {
+	t.Run("Should generate the mermaid with the relationships of the tags gorm and sql", func(t *testing.T) {
+		diagram, err := NewERD().Generate(getPathTemplate("gorm"), diagrams.Mermaid)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(diagram, "erDiagram\n"))
+		assert.Contains(t, diagram, "    products {\n        UUID id PK\n        Time created_at\n"+
+			"        Time updated_at\n        string name\n    }\n")
+		assert.Contains(t, diagram, "        uuid patient_id FK\n        uuid doctor_id FK\n")
+		assert.Contains(t, diagram, `    contacts ||--o| students : "contact_id"`)
+		assert.Contains(t, diagram, `    doctors ||--o{ doctors_patients : "doctor_id"`)
+		assert.Contains(t, diagram, `    patients ||--o{ doctors_patients : "patient_id"`)
+		assert.Contains(t, diagram, `    restaurants ||--o{ orders : "restaurant_id"`)
+	})
+	t.Run("Should generate the relationships of the tags foreignKey and references of the gorm2", func(t *testing.T) {
+		diagram, err := NewERD().Generate(getPathTemplate("gorm2"), diagrams.Mermaid)
+		assert.NoError(t, err)
+		assert.Contains(t, diagram, "        varchar_36 id PK\n")
+		assert.Contains(t, diagram, `    doctors ||--o{ doctors_patients : "doctor_id"`)
+		assert.Contains(t, diagram, `    contacts ||--o| students : "contact_id"`)
+	})
+	t.Run("Should merge the tables declared in the layers preferring the pkg/repository/entities", func(t *testing.T) {
+		for _, database := range []string{"gorm-graphql", "gorm-grpc"} {
+			diagram, err := NewERD().Generate(getPathGolden(database), diagrams.Mermaid)
+			assert.NoError(t, err, database)
+			for _, table := range []string{"doctors", "patients", "doctors_patients", "orders", "restaurants"} {
+				assert.Equal(t, 1, strings.Count(diagram, "    "+table+" {\n"), database+": "+table)
+			}
+			assert.Contains(t, diagram, "    doctors_patients {\n        uuid id PK\n        uuid patient_id FK\n"+
+				"        uuid doctor_id FK\n", database)
+			assert.Contains(t, diagram, `    restaurants ||--o{ orders : "restaurant_id"`, database)
+		}
+	})
+	t.Run("Should generate the dot with the tables and the relationships", func(t *testing.T) {
+		diagram, err := NewERD().Generate(getPathTemplate("gorm"), diagrams.DOT)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(diagram, "digraph erd {\n"))
+		assert.Contains(t, diagram, `<tr><td align="left">contact_id: uuid FK</td></tr>`)
+		assert.Contains(t, diagram, `    "restaurants" -> "orders" [dir=both, arrowtail=teetee, arrowhead=crowodot, `+
+			`label="restaurant_id"];`)
+	})
+	t.Run("Should generate the plantuml with the tables and the relationships", func(t *testing.T) {
+		diagram, err := NewERD().Generate(getPathTemplate("gorm"), diagrams.PlantUML)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(diagram, "@startuml\n"))
+		assert.Contains(t, diagram, "entity \"orders\" as orders {\n    * id : uuid <<PK>>\n    --\n")
+		assert.Contains(t, diagram, "    restaurant_id : uuid <<FK>>\n")
+		assert.Contains(t, diagram, "contacts ||--o| students : contact_id\n")
+		assert.True(t, strings.HasSuffix(diagram, "@enduml\n"))
+	})
+	t.Run("Should return error when not found tables", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "erd")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		source := "package entities\n\ntype Product struct {\n\tName string\n}\n"
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "product.go"), []byte(source), 0600))
+		_, err = NewERD().Generate(dir, diagrams.Mermaid)
+		assert.Equal(t, errors.ErrERDTablesNotFound, err)
+	})
+}
+
+func TestERD_UpdateReadme(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	readme := filepath.Join(dir, ReadmeFile)
+	t.Run("Should add the section of the diagram in the end of the README.md", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(readme, []byte("# Project\n\n## Run application\n"), 0600))
+		assert.NoError(t, NewERD().UpdateReadme(dir, diagrams.Mermaid, "erDiagram\n"))
+		content, err := ioutil.ReadFile(readme)
+		assert.NoError(t, err)
+		assert.Equal(t, "# Project\n\n## Run application\n\n"+ReadmeTitle+"\n\n"+ReadmeMarkerBegin+
+			"\n```mermaid\nerDiagram\n```\n"+ReadmeMarkerEnd+"\n", string(content))
+	})
+	t.Run("Should replace only the diagram between the markers", func(t *testing.T) {
+		content, err := ioutil.ReadFile(readme)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(readme, append(content, []byte("\n## Other\n")...), 0600))
+		assert.NoError(t, NewERD().UpdateReadme(dir, diagrams.PlantUML, "@startuml\n@enduml\n"))
+		content, err = ioutil.ReadFile(readme)
+		assert.NoError(t, err)
+		assert.Equal(t, "# Project\n\n## Run application\n\n"+ReadmeTitle+"\n\n"+ReadmeMarkerBegin+
+			"\n```plantuml\n@startuml\n@enduml\n```\n"+ReadmeMarkerEnd+"\n\n## Other\n", string(content))
+	})
+}
+
+func TestERD_toDBName(t *testing.T) {
+	t.Run("Should return the name of the column like the gorm", func(t *testing.T) {
+		assert.Equal(t, "id", toDBName("ID"))
+		assert.Equal(t, "doctor_id", toDBName("DoctorID"))
+		assert.Equal(t, "created_at", toDBName("CreatedAt"))
+		assert.Equal(t, "http_status", toDBName("HTTPStatus"))
+		assert.Equal(t, "address2_line", toDBName("Address2Line"))
+	})
+}
+
+func TestERD_getTagSettings(t *testing.T) {
+	t.Run("Should return the settings by the key in upper case without _", func(t *testing.T) {
+		settings := getTagSettings("foreignkey:DoctorID;association_foreignkey:ID;primary_key;constraint:OnDelete:CASCADE")
+		assert.Equal(t, map[string]string{
+			"FOREIGNKEY": "DoctorID", "ASSOCIATIONFOREIGNKEY": "ID", "PRIMARYKEY": "", "CONSTRAINT": "OnDelete:CASCADE",
+		}, settings)
+	})
+}
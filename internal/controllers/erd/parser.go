@@ -0,0 +1,356 @@
+package erd
+
+import (
+	"github.com/wilian746/go-generator/internal/entities/erd"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var referencesRegex = regexp.MustCompile(`(?i)\s*REFERENCES\s+"?([A-Za-z0-9_.]+)"?\s*\(\s*"?([A-Za-z0-9_]+)"?\s*\)`)
+
+var ignoredFolders = map[string]bool{"vendor": true, "testdata": true, "node_modules": true}
+
+// entity is a struct of the project, it is a table when it have the method TableName
+type entity struct {
+	dir     string
+	file    *ast.File
+	spec    *ast.StructType
+	table   string
+	columns map[string]string
+}
+
+// association is a field of an entity with the type of other entity, like the Orders []Order of the Restaurant
+type association struct {
+	owner    *entity
+	target   *entity
+	slice    bool
+	settings map[string]string
+}
+
+// reference is a column with the tag sql:"REFERENCES table(column)"
+type reference struct {
+	owner  *entity
+	column string
+	table  string
+	target string
+}
+
+type parserEntities struct {
+	root         string
+	module       string
+	entities     map[string]*entity
+	tables       map[string]*entity
+	associations []association
+	references   []reference
+}
+
+func (p *parserEntities) parse() error {
+	return filepath.Walk(p.root, func(current string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if current != p.root && (ignoredFolders[info.Name()] || strings.HasPrefix(info.Name(), ".")) {
+			return filepath.SkipDir
+		}
+		return p.parseDir(current)
+	})
+}
+
+func (p *parserEntities) parseDir(dir string) error {
+	files, err := goast.ParseDir(dir, 0)
+	if err != nil {
+		return err
+	}
+	relative, _ := filepath.Rel(p.root, dir)
+	for _, file := range files {
+		p.addEntities(filepath.ToSlash(relative), file)
+	}
+	for _, file := range files {
+		p.setTables(filepath.ToSlash(relative), file)
+	}
+	return nil
+}
+
+func (p *parserEntities) addEntities(dir string, file *ast.File) {
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+				p.entities[dir+"."+typeSpec.Name.Name] = &entity{
+					dir: dir, file: file, spec: structType,
+				}
+			}
+		}
+	}
+}
+
+// setTables set the table of the entities with the method TableName that return a string
+func (p *parserEntities) setTables(dir string, file *ast.File) {
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Name.Name != "TableName" || function.Recv == nil || function.Body == nil {
+			continue
+		}
+		entity, ok := p.entities[dir+"."+goast.GetReceiverName(function.Recv.List[0].Type)]
+		if !ok || len(function.Body.List) != 1 {
+			continue
+		}
+		if ret, ok := function.Body.List[0].(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+			entity.table = goast.GetString(ret.Results[0])
+		}
+	}
+}
+
+// getDiagram return the tables of the entities and the relationships of the associations and references
+func (p *parserEntities) getDiagram() *erd.Diagram {
+	p.tables = p.getEntitiesByTable()
+	tables := []erd.Table{}
+	for _, value := range p.tables {
+		value.columns = map[string]string{}
+		tables = append(tables, erd.NewTable(value.table, p.getColumns(value, value, map[*entity]bool{})))
+	}
+	return erd.NewDiagram(tables, p.getRelationships())
+}
+
+// getEntitiesByTable return one entity by table, because the same table is declared in the layers of the project
+// like the internal/entities and the pkg/repository/entities, the entity of the pkg/repository/entities is preferred
+// because it have the tags sql REFERENCES of the relationships
+func (p *parserEntities) getEntitiesByTable() map[string]*entity {
+	tables := map[string]*entity{}
+	for _, value := range p.entities {
+		if current, ok := tables[value.table]; value.table != "" && (!ok || isPreferred(value, current)) {
+			tables[value.table] = value
+		}
+	}
+	return tables
+}
+
+// isPreferred return if the value is preferred to the current of the same table, the others folders are sorted by
+// the name to the diagram be the same in each run
+func isPreferred(value, current *entity) bool {
+	preferred := string(folders.PkgRepositoryEntities)
+	if (value.dir == preferred) != (current.dir == preferred) {
+		return value.dir == preferred
+	}
+	return value.dir < current.dir
+}
+
+func (p *parserEntities) getColumns(owner, current *entity, visited map[*entity]bool) (columns []erd.Column) {
+	visited[current] = true
+	for _, field := range current.spec.Fields.List {
+		tag := getTag(field.Tag)
+		settings := getTagSettings(tag.Get("gorm"))
+		if tag.Get("gorm") == "-" {
+			continue
+		}
+		target, slice := p.resolve(current, field.Type)
+		if len(field.Names) == 0 {
+			if target != nil && !visited[target] {
+				columns = append(columns, p.getColumns(owner, target, visited)...)
+			}
+			continue
+		}
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+			if target != nil && target.table != "" {
+				p.associations = append(p.associations, association{
+					owner: owner, target: target, slice: slice, settings: settings,
+				})
+				continue
+			}
+			column := p.getColumn(owner, name.Name, field.Type, settings, tag.Get("sql"))
+			owner.columns[name.Name] = column.Name
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+func (p *parserEntities) getColumn(owner *entity, name string, expr ast.Expr, settings map[string]string,
+	sql string) erd.Column {
+	columnName := settings["COLUMN"]
+	if columnName == "" {
+		columnName = toDBName(name)
+	}
+	columnType := types.ExprString(expr)
+	for _, value := range []string{getTagSettings(sql)["TYPE"], settings["TYPE"]} {
+		if match := referencesRegex.FindStringSubmatch(value); match != nil {
+			p.references = append(p.references, reference{
+				owner: owner, column: columnName, table: match[1], target: match[2],
+			})
+			value = strings.TrimSpace(referencesRegex.Split(value, 2)[0])
+		}
+		if value != "" {
+			columnType = value
+		}
+	}
+	_, primaryKey := settings["PRIMARYKEY"]
+	return erd.NewColumn(columnName, columnType, primaryKey || (name == "ID" && !hasPrimaryKey(owner)))
+}
+
+// resolve return the entity of the type of the field and if the type is a slice, like the Order of []Order
+func (p *parserEntities) resolve(current *entity, expr ast.Expr) (*entity, bool) {
+	slice := false
+	if array, ok := expr.(*ast.ArrayType); ok {
+		expr, slice = array.Elt, true
+	}
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch value := expr.(type) {
+	case *ast.Ident:
+		return p.entities[current.dir+"."+value.Name], slice
+	case *ast.SelectorExpr:
+		ident, ok := value.X.(*ast.Ident)
+		if !ok {
+			return nil, slice
+		}
+		return p.entities[p.getDir(goast.GetImportsByName(current.file)[ident.Name])+"."+value.Sel.Name], slice
+	}
+	return nil, slice
+}
+
+// getDir return the folder of the import of the project, when the project not have go.mod the folder is found by
+// the end of the import
+func (p *parserEntities) getDir(importPath string) string {
+	if p.module != "" {
+		return strings.TrimPrefix(importPath, p.module+"/")
+	}
+	for _, value := range p.entities {
+		if strings.HasSuffix(importPath, "/"+value.dir) {
+			return value.dir
+		}
+	}
+	return ""
+}
+
+// getRelationships return the relationships of the gorm tags foreignkey and association_foreignkey, the
+// relationships with a field slice are one to many and the others are one to one, and of the sql tags REFERENCES
+// that are one to many when not have an association
+func (p *parserEntities) getRelationships() []erd.Relationship {
+	relationships := map[[3]string]erd.Relationship{}
+	for _, association := range p.associations {
+		relationship, ok := p.getRelationshipOfAssociation(association)
+		if !ok {
+			continue
+		}
+		key := [3]string{relationship.Parent, relationship.Child, relationship.ChildColumn}
+		if current, ok := relationships[key]; !ok || current.Cardinality != erd.OneToMany {
+			relationships[key] = relationship
+		}
+	}
+	for _, reference := range p.references {
+		key := [3]string{reference.table, reference.owner.table, reference.column}
+		if _, ok := relationships[key]; !ok {
+			relationships[key] = erd.NewRelationship(reference.table, reference.target, reference.owner.table,
+				reference.column, erd.OneToMany)
+		}
+	}
+	list := []erd.Relationship{}
+	for _, relationship := range relationships {
+		list = append(list, relationship)
+	}
+	return list
+}
+
+func (p *parserEntities) getRelationshipOfAssociation(association association) (erd.Relationship, bool) {
+	foreignKey := association.settings["FOREIGNKEY"]
+	if foreignKey == "" {
+		return erd.Relationship{}, false
+	}
+	parentKey := association.settings["ASSOCIATIONFOREIGNKEY"]
+	if parentKey == "" {
+		parentKey = association.settings["REFERENCES"]
+	}
+	if parentKey == "" {
+		parentKey = "ID"
+	}
+	parent, child := association.owner, association.target
+	cardinality := erd.OneToOne
+	if association.slice {
+		cardinality = erd.OneToMany
+	} else if _, ok := association.owner.columns[foreignKey]; ok {
+		parent, child = association.target, association.owner
+	}
+	parent, child = p.tables[parent.table], p.tables[child.table]
+	return erd.NewRelationship(parent.table, getColumnName(parent, parentKey), child.table,
+		getColumnName(child, foreignKey), cardinality), true
+}
+
+func getColumnName(entity *entity, field string) string {
+	if column, ok := entity.columns[field]; ok {
+		return column
+	}
+	return toDBName(field)
+}
+
+func hasPrimaryKey(entity *entity) bool {
+	for _, field := range entity.spec.Fields.List {
+		if _, ok := getTagSettings(getTag(field.Tag).Get("gorm"))["PRIMARYKEY"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getTagSettings return the settings of the tags gorm and sql by the key in upper case without _, like PRIMARYKEY
+// to the primary_key of the gorm and the primaryKey of the gorm2
+func getTagSettings(tag string) map[string]string {
+	settings := map[string]string{}
+	for _, setting := range strings.Split(tag, ";") {
+		values := strings.SplitN(setting, ":", 2)
+		key := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(values[0]), "_", ""))
+		if key == "" {
+			continue
+		}
+		settings[key] = ""
+		if len(values) == 2 {
+			settings[key] = strings.TrimSpace(values[1])
+		}
+	}
+	return settings
+}
+
+func getTag(tag *ast.BasicLit) reflect.StructTag {
+	if tag == nil {
+		return ""
+	}
+	value, _ := strconv.Unquote(tag.Value)
+	return reflect.StructTag(value)
+}
+
+// toDBName return the name of the column of the field like the gorm, like doctor_id to DoctorID
+func toDBName(name string) string {
+	runes := []rune(name)
+	var builder strings.Builder
+	for index, r := range runes {
+		if index > 0 && unicode.IsUpper(r) {
+			previousLower := unicode.IsLower(runes[index-1]) || unicode.IsDigit(runes[index-1])
+			nextLower := index+1 < len(runes) && unicode.IsLower(runes[index+1])
+			if previousLower || (unicode.IsUpper(runes[index-1]) && nextLower) {
+				builder.WriteRune('_')
+			}
+		}
+		builder.WriteRune(unicode.ToLower(r))
+	}
+	return builder.String()
+}
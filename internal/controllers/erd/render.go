@@ -0,0 +1,120 @@
+package erd
+
+import (
+	"fmt"
+	"github.com/wilian746/go-generator/internal/entities/erd"
+	"github.com/wilian746/go-generator/internal/enums/diagrams"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	qualifierRegex   = regexp.MustCompile(`[A-Za-z0-9_]+\.`)
+	mermaidTypeRegex = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// cardinalities are the ends of the lines of the parent and of the child of each relationship by diagram
+var cardinalities = map[diagrams.Diagram]map[erd.Cardinality][2]string{
+	diagrams.Mermaid:  {erd.OneToOne: {"||", "o|"}, erd.OneToMany: {"||", "o{"}},
+	diagrams.DOT:      {erd.OneToOne: {"teetee", "teeodot"}, erd.OneToMany: {"teetee", "crowodot"}},
+	diagrams.PlantUML: {erd.OneToOne: {"||", "o|"}, erd.OneToMany: {"||", "o{"}},
+}
+
+func render(diagram *erd.Diagram, format diagrams.Diagram) string {
+	switch format {
+	case diagrams.DOT:
+		return renderDOT(diagram)
+	case diagrams.PlantUML:
+		return renderPlantUML(diagram)
+	default:
+		return renderMermaid(diagram)
+	}
+}
+
+func renderMermaid(diagram *erd.Diagram) string {
+	var builder strings.Builder
+	builder.WriteString("erDiagram\n")
+	for _, table := range diagram.Tables {
+		fmt.Fprintf(&builder, "    %s {\n", table.Name)
+		for _, column := range table.Columns {
+			columnType := getMermaidType(column.Type)
+			line := strings.TrimSpace(columnType + " " + column.Name + " " + column.GetKey())
+			fmt.Fprintf(&builder, "        %s\n", line)
+		}
+		builder.WriteString("    }\n")
+	}
+	for _, relationship := range diagram.Relationships {
+		ends := cardinalities[diagrams.Mermaid][relationship.Cardinality]
+		fmt.Fprintf(&builder, "    %s %s--%s %s : %q\n", relationship.Parent, ends[0], ends[1], relationship.Child,
+			relationship.ChildColumn)
+	}
+	return builder.String()
+}
+
+func renderDOT(diagram *erd.Diagram) string {
+	var builder strings.Builder
+	builder.WriteString("digraph erd {\n    graph [rankdir=LR];\n    node [shape=plaintext];\n")
+	for _, table := range diagram.Tables {
+		fmt.Fprintf(&builder, "    %q [label=<<table border=\"0\" cellborder=\"1\" cellspacing=\"0\">", table.Name)
+		fmt.Fprintf(&builder, "<tr><td bgcolor=\"lightgrey\"><b>%s</b></td></tr>", html.EscapeString(table.Name))
+		for _, column := range table.Columns {
+			line := strings.TrimSpace(column.Name + ": " + column.Type + " " + column.GetKey())
+			fmt.Fprintf(&builder, "<tr><td align=\"left\">%s</td></tr>", html.EscapeString(line))
+		}
+		builder.WriteString("</table>>];\n")
+	}
+	for _, relationship := range diagram.Relationships {
+		ends := cardinalities[diagrams.DOT][relationship.Cardinality]
+		fmt.Fprintf(&builder, "    %q -> %q [dir=both, arrowtail=%s, arrowhead=%s, label=%q];\n",
+			relationship.Parent, relationship.Child, ends[0], ends[1], relationship.ChildColumn)
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func renderPlantUML(diagram *erd.Diagram) string {
+	var builder strings.Builder
+	builder.WriteString("@startuml\nhide circle\nskinparam linetype ortho\n")
+	for _, table := range diagram.Tables {
+		fmt.Fprintf(&builder, "\nentity %q as %s {\n", table.Name, table.Name)
+		for _, column := range table.Columns {
+			if column.PrimaryKey {
+				fmt.Fprintf(&builder, "    * %s : %s <<PK>>\n", column.Name, column.Type)
+			}
+		}
+		builder.WriteString("    --\n")
+		for _, column := range table.Columns {
+			if column.PrimaryKey {
+				continue
+			}
+			line := strings.TrimSpace(column.Name + " : " + column.Type + " " + getPlantUMLKey(column))
+			fmt.Fprintf(&builder, "    %s\n", line)
+		}
+		builder.WriteString("}\n")
+	}
+	if len(diagram.Relationships) > 0 {
+		builder.WriteString("\n")
+	}
+	for _, relationship := range diagram.Relationships {
+		ends := cardinalities[diagrams.PlantUML][relationship.Cardinality]
+		fmt.Fprintf(&builder, "%s %s--%s %s : %s\n", relationship.Parent, ends[0], ends[1], relationship.Child,
+			relationship.ChildColumn)
+	}
+	builder.WriteString("@enduml\n")
+	return builder.String()
+}
+
+func getPlantUMLKey(column erd.Column) string {
+	if key := column.GetKey(); key != "" {
+		return "<<" + key + ">>"
+	}
+	return ""
+}
+
+// getMermaidType return the type without the package and with only the characters allowed by the mermaid, like
+// UUID to uuid.UUID and varchar_36 to varchar(36)
+func getMermaidType(columnType string) string {
+	columnType = qualifierRegex.ReplaceAllString(columnType, "")
+	return strings.Trim(mermaidTypeRegex.ReplaceAllString(columnType, "_"), "_")
+}
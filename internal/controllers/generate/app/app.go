@@ -2,29 +2,98 @@ package app
 
 import (
 	"fmt"
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	EnumsFeatures "github.com/wilian746/go-generator/internal/enums/features"
 	"github.com/wilian746/go-generator/internal/enums/files"
 	"github.com/wilian746/go-generator/internal/enums/folders"
 	EnumsRepository "github.com/wilian746/go-generator/internal/enums/repository"
+	EnumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
+	EnumsRouters "github.com/wilian746/go-generator/internal/enums/routers"
 	"github.com/wilian746/go-generator/internal/utils/environment"
 	"github.com/wilian746/go-generator/internal/utils/github"
+	"github.com/wilian746/go-generator/internal/utils/inflection"
 	"github.com/wilian746/go-generator/internal/utils/logger"
+	"go/format"
+	"golang.org/x/mod/module"
 	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
 	"strings"
 )
 
 const ImportModuleName = "github.com/wilian746/go-generator"
 
+// projectNameInvalid are the characters not allowed in the names of the resources of the kubernetes and the images
+var projectNameInvalid = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// RoutersTemplateFolderName is the template with the routes of each router, selected by build tags
+const RoutersTemplateFolderName = "standart-gorm"
+
+// LayersTemplateFolderName is the template with the files not changed by the templates of grpc and graphql, they have
+// only the files of the transport and the files of gorm changed by them
+const LayersTemplateFolderName = "standart-gorm"
+
 type Interface interface {
+	SetSkipGoMod(skipGoMod bool) Interface
+	SetCommand(command EnumsCommands.Command) Interface
+	SetRouter(router EnumsRouters.Router) Interface
+	SetDialects(dialects []dialects.Dialect) Interface
+	SetWithout(features []EnumsFeatures.Feature) Interface
+	SetResource(resource string) Interface
+	SetTemplatesPath(templatesPath string) Interface
 	CreateFoldersAndFiles(pathDestiny, moduleName string, db EnumsRepository.Repository) error
+	GetFoldersAndFiles(db EnumsRepository.Repository) (foldersPaths, filesPaths []string)
 }
 
 type App struct {
-	db EnumsRepository.Repository
+	db        EnumsRepository.Repository
+	command   EnumsCommands.Command
+	router    EnumsRouters.Router
+	dialects  []dialects.Dialect
+	without   []EnumsFeatures.Feature
+	resource  inflection.Name
+	skipGoMod bool
+	// templatesPath is the root of a clone of the go-generator to read the templates, empty fetch them from github
+	templatesPath string
 }
 
 func NewApp() Interface {
-	return &App{}
+	return &App{command: EnumsCommands.App, router: EnumsRouters.Chi}
+}
+
+func (a *App) SetSkipGoMod(skipGoMod bool) Interface {
+	a.skipGoMod = skipGoMod
+	return a
+}
+
+func (a *App) SetCommand(command EnumsCommands.Command) Interface {
+	a.command = command
+	return a
+}
+
+func (a *App) SetRouter(router EnumsRouters.Router) Interface {
+	a.router = router
+	return a
+}
+
+// SetDialects keep only the migrations, drivers and services of docker-compose of the dialects, empty keep all
+func (a *App) SetDialects(dialects []dialects.Dialect) Interface {
+	a.dialects = dialects
+	return a
+}
+
+// SetWithout remove the features of the project generated, empty generate all
+func (a *App) SetWithout(features []EnumsFeatures.Feature) Interface {
+	a.without = features
+	return a
+}
+
+// SetTemplatesPath read the templates from the root of a clone of the go-generator instead of github
+func (a *App) SetTemplatesPath(templatesPath string) Interface {
+	a.templatesPath = templatesPath
+	return a
 }
 
 func (a *App) CreateFoldersAndFiles(pathDestiny, moduleName string, db EnumsRepository.Repository) error {
@@ -38,18 +107,30 @@ func (a *App) CreateFoldersAndFiles(pathDestiny, moduleName string, db EnumsRepo
 	return a.copyDefaultFiles(pathDestiny, moduleName)
 }
 
+// GetFoldersAndFiles return the paths of the folders and files generated to the repository without create them, with
+// the resource renamed and without the paths of the dialects and features excluded
+func (a *App) GetFoldersAndFiles(db EnumsRepository.Repository) (foldersPaths, filesPaths []string) {
+	a.db = db
+	for _, dir := range a.getFoldersSliceToCreateByDatabase() {
+		foldersPaths = append(foldersPaths, a.renameResourcePath(string(dir)))
+	}
+	for _, dir := range a.getFilesSliceToCreateByDatabase() {
+		filesPaths = append(filesPaths, a.renameResourcePath(string(dir)))
+	}
+	return foldersPaths, filesPaths
+}
+
 func (a *App) factoryCopyContent(destiny, moduleName string) error {
-	switch a.db {
-	case EnumsRepository.Gorm:
-		return a.createFiles(destiny, moduleName, "standart-gorm")
-	default:
+	templateFolderName := GetTemplateFolderName(a.db, a.command)
+	if templateFolderName == "" {
 		return nil
 	}
+	return a.createFiles(destiny, moduleName, templateFolderName)
 }
 
 func (a *App) createFolders(pathDestiny string) error {
 	for _, dir := range a.getFoldersSliceToCreateByDatabase() {
-		err := os.MkdirAll(fmt.Sprintf("%s/%s", pathDestiny, dir), os.ModePerm)
+		err := os.MkdirAll(fmt.Sprintf("%s/%s", pathDestiny, a.renameResourcePath(string(dir))), os.ModePerm)
 		if err != nil {
 			return err
 		}
@@ -58,37 +139,34 @@ func (a *App) createFolders(pathDestiny string) error {
 }
 
 func (a *App) getFoldersSliceToCreateByDatabase() []folders.Folders {
-	switch a.db {
-	case EnumsRepository.Gorm:
-		list := folders.Values()
-		list = append(list, folders.ValuesGorm()...)
-		return list
-	default:
-		return []folders.Folders{}
-	}
+	return a.filterFoldersByFeatures(a.filterFoldersByDialects(GetFoldersByRepository(a.db, a.command)))
 }
 
 func (a *App) getFilesSliceToCreateByDatabase() []files.Files {
-	switch a.db {
-	case EnumsRepository.Gorm:
-		list := files.Values()
-		list = append(list, files.ValuesGorm()...)
-		return list
-	default:
-		return []files.Files{}
-	}
+	return a.filterFilesByReplicas(a.filterFilesByFeatures(a.filterFilesByDialects(GetFilesByRepository(a.db, a.command))))
 }
 
 func (a *App) createFiles(pathDestiny, moduleName, databaseFolderName string) error {
 	for _, dir := range a.getFilesSliceToCreateByDatabase() {
-		fileContent, err := a.getFileStringFromRepository("pkg/"+databaseFolderName, string(dir))
+		fileContent, err := a.getFileContent(databaseFolderName, dir)
 		if err != nil {
 			return err
 		}
-		if dir != files.Readme {
-			fileContent = a.replaceImportsToModuleName(fileContent, moduleName)
+		fileContent = a.pruneDialects(string(dir), fileContent)
+		fileContent = a.pruneReplicas(string(dir), fileContent)
+		fileContent, err = a.pruneFeatures(string(dir), fileContent)
+		if err != nil {
+			return err
 		}
-		err = a.writeContent(pathDestiny, string(dir), fileContent)
+		fileContent, err = a.renameResource(string(dir), fileContent)
+		if err != nil {
+			return err
+		}
+		fileContent, err = a.renderContent(string(dir), fileContent, moduleName)
+		if err != nil {
+			return err
+		}
+		err = a.writeContent(pathDestiny, a.renameResourcePath(string(dir)), fileContent)
 		if err != nil {
 			return err
 		}
@@ -96,24 +174,142 @@ func (a *App) createFiles(pathDestiny, moduleName, databaseFolderName string) er
 	return nil
 }
 
+func (a *App) getFileContent(databaseFolderName string, dir files.Files) ([]byte, error) {
+	if dir == files.InternalRoutesRoutes && a.router != EnumsRouters.Chi && a.router != "" {
+		fileContent, err := a.getFileStringFromRepository("pkg/"+RoutersTemplateFolderName, GetRoutesFileByRouter(a.router))
+		if err != nil {
+			return nil, err
+		}
+		return a.replaceRoutersImportsToTemplate(removeBuildConstraints(fileContent), databaseFolderName), nil
+	}
+	templateFolderName := a.getTemplateFolderNameOfFile(databaseFolderName, dir)
+	fileContent, err := a.getFileStringFromRepository("pkg/"+templateFolderName, string(dir))
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(string(dir)) == ".go" {
+		fileContent = removeBuildConstraints(fileContent)
+	}
+	if templateFolderName != databaseFolderName {
+		fileContent = replaceLayersToTemplate(fileContent, databaseFolderName)
+	}
+	return fileContent, nil
+}
+
+// getTemplateFolderNameOfFile return the template with the file, the files of gorm not changed by the templates of
+// grpc and graphql are read from the template of gorm
+func (a *App) getTemplateFolderNameOfFile(databaseFolderName string, dir files.Files) string {
+	var filesOfTemplate []files.Files
+	switch a.command {
+	case EnumsCommands.GRPC:
+		filesOfTemplate = append(files.ValuesGRPC(), files.ValuesChangedGRPC()...)
+	case EnumsCommands.GraphQL:
+		filesOfTemplate = append(files.ValuesGraphQL(), files.ValuesChangedGraphQL()...)
+	default:
+		return databaseFolderName
+	}
+	for _, file := range filesOfTemplate {
+		if file == dir {
+			return databaseFolderName
+		}
+	}
+	return LayersTemplateFolderName
+}
+
+// replaceLayersToTemplate point the imports and the names of the manifests of the file of gorm to the template
+// selected, so the render replace them like the other files
+func replaceLayersToTemplate(fileContent []byte, databaseFolderName string) []byte {
+	return []byte(strings.ReplaceAll(string(fileContent), LayersTemplateFolderName, databaseFolderName))
+}
+
+// replaceRoutersImportsToTemplate point the imports of routes to the template selected, so the render replace them
+// to the module name like the other files
+func (a *App) replaceRoutersImportsToTemplate(fileContent []byte, databaseFolderName string) []byte {
+	importRouters := ImportModuleName + "/pkg/" + RoutersTemplateFolderName + "/"
+	importTemplate := ImportModuleName + "/pkg/" + databaseFolderName + "/"
+
+	return []byte(strings.ReplaceAll(string(fileContent), importRouters, importTemplate))
+}
+
+func removeBuildConstraints(fileContent []byte) []byte {
+	lines := []string{}
+	for _, line := range strings.Split(string(fileContent), "\n") {
+		if !strings.HasPrefix(line, "//go:build") && !strings.HasPrefix(line, "// +build") {
+			lines = append(lines, line)
+		}
+	}
+	return []byte(strings.TrimLeft(strings.Join(lines, "\n"), "\n"))
+}
+
+func (a *App) renderContent(dir string, fileContent []byte, moduleName string) ([]byte, error) {
+	if dir != string(files.Readme) {
+		endPhase := logger.StartPhase("render")
+		fileContent = a.replaceImportsToModuleName(fileContent, moduleName)
+		endPhase()
+	}
+	if dir == string(files.Readme) || strings.HasPrefix(dir, string(folders.DeploymentsKubernetes)+"/") {
+		fileContent = a.replaceTemplateNameToProjectName(fileContent, moduleName)
+	}
+	return a.formatContent(dir, fileContent)
+}
+
+func (a *App) formatContent(dir string, fileContent []byte) ([]byte, error) {
+	if filepath.Ext(dir) != ".go" {
+		return fileContent, nil
+	}
+	defer logger.StartPhase("format")()
+	formatted, err := format.Source(fileContent)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+	return formatted, nil
+}
+
 func (a *App) writeContent(pathDestiny, dir string, fileContent []byte) error {
+	defer logger.StartPhase("write")()
 	absPath := fmt.Sprintf("%s/%s", pathDestiny, dir)
 	err := ioutil.WriteFile(absPath, fileContent, os.ModePerm)
 	if err != nil {
 		return err
 	}
-	logger.PRINT("File generated with success: " + absPath)
+	logger.INFO("File generated with success: " + absPath)
 	return nil
 }
 
 func (a *App) replaceImportsToModuleName(fileContent []byte, moduleName string) []byte {
-	importModule := ImportModuleName + "/pkg/standart-gorm"
-
-	fileContentReplaced := strings.ReplaceAll(string(fileContent), importModule, moduleName)
+	fileContentReplaced := strings.ReplaceAll(string(fileContent), a.getImportTemplate(), moduleName)
 
 	return []byte(fileContentReplaced)
 }
 
+func (a *App) getImportTemplate() string {
+	return ImportModuleName + "/pkg/" + GetTemplateFolderName(a.db, a.command)
+}
+
+// replaceTemplateNameToProjectName rename the resources, labels and image of the manifests and the docker commands of
+// the readme, named as the template, to the name of the project
+func (a *App) replaceTemplateNameToProjectName(fileContent []byte, moduleName string) []byte {
+	templateName := GetTemplateFolderName(a.db, a.command)
+	projectName := getProjectName(moduleName)
+	if projectName == "" {
+		return fileContent
+	}
+	return []byte(strings.ReplaceAll(string(fileContent), templateName, projectName))
+}
+
+// getProjectName return the last element of the module without the major version as a valid name of the kubernetes,
+// like api to example.com/my_api/v2
+func getProjectName(moduleName string) string {
+	if prefix, _, ok := module.SplitPathVersion(moduleName); ok && prefix != "" {
+		moduleName = prefix
+	}
+	name := projectNameInvalid.ReplaceAllString(strings.ToLower(path.Base(moduleName)), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.Trim(name, "-")
+}
+
 func (a *App) replaceModuleToModuleName(fileContent []byte, moduleName string) []byte {
 	fileContentReplaced := strings.ReplaceAll(string(fileContent), ImportModuleName, moduleName)
 
@@ -122,6 +318,9 @@ func (a *App) replaceModuleToModuleName(fileContent []byte, moduleName string) [
 
 func (a *App) copyDefaultFiles(pathDestiny, moduleName string) error {
 	for _, dir := range files.ValuesNoGO() {
+		if a.skipGoMod && (dir == files.GoMod || dir == files.GoSum) {
+			continue
+		}
 		fileContent, err := a.getFileStringFromRepository("", string(dir))
 		if err != nil {
 			return err
@@ -138,7 +337,129 @@ func (a *App) copyDefaultFiles(pathDestiny, moduleName string) error {
 }
 
 func (a *App) getFileStringFromRepository(databaseFolderName, dir string) ([]byte, error) {
-	latestVersionStable := environment.GetEnvString("GO_GENERATOR_TAG_NAME", "master")
-	routerGithub := fmt.Sprintf("%s/%s/%s", latestVersionStable, databaseFolderName, dir)
+	defer logger.StartPhase("fetch")()
+	if a.templatesPath != "" {
+		return ioutil.ReadFile(filepath.Join(a.templatesPath, databaseFolderName, dir))
+	}
+	routerGithub := fmt.Sprintf("%s/%s/%s", GetTemplateVersion(), databaseFolderName, dir)
 	return github.GetFileFromGithub(routerGithub)
 }
+
+func GetTemplateFolderName(db EnumsRepository.Repository, command EnumsCommands.Command) string {
+	switch command {
+	case EnumsCommands.App:
+		return getAppTemplateFolderName(db)
+	case EnumsCommands.GRPC:
+		if db == EnumsRepository.Gorm {
+			return "standart-gorm-grpc"
+		}
+		return ""
+	case EnumsCommands.GraphQL:
+		if db == EnumsRepository.Gorm {
+			return "standart-gorm-graphql"
+		}
+		return ""
+	default:
+		return ""
+	}
+}
+
+func getAppTemplateFolderName(db EnumsRepository.Repository) string {
+	switch db {
+	case EnumsRepository.Gorm:
+		return "standart-gorm"
+	case EnumsRepository.SQL:
+		return "standart-sql"
+	case EnumsRepository.Gorm2:
+		return "standart-gorm2"
+	case EnumsRepository.Bolt:
+		return "standart-bolt"
+	case EnumsRepository.Memory:
+		return "standart-memory"
+	default:
+		return ""
+	}
+}
+
+// GetRoutesFileByRouter return the file of routes of the router, chi is the routes.go of each template
+func GetRoutesFileByRouter(router EnumsRouters.Router) string {
+	if router == EnumsRouters.Chi {
+		return string(files.InternalRoutesRoutes)
+	}
+	return fmt.Sprintf("internal/routes/routes_%s.go", router)
+}
+
+// IsRouterSupported return false when the routes of the generate type are not in the routes of the routers
+func IsRouterSupported(command EnumsCommands.Command, router EnumsRouters.Router) bool {
+	return router == EnumsRouters.Chi || command != EnumsCommands.GraphQL
+}
+
+func GetTemplateVersion() string {
+	return environment.GetEnvString("GO_GENERATOR_TAG_NAME", "master")
+}
+
+func GetTemplateSource(db EnumsRepository.Repository, command EnumsCommands.Command) string {
+	return fmt.Sprintf("%s/%s/pkg/%s", github.URLBase, GetTemplateVersion(), GetTemplateFolderName(db, command))
+}
+
+func GetFoldersByRepository(db EnumsRepository.Repository, command EnumsCommands.Command) []folders.Folders {
+	if GetTemplateFolderName(db, command) == "" {
+		return []folders.Folders{}
+	}
+	list := getAppFolders(db)
+	switch command {
+	case EnumsCommands.GRPC:
+		list = append(list, folders.ValuesGRPC()...)
+	case EnumsCommands.GraphQL:
+		list = append(list, folders.ValuesGraphQL()...)
+	}
+	return list
+}
+
+func getAppFolders(db EnumsRepository.Repository) []folders.Folders {
+	switch db {
+	case EnumsRepository.Gorm, EnumsRepository.Gorm2:
+		list := folders.Values()
+		list = append(list, folders.ValuesGorm()...)
+		return list
+	case EnumsRepository.SQL:
+		list := folders.Values()
+		list = append(list, folders.ValuesSQL()...)
+		return list
+	case EnumsRepository.Bolt, EnumsRepository.Memory:
+		return folders.Values()
+	default:
+		return []folders.Folders{}
+	}
+}
+
+func GetFilesByRepository(db EnumsRepository.Repository, command EnumsCommands.Command) []files.Files {
+	if GetTemplateFolderName(db, command) == "" {
+		return []files.Files{}
+	}
+	list := getAppFiles(db)
+	switch command {
+	case EnumsCommands.GRPC:
+		list = append(list, files.ValuesGRPC()...)
+	case EnumsCommands.GraphQL:
+		list = append(list, files.ValuesGraphQL()...)
+	}
+	return list
+}
+
+func getAppFiles(db EnumsRepository.Repository) []files.Files {
+	switch db {
+	case EnumsRepository.Gorm, EnumsRepository.Gorm2:
+		list := files.Values()
+		list = append(list, files.ValuesGorm()...)
+		return list
+	case EnumsRepository.SQL:
+		list := files.Values()
+		list = append(list, files.ValuesSQL()...)
+		return list
+	case EnumsRepository.Bolt, EnumsRepository.Memory:
+		return files.Values()
+	default:
+		return []files.Files{}
+	}
+}
@@ -2,7 +2,13 @@ package app
 
 import (
 	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	"github.com/wilian746/go-generator/internal/enums/features"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
 	"github.com/wilian746/go-generator/internal/enums/repository"
+	"github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"github.com/wilian746/go-generator/internal/enums/routers"
 	"testing"
 )
 
@@ -14,3 +20,245 @@ func TestServer_CreateFoldersAndFiles(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestApp_GetFoldersAndFiles(t *testing.T) {
+	t.Run("Should return the paths of the template to the repository", func(t *testing.T) {
+		foldersPaths, filesPaths := NewApp().GetFoldersAndFiles(repository.Bolt)
+		assert.Len(t, foldersPaths, len(GetFoldersByRepository(repository.Bolt, commands.App)))
+		assert.Len(t, filesPaths, len(GetFilesByRepository(repository.Bolt, commands.App)))
+	})
+	t.Run("Should return the paths with the resource renamed and without the excluded", func(t *testing.T) {
+		foldersPaths, filesPaths := NewApp().SetResource("invoice").SetDialects([]dialects.Dialect{dialects.MySQL}).
+			SetWithout([]features.Feature{features.Tests}).GetFoldersAndFiles(repository.SQL)
+		assert.Contains(t, foldersPaths, "internal/handlers/invoice")
+		assert.Contains(t, filesPaths, "internal/handlers/invoice/invoice.go")
+		assert.Contains(t, filesPaths, "migrations/mysql/20200607175350_create_table_invoices.up.sql")
+		assert.NotContains(t, filesPaths, "internal/handlers/invoice/invoice_test.go")
+		assert.NotContains(t, filesPaths, string(files.InternalHandlersProductProduct))
+		for _, file := range filesPaths {
+			assert.NotContains(t, file, "migrations/postgres", file)
+		}
+	})
+}
+
+func TestGetTemplateSource(t *testing.T) {
+	t.Run("Should return source of the gorm template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-gorm",
+			GetTemplateSource(repository.Gorm, commands.App))
+	})
+	t.Run("Should return source of the gorm2 template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-gorm2",
+			GetTemplateSource(repository.Gorm2, commands.App))
+	})
+	t.Run("Should return source of the sql template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-sql",
+			GetTemplateSource(repository.SQL, commands.App))
+	})
+	t.Run("Should return source of the bolt template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-bolt",
+			GetTemplateSource(repository.Bolt, commands.App))
+	})
+	t.Run("Should return source of the memory template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-memory",
+			GetTemplateSource(repository.Memory, commands.App))
+	})
+	t.Run("Should return source of the gorm grpc template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-gorm-grpc",
+			GetTemplateSource(repository.Gorm, commands.GRPC))
+	})
+	t.Run("Should return source of the gorm graphql template on github", func(t *testing.T) {
+		assert.Equal(t, "https://raw.githubusercontent.com/wilian746/go-generator/master/pkg/standart-gorm-graphql",
+			GetTemplateSource(repository.Gorm, commands.GraphQL))
+	})
+}
+
+func TestGetFoldersByRepository(t *testing.T) {
+	t.Run("Should return default and migrations folders to gorm", func(t *testing.T) {
+		list := GetFoldersByRepository(repository.Gorm, commands.App)
+		assert.Contains(t, list, folders.Cmd)
+		assert.Contains(t, list, folders.MigrationsPostgres)
+	})
+	t.Run("Should return default, migrations and query folders to sql", func(t *testing.T) {
+		list := GetFoldersByRepository(repository.SQL, commands.App)
+		assert.Contains(t, list, folders.Cmd)
+		assert.Contains(t, list, folders.MigrationsSQLite3)
+		assert.Contains(t, list, folders.PkgRepositoryQuery)
+	})
+	t.Run("Should return only default folders to bolt", func(t *testing.T) {
+		assert.Equal(t, folders.Values(), GetFoldersByRepository(repository.Bolt, commands.App))
+	})
+	t.Run("Should return empty folders to unknown repository", func(t *testing.T) {
+		assert.Empty(t, GetFoldersByRepository(repository.Unknown, commands.App))
+	})
+	t.Run("Should return folders of gorm and grpc to gorm grpc", func(t *testing.T) {
+		list := GetFoldersByRepository(repository.Gorm, commands.GRPC)
+		assert.Contains(t, list, folders.MigrationsPostgres)
+		assert.Contains(t, list, folders.APIProtoProduct)
+		assert.Contains(t, list, folders.InternalGrpcServer)
+	})
+	t.Run("Should return empty folders to repository without grpc", func(t *testing.T) {
+		assert.Empty(t, GetFoldersByRepository(repository.SQL, commands.GRPC))
+	})
+	t.Run("Should return folders of gorm and graphql to gorm graphql", func(t *testing.T) {
+		list := GetFoldersByRepository(repository.Gorm, commands.GraphQL)
+		assert.Contains(t, list, folders.MigrationsPostgres)
+		assert.Contains(t, list, folders.InternalGraphQLResolvers)
+		assert.NotContains(t, list, folders.InternalGrpcServer)
+	})
+	t.Run("Should return empty folders to repository without graphql", func(t *testing.T) {
+		assert.Empty(t, GetFoldersByRepository(repository.Memory, commands.GraphQL))
+	})
+}
+
+func TestGetFilesByRepository(t *testing.T) {
+	t.Run("Should return default and migrations files to gorm", func(t *testing.T) {
+		list := GetFilesByRepository(repository.Gorm, commands.App)
+		assert.Contains(t, list, files.CmdMain)
+		assert.Contains(t, list, files.MigrationsPostgresCreateTableProductsUp)
+	})
+	t.Run("Should return the same files of gorm to gorm2", func(t *testing.T) {
+		assert.Equal(t, GetFilesByRepository(repository.Gorm, commands.App),
+			GetFilesByRepository(repository.Gorm2, commands.App))
+	})
+	t.Run("Should return default, migrations and query files to sql", func(t *testing.T) {
+		list := GetFilesByRepository(repository.SQL, commands.App)
+		assert.Contains(t, list, files.CmdMain)
+		assert.Contains(t, list, files.MigrationsSQLite3CreateTableProductsUp)
+		assert.Contains(t, list, files.PkgRepositoryQueryQuery)
+	})
+	t.Run("Should return only default files to bolt without migrations", func(t *testing.T) {
+		list := GetFilesByRepository(repository.Bolt, commands.App)
+		assert.Equal(t, files.Values(), list)
+		assert.NotContains(t, list, files.MigrationsPostgresCreateTableProductsUp)
+	})
+	t.Run("Should return the same files of bolt to memory", func(t *testing.T) {
+		assert.Equal(t, GetFilesByRepository(repository.Bolt, commands.App),
+			GetFilesByRepository(repository.Memory, commands.App))
+	})
+	t.Run("Should return empty files to unknown repository", func(t *testing.T) {
+		assert.Empty(t, GetFilesByRepository(repository.Unknown, commands.App))
+	})
+	t.Run("Should return files of gorm and grpc to gorm grpc", func(t *testing.T) {
+		list := GetFilesByRepository(repository.Gorm, commands.GRPC)
+		assert.Contains(t, list, files.MigrationsPostgresCreateTableProductsUp)
+		assert.Contains(t, list, files.APIProtoProductProto)
+		assert.Contains(t, list, files.InternalGrpcServerServerTest)
+	})
+	t.Run("Should return empty files to repository without grpc", func(t *testing.T) {
+		assert.Empty(t, GetFilesByRepository(repository.Bolt, commands.GRPC))
+	})
+	t.Run("Should return files of gorm, graphql and migrations of relations to gorm graphql", func(t *testing.T) {
+		list := GetFilesByRepository(repository.Gorm, commands.GraphQL)
+		assert.Contains(t, list, files.MigrationsPostgresCreateTableProductsUp)
+		assert.Contains(t, list, files.MigrationsPostgresCreateTableDoctorsPatientsUp)
+		assert.Contains(t, list, files.InternalGraphQLSchemaSchema)
+		assert.Contains(t, list, files.InternalHandlersGraphQLGraphQL)
+	})
+	t.Run("Should return empty files to repository without graphql", func(t *testing.T) {
+		assert.Empty(t, GetFilesByRepository(repository.SQL, commands.GraphQL))
+	})
+}
+
+func TestApp_replaceImportsToModuleName(t *testing.T) {
+	t.Run("Should replace imports of the template selected", func(t *testing.T) {
+		content := []byte(`import "github.com/wilian746/go-generator/pkg/standart-sql/configs"`)
+		replaced := (&App{db: repository.SQL, command: commands.App}).replaceImportsToModuleName(content, "example.com/api")
+		assert.Equal(t, `import "example.com/api/configs"`, string(replaced))
+	})
+	t.Run("Should replace go_package of proto of the grpc template", func(t *testing.T) {
+		content := []byte(`option go_package = "github.com/wilian746/go-generator/pkg/standart-gorm-grpc/api/proto/product";`)
+		app := NewApp().SetCommand(commands.GRPC).(*App)
+		app.db = repository.Gorm
+		replaced := app.replaceImportsToModuleName(content, "example.com/api")
+		assert.Equal(t, `option go_package = "example.com/api/api/proto/product";`, string(replaced))
+	})
+}
+
+func TestApp_replaceTemplateNameToProjectName(t *testing.T) {
+	t.Run("Should replace the name of the template selected to the name of the project", func(t *testing.T) {
+		content := []byte("metadata:\n  name: standart-gorm-grpc\n")
+		app := NewApp().SetCommand(commands.GRPC).(*App)
+		app.db = repository.Gorm
+		replaced := app.replaceTemplateNameToProjectName(content, "example.com/api")
+		assert.Equal(t, "metadata:\n  name: api\n", string(replaced))
+	})
+	t.Run("Should keep the name of the template when the module has not a valid name", func(t *testing.T) {
+		content := []byte("image: standart-sql")
+		replaced := (&App{db: repository.SQL, command: commands.App}).replaceTemplateNameToProjectName(content, "_")
+		assert.Equal(t, "image: standart-sql", string(replaced))
+	})
+}
+
+func TestGetProjectName(t *testing.T) {
+	t.Run("Should return the last element of the module", func(t *testing.T) {
+		assert.Equal(t, "api", getProjectName("github.com/wilian746/api"))
+	})
+	t.Run("Should return the name without the major version of the module", func(t *testing.T) {
+		assert.Equal(t, "api", getProjectName("example.com/api/v2"))
+	})
+	t.Run("Should return the name in lower case with the invalid characters replaced", func(t *testing.T) {
+		assert.Equal(t, "my-api", getProjectName("example.com/My_API."))
+	})
+	t.Run("Should return empty when the module has not a valid character", func(t *testing.T) {
+		assert.Equal(t, "", getProjectName("_"))
+	})
+}
+
+func TestApp_replaceRoutersImportsToTemplate(t *testing.T) {
+	t.Run("Should replace imports of routes to the template selected without change prefix of others", func(t *testing.T) {
+		content := []byte(`import "github.com/wilian746/go-generator/pkg/standart-gorm/configs"`)
+		replaced := NewApp().(*App).replaceRoutersImportsToTemplate(content, "standart-gorm2")
+		assert.Equal(t, `import "github.com/wilian746/go-generator/pkg/standart-gorm2/configs"`, string(replaced))
+	})
+}
+
+func TestRemoveBuildConstraints(t *testing.T) {
+	t.Run("Should remove build constraints of the routes", func(t *testing.T) {
+		content := "//go:build gin\n// +build gin\n\npackage routes\n"
+		assert.Equal(t, "package routes\n", string(removeBuildConstraints([]byte(content))))
+	})
+	t.Run("Should keep content without build constraints", func(t *testing.T) {
+		assert.Equal(t, "package routes\n", string(removeBuildConstraints([]byte("package routes\n"))))
+	})
+}
+
+func TestGetRoutesFileByRouter(t *testing.T) {
+	t.Run("Should return routes of template to chi", func(t *testing.T) {
+		assert.Equal(t, "internal/routes/routes.go", GetRoutesFileByRouter(routers.Chi))
+	})
+	t.Run("Should return routes with suffix of router to others", func(t *testing.T) {
+		assert.Equal(t, "internal/routes/routes_gin.go", GetRoutesFileByRouter(routers.Gin))
+		assert.Equal(t, "internal/routes/routes_echo.go", GetRoutesFileByRouter(routers.Echo))
+		assert.Equal(t, "internal/routes/routes_stdlib.go", GetRoutesFileByRouter(routers.Stdlib))
+	})
+}
+
+func TestIsRouterSupported(t *testing.T) {
+	t.Run("Should support all routers to app and grpc", func(t *testing.T) {
+		for _, router := range routers.Values() {
+			assert.True(t, IsRouterSupported(commands.App, router))
+			assert.True(t, IsRouterSupported(commands.GRPC, router))
+		}
+	})
+	t.Run("Should support only chi to graphql", func(t *testing.T) {
+		assert.True(t, IsRouterSupported(commands.GraphQL, routers.Chi))
+		assert.False(t, IsRouterSupported(commands.GraphQL, routers.Gin))
+	})
+}
+
+func TestApp_formatContent(t *testing.T) {
+	t.Run("Should format go files", func(t *testing.T) {
+		content, err := (&App{}).formatContent("main.go", []byte("package main\nimport (\n\"os\"\n\"fmt\"\n)\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, "package main\n\nimport (\n\t\"fmt\"\n\t\"os\"\n)\n", string(content))
+	})
+	t.Run("Should not format files that are not go files", func(t *testing.T) {
+		content, err := (&App{}).formatContent("go.mod", []byte("module  example"))
+		assert.NoError(t, err)
+		assert.Equal(t, "module  example", string(content))
+	})
+	t.Run("Should return error when go file is invalid", func(t *testing.T) {
+		_, err := (&App{}).formatContent("main.go", []byte("package"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,186 @@
+package app
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	"github.com/wilian746/go-generator/internal/enums/features"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/enums/repository"
+	"github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func newAppWithDialects(db repository.Repository, selected ...dialects.Dialect) *App {
+	app := NewApp().SetDialects(selected).(*App)
+	app.db = db
+	return app
+}
+
+func readTemplateFile(t *testing.T, templateFolderName string, file files.Files) []byte {
+	content, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "..", "pkg", templateFolderName, string(file)))
+	assert.NoError(t, err)
+	return content
+}
+
+func TestIsDialectsSupported(t *testing.T) {
+	t.Run("Should support dialects to repositories with database server", func(t *testing.T) {
+		assert.True(t, IsDialectsSupported(repository.Gorm))
+		assert.True(t, IsDialectsSupported(repository.Gorm2))
+		assert.True(t, IsDialectsSupported(repository.SQL))
+	})
+	t.Run("Should not support dialects to bolt and memory", func(t *testing.T) {
+		assert.False(t, IsDialectsSupported(repository.Bolt))
+		assert.False(t, IsDialectsSupported(repository.Memory))
+	})
+}
+
+func TestAddDialectOfTests(t *testing.T) {
+	t.Run("Should add sqlite3 when tests are generated and it is not selected", func(t *testing.T) {
+		selected, added := AddDialectOfTests([]dialects.Dialect{dialects.MySQL}, nil)
+		assert.True(t, added)
+		assert.Equal(t, []dialects.Dialect{dialects.MySQL, dialects.SQLite3}, selected)
+	})
+	t.Run("Should not add sqlite3 when tests are not generated", func(t *testing.T) {
+		selected, added := AddDialectOfTests([]dialects.Dialect{dialects.MySQL}, []features.Feature{features.Tests})
+		assert.False(t, added)
+		assert.Equal(t, []dialects.Dialect{dialects.MySQL}, selected)
+	})
+	t.Run("Should not add sqlite3 when it is selected or dialects are not selected", func(t *testing.T) {
+		_, added := AddDialectOfTests([]dialects.Dialect{dialects.SQLite3, dialects.MySQL}, nil)
+		assert.False(t, added)
+		selected, added := AddDialectOfTests(nil, nil)
+		assert.False(t, added)
+		assert.Empty(t, selected)
+	})
+}
+
+func TestGetDialectsByDrivers(t *testing.T) {
+	t.Run("Should return the dialects of the drivers imported by the database", func(t *testing.T) {
+		selected := GetDialectsByDrivers([]string{"gorm.io/gorm", "gorm.io/driver/sqlite", "gorm.io/driver/postgres"})
+		assert.Equal(t, []dialects.Dialect{dialects.Postgres, dialects.SQLite3}, selected)
+		assert.Equal(t, []dialects.Dialect{dialects.MySQL}, GetDialectsByDrivers([]string{"github.com/go-sql-driver/mysql"}))
+		assert.Empty(t, GetDialectsByDrivers([]string{"go.etcd.io/bbolt"}))
+	})
+}
+
+func TestApp_filterFilesByDialects(t *testing.T) {
+	t.Run("Should keep all files when dialects are not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm)
+		list := GetFilesByRepository(repository.Gorm, commands.App)
+		assert.Equal(t, list, app.filterFilesByDialects(list))
+	})
+	t.Run("Should keep only migrations of dialects selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.SQL, dialects.Postgres, dialects.SQLite3)
+		filtered := app.filterFilesByDialects(GetFilesByRepository(repository.SQL, commands.App))
+		assert.Contains(t, filtered, files.MigrationsPostgresCreateTableProductsUp)
+		assert.Contains(t, filtered, files.MigrationsSQLite3CreateTableProductsUp)
+		assert.NotContains(t, filtered, files.MigrationsMysqlCreateTableProductsUp)
+		assert.NotContains(t, filtered, files.MigrationsSQLServerCreateTableProductsDown)
+		assert.Contains(t, filtered, files.DeploymentsDockerCompose)
+	})
+	t.Run("Should remove migrations of relations of graphql of dialects not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm, dialects.Postgres, dialects.SQLite3)
+		filtered := app.filterFilesByDialects(GetFilesByRepository(repository.Gorm, commands.GraphQL))
+		assert.Contains(t, filtered, files.MigrationsPostgresCreateTableOrdersUp)
+		assert.NotContains(t, filtered, files.MigrationsMysqlCreateTableOrdersUp)
+	})
+	t.Run("Should remove docker-compose when dialects selected not have server", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm, dialects.SQLite3)
+		filtered := app.filterFilesByDialects(GetFilesByRepository(repository.Gorm, commands.App))
+		assert.NotContains(t, filtered, files.DeploymentsDockerCompose)
+		assert.Contains(t, filtered, files.DeploymentsDockerfile)
+		assert.Contains(t, app.filterFoldersByDialects(GetFoldersByRepository(repository.Gorm, commands.App)),
+			folders.Deployments)
+	})
+	t.Run("Should keep sqlite3 used by tests when it is not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.SQL, dialects.Postgres)
+		filtered := app.filterFilesByDialects(GetFilesByRepository(repository.SQL, commands.App))
+		assert.Contains(t, filtered, files.MigrationsSQLite3CreateTableProductsUp)
+		app.without = []features.Feature{features.Tests}
+		filtered = app.filterFilesByDialects(GetFilesByRepository(repository.SQL, commands.App))
+		assert.NotContains(t, filtered, files.MigrationsSQLite3CreateTableProductsUp)
+	})
+	t.Run("Should keep all files to repositories without dialects", func(t *testing.T) {
+		app := newAppWithDialects(repository.Bolt, dialects.SQLite3)
+		list := GetFilesByRepository(repository.Bolt, commands.App)
+		assert.Equal(t, list, app.filterFilesByDialects(list))
+	})
+}
+
+func TestApp_filterFoldersByDialects(t *testing.T) {
+	t.Run("Should keep only migrations folders of dialects selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm, dialects.SQLServer, dialects.SQLite3)
+		filtered := app.filterFoldersByDialects(GetFoldersByRepository(repository.Gorm, commands.App))
+		assert.Contains(t, filtered, folders.MigrationsSQLServer)
+		assert.NotContains(t, filtered, folders.MigrationsPostgres)
+		assert.NotContains(t, filtered, folders.MigrationsMysql)
+		assert.Contains(t, filtered, folders.Deployments)
+	})
+}
+
+func TestApp_pruneDialects(t *testing.T) {
+	t.Run("Should keep content when all dialects are selected", func(t *testing.T) {
+		content := readTemplateFile(t, "standart-gorm", files.PkgRepositoryDatabaseDatabase)
+		assert.Equal(t, content, newAppWithDialects(repository.Gorm).pruneDialects(
+			string(files.PkgRepositoryDatabaseDatabase), content))
+	})
+	t.Run("Should remove drivers of gorm not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm, dialects.Postgres, dialects.SQLite3)
+		content := readTemplateFile(t, "standart-gorm", files.PkgRepositoryDatabaseDatabase)
+		pruned, err := app.formatContent("database.go",
+			app.pruneDialects(string(files.PkgRepositoryDatabaseDatabase), content))
+		assert.NoError(t, err)
+		assert.Contains(t, string(pruned), `"github.com/jinzhu/gorm/dialects/postgres"`)
+		assert.Contains(t, string(pruned), `"github.com/jinzhu/gorm/dialects/sqlite"`)
+		assert.NotContains(t, string(pruned), `"github.com/jinzhu/gorm/dialects/mysql"`)
+		assert.NotContains(t, string(pruned), `"github.com/jinzhu/gorm/dialects/mssql"`)
+		assert.Contains(t, string(pruned), `return []string{"postgres", "sqlite3"}`)
+	})
+	t.Run("Should remove drivers and cases of gorm2 not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm2, dialects.MySQL, dialects.SQLite3)
+		content := readTemplateFile(t, "standart-gorm2", files.PkgRepositoryDatabaseDatabase)
+		pruned, err := app.formatContent("database.go",
+			app.pruneDialects(string(files.PkgRepositoryDatabaseDatabase), content))
+		assert.NoError(t, err)
+		assert.Contains(t, string(pruned), "return mysql.Open(uri)")
+		assert.NotContains(t, string(pruned), "postgres.Open(uri)")
+		assert.NotContains(t, string(pruned), `"gorm.io/driver/sqlserver"`)
+		assert.NotContains(t, string(pruned), `case "mssql":`)
+		assert.Contains(t, string(pruned), `return []string{"mysql", "sqlite3"}`)
+	})
+	t.Run("Should remove driver and case of sqlite3 of gorm2 when tests are not generated", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm2, dialects.MySQL)
+		app.without = []features.Feature{features.Tests}
+		content := readTemplateFile(t, "standart-gorm2", files.PkgRepositoryDatabaseDatabase)
+		pruned, err := app.formatContent("database.go",
+			app.pruneDialects(string(files.PkgRepositoryDatabaseDatabase), content))
+		assert.NoError(t, err)
+		assert.NotContains(t, string(pruned), "sqlite.")
+		assert.NotContains(t, string(pruned), `"gorm.io/driver/sqlite"`)
+		assert.Contains(t, string(pruned), "\tdefault:\n\t\tlog.Panic(\"Error\", ErrDialectInvalid)")
+		assert.Contains(t, string(pruned), `return []string{"mysql"}`)
+	})
+	t.Run("Should remove drivers of database/sql not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.SQL, dialects.SQLServer, dialects.SQLite3)
+		content := readTemplateFile(t, "standart-sql", files.PkgRepositoryDatabaseDatabase)
+		pruned, err := app.formatContent("database.go",
+			app.pruneDialects(string(files.PkgRepositoryDatabaseDatabase), content))
+		assert.NoError(t, err)
+		assert.Contains(t, string(pruned), `"github.com/denisenkom/go-mssqldb"`)
+		assert.NotContains(t, string(pruned), `"github.com/lib/pq"`)
+		assert.NotContains(t, string(pruned), `"github.com/go-sql-driver/mysql"`)
+		assert.Contains(t, string(pruned), `return []string{"sqlite3", "mssql"}`)
+	})
+	t.Run("Should remove services of docker-compose not selected", func(t *testing.T) {
+		app := newAppWithDialects(repository.Gorm, dialects.Postgres, dialects.SQLite3)
+		content := readTemplateFile(t, "standart-gorm", files.DeploymentsDockerCompose)
+		pruned := string(app.pruneDialects(string(files.DeploymentsDockerCompose), content))
+		assert.Contains(t, pruned, "  postgresql:\n    container_name: postgresql")
+		assert.NotContains(t, pruned, "mysql")
+		assert.NotContains(t, pruned, "sqlserver")
+		assert.Contains(t, pruned, "networks:\n  horus_net:")
+	})
+}
@@ -0,0 +1,205 @@
+package app
+
+import (
+	"fmt"
+	EnumsFeatures "github.com/wilian746/go-generator/internal/enums/features"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	importSwagger = "github.com/swaggo/http-swagger"
+	importCors    = "github.com/go-chi/cors"
+	probeHealth   = "httpGet:\n              path: /api/v1/health\n              port: http"
+	probeTCP      = "tcpSocket:\n              port: http"
+
+	readmeProbeHealth = "probes in the route `/api/v1/health`"
+	readmeProbeTCP    = "probes in the port of the container"
+)
+
+func (a *App) isFeatureExcluded(feature EnumsFeatures.Feature) bool {
+	for _, excluded := range a.without {
+		if excluded == feature {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *App) isPathOfFeatureExcluded(path string) bool {
+	switch {
+	case a.isFeatureExcluded(EnumsFeatures.Swagger) && isPathOfSwagger(path):
+		return true
+	case a.isFeatureExcluded(EnumsFeatures.Health) && isPathOfHealth(path):
+		return true
+	case a.isFeatureExcluded(EnumsFeatures.Tests) && isPathOfTests(path):
+		return true
+	case a.isFeatureExcluded(EnumsFeatures.DockerCompose):
+		return path == string(files.DeploymentsDockerCompose)
+	default:
+		return false
+	}
+}
+
+func isPathOfSwagger(path string) bool {
+	return isPathInFolder(path, string(folders.Docs)) || isPathInFolder(path, string(folders.InternalEntitiesHealth)) ||
+		filepath.Base(path) == "swagger_entities.go"
+}
+
+func isPathOfHealth(path string) bool {
+	return isPathInFolder(path, string(folders.InternalHandlersHealth)) ||
+		isPathInFolder(path, string(folders.InternalEntitiesHealth))
+}
+
+// isPathOfTests return true to the tests and to the mock of adapter, because the mock is used only by the tests
+func isPathOfTests(path string) bool {
+	return strings.HasSuffix(path, "_test.go") || path == string(files.PkgRepositoryAdapterAdapterMock)
+}
+
+func isPathInFolder(path, folder string) bool {
+	return path == folder || strings.HasPrefix(path, folder+"/")
+}
+
+func (a *App) filterFilesByFeatures(list []files.Files) (filtered []files.Files) {
+	for _, file := range list {
+		if !a.isPathOfFeatureExcluded(string(file)) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+func (a *App) filterFoldersByFeatures(list []folders.Folders) (filtered []folders.Folders) {
+	for _, folder := range list {
+		if !a.isPathOfFeatureExcluded(string(folder)) {
+			filtered = append(filtered, folder)
+		}
+	}
+	return filtered
+}
+
+// pruneFeatures remove of the files kept the code of the features excluded, like the routes, the imports and the
+// fields of configs, so the project generated still build without them
+func (a *App) pruneFeatures(dir string, fileContent []byte) ([]byte, error) {
+	if len(a.without) == 0 {
+		return fileContent, nil
+	}
+	switch dir {
+	case string(files.Readme):
+		return a.pruneReadme(fileContent), nil
+	case string(files.DeploymentsKubernetesDeployment):
+		return a.pruneProbes(fileContent), nil
+	case string(files.DeploymentsKubernetesConfigMap):
+		return a.pruneConfigMap(fileContent), nil
+	}
+	if filepath.Ext(dir) != ".go" {
+		return fileContent, nil
+	}
+	editor := goast.NewEditor(fileContent)
+	if a.isFeatureExcluded(EnumsFeatures.Swagger) {
+		a.pruneSwagger(editor, dir)
+	}
+	if a.isFeatureExcluded(EnumsFeatures.Health) {
+		a.pruneHealth(editor, dir)
+	}
+	if a.isFeatureExcluded(EnumsFeatures.CORS) {
+		pruneCORS(editor, dir)
+	}
+	pruned, err := editor.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", dir, err)
+	}
+	return pruned, nil
+}
+
+func (a *App) pruneSwagger(editor *goast.Editor, dir string) {
+	importTemplate := a.getImportTemplate()
+	editor.RemoveCommentLines("// @")
+	switch dir {
+	case string(files.CmdMain):
+		editor.RemoveCalls("main", "setupSwagger").RemoveFunc("setupSwagger").
+			RemoveImport(importTemplate+"/docs").RemoveImportIfUnused("fmt", "fmt")
+	case string(files.ConfigsConfigs):
+		editor.RemoveStructField("Config", "SwaggerHost").RemoveKeyValue("Config", "SwaggerHost")
+	case string(files.InternalRoutesRoutes):
+		editor.RemoveCalls("SetRouters", "RouterSwagger").RemoveFunc("RouterSwagger").
+			RemoveImport(importSwagger).RemoveImportIfUnused("fmt", "fmt")
+	case string(files.InternalRoutesRoutesTest):
+		editor.RemoveCalls("TestRouter_SetRouters", "Run", "Should return ok when call swagger")
+	case string(files.InternalHandlersProductProduct):
+		editor.RemoveImport(importTemplate + "/internal/entities")
+	case string(files.InternalHandlersHealthHealth):
+		editor.RemoveImport(importTemplate + "/internal/entities/health")
+	}
+}
+
+func (a *App) pruneHealth(editor *goast.Editor, dir string) {
+	if dir == string(files.InternalRoutesRoutes) {
+		editor.RemoveCalls("SetRouters", "RouterHealth").RemoveFunc("RouterHealth").
+			RemoveImport(a.getImportTemplate() + "/internal/handlers/health")
+	}
+}
+
+func pruneCORS(editor *goast.Editor, dir string) {
+	switch dir {
+	case string(files.InternalRoutesRoutes):
+		editor.RemoveCalls("setConfigsRouters", "EnableCORS").RemoveFunc("EnableCORS")
+	case string(files.InternalRoutesRoutesTest):
+		editor.RemoveCalls("TestRouter_SetRouters", "Run", "Should pass request by middleware of cors")
+	case string(files.InternalRoutesConfig):
+		editor.RemoveFunc("Cors").RemoveImport(importCors).RemoveImportIfUnused("net/http", "http")
+	case string(files.InternalRoutesConfigTest):
+		editor.RemoveFunc("TestConfig_Cors")
+	case string(files.InternalUtilsHTTPResponse):
+		editor.RemoveCalls("sendResponse", "Set", "Access-Control-Allow-Origin")
+	}
+}
+
+// pruneReadme remove the section of swagger and the environment of host of swagger, and change the probes of health
+func (a *App) pruneReadme(fileContent []byte) []byte {
+	if a.isFeatureExcluded(EnumsFeatures.Health) {
+		fileContent = []byte(strings.ReplaceAll(string(fileContent), readmeProbeHealth, readmeProbeTCP))
+	}
+	if !a.isFeatureExcluded(EnumsFeatures.Swagger) {
+		return fileContent
+	}
+	lines := strings.Split(string(fileContent), "\n")
+	pruned := []string{}
+	for index := 0; index < len(lines); index++ {
+		if lines[index] == "## Swagger" {
+			for index+1 < len(lines) && !strings.HasPrefix(lines[index+1], "## ") {
+				index++
+			}
+			continue
+		}
+		if !strings.HasPrefix(lines[index], "| SWAGGER_HOST") {
+			pruned = append(pruned, lines[index])
+		}
+	}
+	return []byte(strings.Join(pruned, "\n"))
+}
+
+// pruneProbes change the liveness and readiness probes of the route of health to check only the port of the container
+func (a *App) pruneProbes(fileContent []byte) []byte {
+	if !a.isFeatureExcluded(EnumsFeatures.Health) {
+		return fileContent
+	}
+	return []byte(strings.ReplaceAll(string(fileContent), probeHealth, probeTCP))
+}
+
+// pruneConfigMap remove the environment of host of swagger of the ConfigMap of kubernetes
+func (a *App) pruneConfigMap(fileContent []byte) []byte {
+	if !a.isFeatureExcluded(EnumsFeatures.Swagger) {
+		return fileContent
+	}
+	lines := []string{}
+	for _, line := range strings.Split(string(fileContent), "\n") {
+		if !strings.HasPrefix(line, "  SWAGGER_HOST:") {
+			lines = append(lines, line)
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/features"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/enums/repository"
+	"github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"strings"
+	"testing"
+)
+
+func newAppWithout(db repository.Repository, without ...features.Feature) *App {
+	app := NewApp().SetWithout(without).(*App)
+	app.db = db
+	return app
+}
+
+func pruneTemplateFile(t *testing.T, app *App, templateFolderName string, file files.Files) string {
+	pruned, err := app.pruneFeatures(string(file), readTemplateFile(t, templateFolderName, file))
+	assert.NoError(t, err)
+	return string(pruned)
+}
+
+func TestApp_filterFilesByFeatures(t *testing.T) {
+	t.Run("Should keep all files when features are not excluded", func(t *testing.T) {
+		list := GetFilesByRepository(repository.Gorm, commands.App)
+		assert.Equal(t, list, newAppWithout(repository.Gorm).filterFilesByFeatures(list))
+	})
+	t.Run("Should remove docs and entities of swagger", func(t *testing.T) {
+		app := newAppWithout(repository.Gorm, features.Swagger)
+		filtered := app.filterFilesByFeatures(GetFilesByRepository(repository.Gorm, commands.App))
+		assert.NotContains(t, filtered, files.DocsSwaggerJSON)
+		assert.NotContains(t, filtered, files.InternalEntitiesHealthSwaggerEntities)
+		assert.NotContains(t, filtered, files.InternalEntitiesProductSwaggerEntities)
+		assert.Contains(t, filtered, files.InternalHandlersHealthHealth)
+	})
+	t.Run("Should remove tests and mock of adapter", func(t *testing.T) {
+		app := newAppWithout(repository.Gorm, features.Tests)
+		filtered := app.filterFilesByFeatures(GetFilesByRepository(repository.Gorm, commands.GraphQL))
+		assert.NotContains(t, filtered, files.InternalRoutesRoutesTest)
+		assert.NotContains(t, filtered, files.InternalGraphQLResolversResolverTest)
+		assert.NotContains(t, filtered, files.PkgRepositoryAdapterAdapterMock)
+		assert.Contains(t, filtered, files.PkgRepositoryAdapterAdapter)
+	})
+	t.Run("Should remove handler of health and docker-compose", func(t *testing.T) {
+		app := newAppWithout(repository.Gorm, features.Health, features.DockerCompose)
+		filtered := app.filterFilesByFeatures(GetFilesByRepository(repository.Gorm, commands.App))
+		assert.NotContains(t, filtered, files.InternalHandlersHealthHealth)
+		assert.NotContains(t, filtered, files.InternalEntitiesHealthSwaggerEntities)
+		assert.NotContains(t, filtered, files.DeploymentsDockerCompose)
+		assert.Contains(t, filtered, files.DeploymentsDockerfile)
+		assert.Contains(t, filtered, files.DeploymentsKubernetesDeployment)
+	})
+}
+
+func TestApp_filterFoldersByFeatures(t *testing.T) {
+	t.Run("Should remove folders of features excluded", func(t *testing.T) {
+		app := newAppWithout(repository.Gorm, features.Swagger, features.DockerCompose)
+		filtered := app.filterFoldersByFeatures(GetFoldersByRepository(repository.Gorm, commands.App))
+		assert.NotContains(t, filtered, folders.Docs)
+		assert.Contains(t, filtered, folders.Deployments)
+		assert.NotContains(t, filtered, folders.InternalEntitiesHealth)
+		assert.Contains(t, filtered, folders.InternalHandlersHealth)
+	})
+}
+
+func TestApp_pruneFeatures(t *testing.T) {
+	t.Run("Should keep content when features are not excluded", func(t *testing.T) {
+		content := readTemplateFile(t, "standart-gorm", files.InternalRoutesRoutes)
+		pruned, err := newAppWithout(repository.Gorm).pruneFeatures(string(files.InternalRoutesRoutes), content)
+		assert.NoError(t, err)
+		assert.Equal(t, content, pruned)
+	})
+	t.Run("Should remove setup, route, config and annotations of swagger", func(t *testing.T) {
+		app := newAppWithout(repository.Gorm, features.Swagger)
+		main := pruneTemplateFile(t, app, "standart-gorm", files.CmdMain)
+		assert.NotContains(t, main, "setupSwagger")
+		assert.NotContains(t, main, "/docs\"")
+		assert.NotContains(t, main, "// @title")
+		assert.Contains(t, main, `"fmt"`)
+		routes := pruneTemplateFile(t, app, "standart-gorm", files.InternalRoutesRoutes)
+		assert.NotContains(t, routes, "RouterSwagger")
+		assert.NotContains(t, routes, "http-swagger")
+		assert.NotContains(t, routes, `"fmt"`)
+		assert.Contains(t, routes, "r.RouterHealth(repository)")
+		configs := pruneTemplateFile(t, app, "standart-gorm", files.ConfigsConfigs)
+		assert.NotContains(t, configs, "SwaggerHost")
+		handler := pruneTemplateFile(t, app, "standart-gorm", files.InternalHandlersProductProduct)
+		assert.NotContains(t, handler, "import used in swagger")
+		assert.NotContains(t, handler, "// @Router")
+		test := pruneTemplateFile(t, app, "standart-gorm", files.InternalRoutesRoutesTest)
+		assert.NotContains(t, test, "Should return ok when call swagger")
+	})
+	t.Run("Should remove route of health of the router selected", func(t *testing.T) {
+		app := newAppWithout(repository.SQL, features.Health)
+		routes := pruneTemplateFile(t, app, "standart-sql", files.InternalRoutesRoutes)
+		assert.NotContains(t, routes, "RouterHealth")
+		assert.NotContains(t, routes, "HealthHandler")
+		assert.Contains(t, routes, "r.RouterProduct(repository)")
+	})
+	t.Run("Should remove middleware, config and header of cors", func(t *testing.T) {
+		app := newAppWithout(repository.Bolt, features.CORS)
+		routes := pruneTemplateFile(t, app, "standart-bolt", files.InternalRoutesRoutes)
+		assert.NotContains(t, routes, "EnableCORS")
+		assert.Contains(t, routes, "r.EnableLogger()")
+		config := pruneTemplateFile(t, app, "standart-bolt", files.InternalRoutesConfig)
+		assert.NotContains(t, config, "cors")
+		assert.NotContains(t, config, `"net/http"`)
+		configTest := pruneTemplateFile(t, app, "standart-bolt", files.InternalRoutesConfigTest)
+		assert.NotContains(t, configTest, "TestConfig_Cors")
+		response := pruneTemplateFile(t, app, "standart-bolt", files.InternalUtilsHTTPResponse)
+		assert.NotContains(t, response, "Access-Control-Allow-Origin")
+		assert.Contains(t, response, `w.Header().Set("Content-Type", "application/json")`)
+		test := pruneTemplateFile(t, app, "standart-bolt", files.InternalRoutesRoutesTest)
+		assert.NotContains(t, test, "Should pass request by middleware of cors")
+	})
+	t.Run("Should change the probes of health of kubernetes to check the port", func(t *testing.T) {
+		deployment := pruneTemplateFile(t, newAppWithout(repository.Gorm, features.Health), "standart-gorm",
+			files.DeploymentsKubernetesDeployment)
+		assert.NotContains(t, deployment, "/api/v1/health")
+		assert.Equal(t, 2, strings.Count(deployment, "tcpSocket:\n              port: http"))
+		readme := pruneTemplateFile(t, newAppWithout(repository.Gorm, features.Health), "standart-gorm", files.Readme)
+		assert.Contains(t, readme, "probes in the port of the container")
+		deployment = pruneTemplateFile(t, newAppWithout(repository.Gorm, features.Swagger), "standart-gorm",
+			files.DeploymentsKubernetesDeployment)
+		assert.Equal(t, 2, strings.Count(deployment, "path: /api/v1/health"))
+	})
+	t.Run("Should remove environment of swagger of the config map of kubernetes", func(t *testing.T) {
+		configMap := pruneTemplateFile(t, newAppWithout(repository.Bolt, features.Swagger), "standart-bolt",
+			files.DeploymentsKubernetesConfigMap)
+		assert.NotContains(t, configMap, "SWAGGER_HOST")
+		assert.Contains(t, configMap, "  TIMEOUT: \"30\"\n")
+	})
+	t.Run("Should remove section and environment of swagger of readme", func(t *testing.T) {
+		app := newAppWithout(repository.Gorm, features.Swagger)
+		readme := pruneTemplateFile(t, app, "standart-gorm", files.Readme)
+		assert.NotContains(t, readme, "SWAGGER_HOST")
+		assert.NotContains(t, readme, "## Swagger")
+		assert.Contains(t, readme, "## Migrations")
+	})
+}
@@ -0,0 +1,303 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	"github.com/wilian746/go-generator/internal/enums/features"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/repository"
+	"github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"github.com/wilian746/go-generator/internal/enums/routers"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update rewrite the golden trees with the projects generated: go test ./internal/controllers/generate/app -update
+var update = flag.Bool("update", false, "update the golden trees of testdata/golden with the projects generated")
+
+const goldenModuleName = "example.com/golden"
+
+type goldenCase struct {
+	name     string
+	db       repository.Repository
+	command  commands.Command
+	router   routers.Router
+	dialects []dialects.Dialect
+	without  []features.Feature
+	resource string
+	// runTests run the tests of the project generated, the templates of grpc and graphql are built only with the files
+	// layered from the template of gorm, so their tests are not run by the go-generator
+	runTests bool
+}
+
+func getGoldenCases() []goldenCase {
+	return []goldenCase{
+		{name: "gorm", db: repository.Gorm, command: commands.App},
+		{name: "sql", db: repository.SQL, command: commands.App},
+		{name: "gorm2", db: repository.Gorm2, command: commands.App},
+		{name: "bolt", db: repository.Bolt, command: commands.App},
+		{name: "memory", db: repository.Memory, command: commands.App},
+		{name: "gorm-grpc", db: repository.Gorm, command: commands.GRPC, runTests: true},
+		{name: "gorm-graphql", db: repository.Gorm, command: commands.GraphQL, runTests: true},
+		{name: "sql-gin", db: repository.SQL, command: commands.App, router: routers.Gin},
+		{name: "memory-echo", db: repository.Memory, command: commands.App, router: routers.Echo},
+		{name: "bolt-stdlib", db: repository.Bolt, command: commands.App, router: routers.Stdlib},
+		{name: "gorm2-postgres-sqlite3-without-swagger-cors-shipment-item", db: repository.Gorm2, command: commands.App,
+			dialects: []dialects.Dialect{dialects.Postgres, dialects.SQLite3},
+			without:  []features.Feature{features.Swagger, features.CORS}, resource: "shipment_item"},
+		{name: "gorm2-mysql-without-tests", db: repository.Gorm2, command: commands.App,
+			dialects: []dialects.Dialect{dialects.MySQL}, without: []features.Feature{features.Tests}},
+		{name: "sql-gin-mysql-without-tests-health-docker-compose-invoice", db: repository.SQL, command: commands.App,
+			router: routers.Gin, dialects: []dialects.Dialect{dialects.MySQL},
+			without: []features.Feature{features.Tests, features.Health, features.DockerCompose}, resource: "invoice"},
+	}
+}
+
+func (g goldenCase) newApp() Interface {
+	app := NewApp().SetCommand(g.command).SetDialects(g.dialects).SetWithout(g.without).SetResource(g.resource)
+	if g.router != "" {
+		app.SetRouter(g.router)
+	}
+	return app.SetTemplatesPath(filepath.Join("..", "..", "..", ".."))
+}
+
+func TestApp_CreateFoldersAndFilesGolden(t *testing.T) {
+	for _, golden := range getGoldenCases() {
+		golden := golden
+		t.Run("Should generate the project of "+golden.name+" equal to the golden tree", func(t *testing.T) {
+			t.Parallel()
+			destiny, err := ioutil.TempDir("", "go-generator-golden")
+			assert.NoError(t, err)
+			defer os.RemoveAll(destiny)
+			assert.NoError(t, golden.newApp().CreateFoldersAndFiles(destiny, goldenModuleName, golden.db))
+			generated := readGoldenTree(t, destiny)
+			goldenPath := filepath.Join("testdata", "golden", golden.name)
+			if *update {
+				writeGoldenTree(t, goldenPath, generated)
+			}
+			assertGoldenTree(t, readGoldenTree(t, goldenPath), generated)
+			assertGoldenProjectName(t, GetTemplateFolderName(golden.db, golden.command), generated)
+			assertGoldenProductionDatabase(t, generated)
+			assertGoldenDatabaseOfDeployments(t, golden, generated)
+			assertGoldenReplicas(t, golden, generated)
+			if !testing.Short() {
+				assertGoldenTypeCheck(t, destiny)
+			}
+			if golden.runTests && !testing.Short() {
+				assertGoldenTests(t, destiny)
+			}
+		})
+	}
+}
+
+// readGoldenTree return the content of the files generated by path, without the files copied from the root of the
+// go-generator that are not templates
+func readGoldenTree(t *testing.T, root string) map[string]string {
+	ignored := map[string]bool{}
+	for _, file := range files.ValuesNoGO() {
+		ignored[string(file)] = true
+	}
+	tree := map[string]string{}
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil || ignored[filepath.ToSlash(relative)] {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		tree[filepath.ToSlash(relative)] = string(content)
+		return err
+	})
+	assert.NoError(t, err)
+	return tree
+}
+
+func writeGoldenTree(t *testing.T, root string, tree map[string]string) {
+	assert.NoError(t, os.RemoveAll(root))
+	for path, content := range tree {
+		absPath := filepath.Join(root, filepath.FromSlash(path))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(absPath), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(absPath, []byte(content), 0600))
+	}
+}
+
+func assertGoldenTree(t *testing.T, expected, generated map[string]string) {
+	if len(expected) == 0 {
+		t.Fatal("golden tree not found, run go test with -update to create it")
+	}
+	for path, content := range expected {
+		if _, ok := generated[path]; !ok {
+			t.Errorf("file %s of golden tree was not generated", path)
+			continue
+		}
+		assert.Equal(t, content, generated[path], "file %s is different of golden tree, run go test with -update "+
+			"if the change is expected", path)
+	}
+	for path := range generated {
+		if _, ok := expected[path]; !ok {
+			t.Errorf("file %s generated is not in golden tree", path)
+		}
+	}
+}
+
+// assertGoldenProjectName check that the manifests of the kubernetes and the readme use the name of the project of the
+// module, and not the name of the template
+func assertGoldenProjectName(t *testing.T, templateName string, generated map[string]string) {
+	projectName := getProjectName(goldenModuleName)
+	for path, content := range generated {
+		if path == string(files.Readme) || strings.HasPrefix(path, "deployments/kubernetes/") {
+			assert.NotContains(t, content, templateName, "file %s has the name of the template", path)
+		}
+	}
+	assert.Contains(t, generated[string(files.DeploymentsKubernetesDeployment)], "image: "+projectName+"\n")
+	assert.Contains(t, generated[string(files.Readme)], "-t "+projectName+" .")
+}
+
+// assertGoldenProductionDatabase check that the overlay of production replace the database of the base with the
+// placeholders, when the project has the secret of the database
+func assertGoldenProductionDatabase(t *testing.T, generated map[string]string) {
+	if _, ok := generated[string(files.DeploymentsKubernetesSecret)]; !ok {
+		return
+	}
+	production := generated[string(files.DeploymentsKubernetesProduction)]
+	assert.Contains(t, production, "value: REPLACE_WITH_THE_DIALECT_OF_THE_DATABASE")
+	assert.Contains(t, production, "value: REPLACE_WITH_THE_URI_OF_THE_DATABASE")
+}
+
+// assertGoldenDatabaseOfDeployments check that the deployments use the first dialect selected and build with cgo only
+// when the sqlite3 is kept
+func assertGoldenDatabaseOfDeployments(t *testing.T, golden goldenCase, generated map[string]string) {
+	if !IsDialectsSupported(golden.db) {
+		return
+	}
+	first, selected := dialects.SQLite3, []dialects.Dialect{dialects.SQLite3}
+	if len(golden.dialects) > 0 {
+		first = golden.dialects[0]
+		selected, _ = AddDialectOfTests(golden.dialects, golden.without)
+	}
+	assert.Contains(t, generated[string(files.DeploymentsKubernetesConfigMap)], "DATABASE_DIALECT: "+first.String()+"\n")
+	cgo := "CGO_ENABLED=0"
+	for _, dialect := range selected {
+		if dialect == dialects.SQLite3 {
+			cgo = "CGO_ENABLED=1"
+		}
+	}
+	assert.Contains(t, generated[string(files.DeploymentsDockerfile)], cgo)
+}
+
+// assertGoldenReplicas check that the HPA is generated only when the data is not stored in the pod, like the memory,
+// the bolt and the sqlite in memory of the first dialect
+func assertGoldenReplicas(t *testing.T, golden goldenCase, generated map[string]string) {
+	_, hasHPA := generated[string(files.DeploymentsKubernetesHPA)]
+	if IsDialectsSupported(golden.db) && len(golden.dialects) > 0 && golden.dialects[0] != dialects.SQLite3 {
+		assert.True(t, hasHPA)
+		return
+	}
+	assert.False(t, hasHPA)
+	for path, content := range generated {
+		if strings.HasPrefix(path, "deployments/kubernetes/") {
+			assert.NotContains(t, content, "hpa.yaml", "file %s has the HPA", path)
+			assert.NotContains(t, content, "HorizontalPodAutoscaler", "file %s has the HPA", path)
+		}
+	}
+	assert.Contains(t, generated[string(files.DeploymentsKubernetesDeployment)], "\n  replicas: 1\n")
+}
+
+type goldenPackage struct {
+	ImportPath   string
+	Dir          string
+	Export       string
+	ForTest      string
+	GoFiles      []string
+	TestGoFiles  []string
+	XTestGoFiles []string
+	Module       *struct{ Path string }
+}
+
+// isGenerated return false to the dependencies, to the packages compiled with the tests and to the main of the tests
+func (p *goldenPackage) isGenerated() bool {
+	return p.ForTest == "" && p.Module != nil && p.Module.Path == goldenModuleName && !strings.HasSuffix(p.ImportPath, ".test")
+}
+
+func assertGoldenTests(t *testing.T, destiny string) {
+	cmd := exec.Command("go", "test", "-mod=mod", "./...")
+	cmd.Dir = destiny
+	output, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(output))
+}
+
+// assertGoldenTypeCheck type check the packages and the tests of the project generated with go/types, the packages
+// imported are loaded from the export data compiled by go list
+func assertGoldenTypeCheck(t *testing.T, destiny string) {
+	packages := listGoldenPackages(t, destiny)
+	exports := map[string]string{}
+	for _, pkg := range packages {
+		if pkg.ForTest == "" && pkg.Export != "" {
+			exports[pkg.ImportPath] = pkg.Export
+		}
+	}
+	for _, pkg := range packages {
+		if !pkg.isGenerated() {
+			continue
+		}
+		typeCheckGoldenPackage(t, exports, pkg.ImportPath, pkg.Dir, append(pkg.GoFiles, pkg.TestGoFiles...))
+		typeCheckGoldenPackage(t, exports, pkg.ImportPath+"_test", pkg.Dir, pkg.XTestGoFiles)
+	}
+}
+
+func listGoldenPackages(t *testing.T, destiny string) (packages []goldenPackage) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", "list", "-mod=mod", "-e", "-export", "-deps", "-test", "-json", "./...")
+	cmd.Dir = destiny
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if !assert.NoError(t, err, stderr.String()) {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(output))
+	for decoder.More() {
+		pkg := goldenPackage{}
+		assert.NoError(t, decoder.Decode(&pkg))
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+func typeCheckGoldenPackage(t *testing.T, exports map[string]string, importPath, dir string, fileNames []string) {
+	if len(fileNames) == 0 {
+		return
+	}
+	fset := token.NewFileSet()
+	parsed := []*ast.File{}
+	for _, fileName := range fileNames {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, fileName), nil, 0)
+		if !assert.NoError(t, err) {
+			return
+		}
+		parsed = append(parsed, file)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		if exports[path] == "" {
+			return nil, fmt.Errorf("export data of %s not found", path)
+		}
+		return os.Open(exports[path])
+	})}
+	_, err := config.Check(importPath, fset, parsed, nil)
+	assert.NoError(t, err, "package %s generated is not valid", strings.TrimPrefix(importPath, goldenModuleName))
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"github.com/wilian746/go-generator/internal/enums/dialects"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"strings"
+)
+
+const (
+	resourceHPA       = "  - hpa.yaml"
+	replicasOfPod     = "spec:\n  replicas: 1\n"
+	replicasOfPodKept = "spec:\n" +
+		"  # The data is stored in the pod and is not shared by the replicas, so keep only one replica\n" +
+		"  replicas: 1\n"
+
+	readmeHPA             = ", Secret and HPA with the environments"
+	readmeWithoutHPA      = " and Secret with the environments"
+	readmeOverlaysHPA     = "change the namespace, the tag of the image and the replicas of HPA"
+	readmeOverlaysWithout = "change the namespace and the tag of the image. The Deployment keep only one replica and " +
+		"there is not HPA, because the sqlite in memory is not shared by the replicas"
+)
+
+// hasStorageInPod return true when the first dialect is the sqlite in memory, so the replicas would not share the data
+// and the HPA is not generated, like in the templates of memory and bolt that have not the HPA
+func (a *App) hasStorageInPod() bool {
+	return IsDialectsSupported(a.db) && a.getFirstDialect() == dialects.SQLite3
+}
+
+func (a *App) isPathOfReplicasNotShared(path string) bool {
+	return path == string(files.DeploymentsKubernetesHPA) && a.hasStorageInPod()
+}
+
+func (a *App) filterFilesByReplicas(list []files.Files) (filtered []files.Files) {
+	for _, file := range list {
+		if !a.isPathOfReplicasNotShared(string(file)) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
+// pruneReplicas remove the HPA of the base and the patches of the HPA of the overlays, and keep only one replica in
+// the deployment, when the data is stored in the pod
+func (a *App) pruneReplicas(dir string, fileContent []byte) []byte {
+	if !a.hasStorageInPod() {
+		return fileContent
+	}
+	switch dir {
+	case string(files.DeploymentsKubernetesKustomization):
+		return []byte(strings.ReplaceAll(string(fileContent), "\n"+resourceHPA, ""))
+	case string(files.DeploymentsKubernetesDeployment):
+		return []byte(strings.Replace(string(fileContent), replicasOfPod, replicasOfPodKept, 1))
+	case string(files.DeploymentsKubernetesDevelopment), string(files.DeploymentsKubernetesProduction):
+		return prunePatchesOfHPA(fileContent)
+	case string(files.Readme):
+		return []byte(strings.NewReplacer(readmeHPA, readmeWithoutHPA, readmeOverlaysHPA, readmeOverlaysWithout).
+			Replace(string(fileContent)))
+	default:
+		return fileContent
+	}
+}
+
+// prunePatchesOfHPA remove the patches with the target of kind HorizontalPodAutoscaler, each patch is the block
+// indented in patches, and the patches when all of them are removed
+func prunePatchesOfHPA(fileContent []byte) []byte {
+	lines := strings.Split(string(fileContent), "\n")
+	pruned := []string{}
+	for index := 0; index < len(lines); index++ {
+		if lines[index] == "  - target:" && index+1 < len(lines) &&
+			strings.TrimSpace(lines[index+1]) == "kind: HorizontalPodAutoscaler" {
+			index = getEndOfBlock(lines, index)
+			continue
+		}
+		pruned = append(pruned, lines[index])
+	}
+	for index, line := range pruned {
+		if line == "patches:" && (index+1 == len(pruned) || getIndentation(pruned[index+1]) == 0) {
+			return []byte(strings.Join(append(pruned[:index:index], pruned[index+1:]...), "\n"))
+		}
+	}
+	return []byte(strings.Join(pruned, "\n"))
+}
@@ -0,0 +1,203 @@
+package app
+
+import (
+	"fmt"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	EnumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"github.com/wilian746/go-generator/internal/utils/inflection"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ResourceTemplateName is the resource generated by the templates, renamed to the resource selected
+const ResourceTemplateName = "product"
+
+type resourceMode int
+
+const (
+	resourceModePath resourceMode = iota
+	resourceModeIdent
+	resourceModeCode
+	resourceModeText
+)
+
+var (
+	resourceRegex      = regexp.MustCompile(`[Pp]roducts?`)
+	resourceValidRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_ -]*$`)
+	resourceParamRegex = regexp.MustCompile(`(@Param |"name": "|name: )$`)
+)
+
+// resourcesReserved are the names of the packages and variables of the templates that conflict with the resource
+var resourcesReserved = map[string]bool{
+	"adapter": true, "base": true, "config": true, "controller": true, "database": true, "doc": true,
+	"entity": true, "environment": true, "handler": true, "health": true, "http": true, "logger": true,
+	"main": true, "migration": true, "mock": true, "param": true, "query": true, "repository": true,
+	"response": true, "route": true, "router": true, "rule": true, "test": true,
+}
+
+// IsResourceSupported return false to the generate types with code generated from the resource, like the proto of
+// grpc and the schema of graphql
+func IsResourceSupported(command EnumsCommands.Command) bool {
+	return command == EnumsCommands.App
+}
+
+func IsResourceValid(resource string) bool {
+	name := inflection.NewName(resource)
+	if !resourceValidRegex.MatchString(resource) || name.IsEmpty() {
+		return false
+	}
+	for _, value := range []string{name.Package(), name.Camel(), name.Plural().Package(), name.Plural().Camel()} {
+		if resourcesReserved[value] || token.IsKeyword(value) || types.Universe.Lookup(value) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// SetResource rename the resource product of the templates to the resource, empty keep product
+func (a *App) SetResource(resource string) Interface {
+	a.resource = inflection.NewName(resource)
+	return a
+}
+
+func (a *App) isResourceRenamed() bool {
+	return !a.resource.IsEmpty() && a.resource.Snake() != ResourceTemplateName
+}
+
+func (a *App) renameResourcePath(path string) string {
+	if !a.isResourceRenamed() {
+		return path
+	}
+	return a.replaceResource(path, resourceModePath)
+}
+
+// renameResource rename the product in the identifiers, packages, routes, tables and texts of the file with the form
+// of the resource used in each place
+func (a *App) renameResource(dir string, fileContent []byte) ([]byte, error) {
+	if !a.isResourceRenamed() {
+		return fileContent, nil
+	}
+	switch filepath.Ext(dir) {
+	case ".go":
+		renamed, err := goast.Rename(fileContent, func(kind goast.Kind, value string) string {
+			return a.renameResourceOfGo(dir, kind, value)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", dir, err)
+		}
+		return renamed, nil
+	case ".sql":
+		return []byte(a.replaceResource(string(fileContent), resourceModeCode)), nil
+	default:
+		return []byte(a.replaceResource(string(fileContent), resourceModeText)), nil
+	}
+}
+
+func (a *App) renameResourceOfGo(dir string, kind goast.Kind, value string) string {
+	switch kind {
+	case goast.KindPackage:
+		if value == ResourceTemplateName {
+			return a.resource.Package()
+		}
+		return a.replaceResource(value, resourceModeIdent)
+	case goast.KindIdent:
+		return a.replaceResource(value, resourceModeIdent)
+	case goast.KindImport:
+		return a.replaceResource(value, resourceModePath)
+	case goast.KindString:
+		return a.replaceResource(value, getResourceModeOfString(dir, value))
+	default:
+		return a.replaceResource(value, resourceModeText)
+	}
+}
+
+// getResourceModeOfString return text to the titles of tests and to the docs of swagger, the other strings are code
+// like the names of tables
+func getResourceModeOfString(dir, value string) resourceMode {
+	unquoted, err := strconv.Unquote(value)
+	if isPathInFolder(dir, string(folders.Docs)) || (err == nil && strings.HasPrefix(unquoted, "Should ")) {
+		return resourceModeText
+	}
+	return resourceModeCode
+}
+
+func (a *App) replaceResource(text string, mode resourceMode) string {
+	replaced := ""
+	last := 0
+	for _, index := range resourceRegex.FindAllStringIndex(text, -1) {
+		if !isResourceWord(text, index[0], index[1]) {
+			continue
+		}
+		replaced += text[last:index[0]] + a.getResourceForm(text, index[0], index[1], mode)
+		last = index[1]
+	}
+	return replaced + text[last:]
+}
+
+// isResourceWord return false when the product found is part of other word, like byproduct or productive
+func isResourceWord(text string, start, end int) bool {
+	return !isLower(getChar(text, end)) && (text[start] == 'P' || !isLetter(getChar(text, start-1)))
+}
+
+func (a *App) getResourceForm(text string, start, end int, mode resourceMode) string {
+	name := a.resource
+	if text[end-1] == 's' {
+		name = name.Plural()
+	}
+	switch {
+	case text[start] == 'P':
+		return name.Pascal()
+	case mode == resourceModePath && getChar(text, start-1) == '_':
+		return name.Snake()
+	case mode == resourceModePath:
+		return name.Package()
+	case mode == resourceModeIdent:
+		return name.Camel()
+	default:
+		return getResourceFormOfText(name, text, start, end, mode)
+	}
+}
+
+// getResourceFormOfText return the form of the resource by the characters around it, like the kebab in the routes
+// and the package in the types of swagger
+func getResourceFormOfText(name inflection.Name, text string, start, end int, mode resourceMode) string {
+	previous, next := getChar(text, start-1), getChar(text, end)
+	switch {
+	case next == '.' && isUpper(getChar(text, end+1)):
+		return name.Package()
+	case previous == '/' || previous == '-' || next == '-':
+		return name.Kebab()
+	case previous == '_' || next == '_':
+		return name.Snake()
+	case isUpper(next) || resourceParamRegex.MatchString(text[:start]):
+		return name.Camel()
+	case mode == resourceModeCode:
+		return name.Snake()
+	default:
+		return name.Words()
+	}
+}
+
+func getChar(text string, index int) byte {
+	if index < 0 || index >= len(text) {
+		return 0
+	}
+	return text[index]
+}
+
+func isLower(char byte) bool {
+	return char >= 'a' && char <= 'z'
+}
+
+func isUpper(char byte) bool {
+	return char >= 'A' && char <= 'Z'
+}
+
+func isLetter(char byte) bool {
+	return isLower(char) || isUpper(char)
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"github.com/wilian746/go-generator/internal/enums/repository"
+	"github.com/wilian746/go-generator/internal/enums/repository/commands"
+	"testing"
+)
+
+func newAppWithResource(db repository.Repository, resource string) *App {
+	app := NewApp().SetResource(resource).(*App)
+	app.db = db
+	return app
+}
+
+func renameTemplateFile(t *testing.T, app *App, templateFolderName string, file files.Files) string {
+	renamed, err := app.renameResource(string(file), readTemplateFile(t, templateFolderName, file))
+	assert.NoError(t, err)
+	return string(renamed)
+}
+
+func TestIsResourceSupported(t *testing.T) {
+	t.Run("Should support resource only to app", func(t *testing.T) {
+		assert.True(t, IsResourceSupported(commands.App))
+		assert.False(t, IsResourceSupported(commands.GRPC))
+		assert.False(t, IsResourceSupported(commands.GraphQL))
+	})
+}
+
+func TestIsResourceValid(t *testing.T) {
+	t.Run("Should return true to names of resources", func(t *testing.T) {
+		for _, resource := range []string{"invoice", "shipment_item", "shipment-item", "ShipmentItem", "orders"} {
+			assert.True(t, IsResourceValid(resource), resource)
+		}
+	})
+	t.Run("Should return false to names invalid or used by the template", func(t *testing.T) {
+		for _, resource := range []string{"", "1invoice", "invoice!", "health", "handlers", "type", "string", "-"} {
+			assert.False(t, IsResourceValid(resource), resource)
+		}
+	})
+}
+
+func TestApp_renameResourcePath(t *testing.T) {
+	t.Run("Should keep path when resource is product", func(t *testing.T) {
+		app := newAppWithResource(repository.Gorm, "products")
+		assert.Equal(t, string(files.InternalHandlersProductProduct),
+			app.renameResourcePath(string(files.InternalHandlersProductProduct)))
+	})
+	t.Run("Should rename folders, files and migrations of product", func(t *testing.T) {
+		app := newAppWithResource(repository.Gorm, "shipment-item")
+		assert.Equal(t, "internal/handlers/shipmentitem/shipmentitem_test.go",
+			app.renameResourcePath(string(files.InternalHandlersProductProductTest)))
+		assert.Equal(t, "migrations/postgres/20200607175350_create_table_shipment_items.up.sql",
+			app.renameResourcePath(string(files.MigrationsPostgresCreateTableProductsUp)))
+		assert.Equal(t, string(files.InternalRoutesRoutes), app.renameResourcePath(string(files.InternalRoutesRoutes)))
+	})
+}
+
+func TestApp_renameResource(t *testing.T) {
+	t.Run("Should keep content when resource is not selected", func(t *testing.T) {
+		content := readTemplateFile(t, "standart-gorm", files.InternalRoutesRoutes)
+		renamed, err := NewApp().(*App).renameResource(string(files.InternalRoutesRoutes), content)
+		assert.NoError(t, err)
+		assert.Equal(t, content, renamed)
+	})
+	t.Run("Should rename imports, identifiers and routes", func(t *testing.T) {
+		app := newAppWithResource(repository.Gorm, "ShipmentItem")
+		routes := renameTemplateFile(t, app, "standart-gorm", files.InternalRoutesRoutes)
+		assert.Contains(t, routes, `ShipmentItemHandler "github.com/wilian746/go-generator/pkg/standart-gorm/internal/`+
+			`handlers/shipmentitem"`)
+		assert.Contains(t, routes, "func (r *Router) RouterShipmentItem(repository adapter.Interface) {")
+		assert.Contains(t, routes, `r.router.Route(BasePath+"/shipment-item", func(route chi.Router) {`)
+		assert.NotContains(t, routes, "roduct")
+	})
+	t.Run("Should rename package, variables and table", func(t *testing.T) {
+		app := newAppWithResource(repository.SQL, "shipment_item")
+		entity := renameTemplateFile(t, app, "standart-sql", files.InternalEntitiesProductProduct)
+		assert.Contains(t, entity, "package shipmentitem")
+		assert.Contains(t, entity, "type ShipmentItem struct {")
+		assert.Contains(t, entity, `return "shipment_items"`)
+		rules := renameTemplateFile(t, app, "standart-sql", files.InternalRulesProductProductTest)
+		assert.Contains(t, rules, "shipmentitem.ShipmentItem")
+		assert.NotContains(t, rules, "roduct")
+	})
+	t.Run("Should rename annotations and docs of swagger", func(t *testing.T) {
+		app := newAppWithResource(repository.Gorm, "shipment_item")
+		handler := renameTemplateFile(t, app, "standart-gorm", files.InternalHandlersProductProduct)
+		assert.Contains(t, handler, "// @Summary List all shipment items")
+		assert.Contains(t, handler, "// @ID get-all-shipment-items")
+		assert.Contains(t, handler, "// @Param shipmentItem body shipmentitem.RequestBodyToCreateOrUpdateShipmentItem")
+		assert.Contains(t, handler, "// @Router /shipment-item/{ID} [get]")
+		docs := renameTemplateFile(t, app, "standart-gorm", files.DocsSwaggerJSON)
+		assert.Contains(t, docs, `"$ref": "#/definitions/shipmentitem.ShipmentItem"`)
+		assert.Contains(t, docs, `"/shipment-item/{ID}": {`)
+		assert.NotContains(t, docs, "roduct")
+	})
+	t.Run("Should rename table of migrations", func(t *testing.T) {
+		app := newAppWithResource(repository.SQL, "invoice")
+		migration := renameTemplateFile(t, app, "standart-sql", files.MigrationsPostgresCreateTableProductsUp)
+		assert.Contains(t, migration, `CREATE TABLE IF NOT EXISTS "invoices"`)
+		assert.Contains(t, migration, `CREATE UNIQUE INDEX "UK_invoices_name" ON "invoices"("name");`)
+	})
+}
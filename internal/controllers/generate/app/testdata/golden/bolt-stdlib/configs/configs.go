@@ -0,0 +1,19 @@
+package config
+
+import "example.com/golden/internal/utils/environment"
+
+type Config struct {
+	Port        int
+	Timeout     int
+	DatabaseURI string
+	SwaggerHost string
+}
+
+func GetConfig() Config {
+	return Config{
+		Port:        environment.GetEnvAndParseToInt("PORT", 8080),
+		Timeout:     environment.GetEnvAndParseToInt("TIMEOUT", 30),
+		DatabaseURI: environment.GetEnvString("DATABASE_URI", "standartbolt.db"),
+		SwaggerHost: environment.GetEnvString("SWAGGER_HOST", "localhost:8080"),
+	}
+}
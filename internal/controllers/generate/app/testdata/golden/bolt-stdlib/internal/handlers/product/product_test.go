@@ -0,0 +1,286 @@
+package product
+
+import (
+	"bytes"
+	"example.com/golden/internal/rules/product"
+	"example.com/golden/internal/utils/params"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/bbolt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func getConnection(t *testing.T) *bbolt.DB {
+	dir, err := ioutil.TempDir("", "standartbolt")
+	assert.NoError(t, err)
+	connection := database.GetConnection(filepath.Join(dir, "test.db"))
+	t.Cleanup(func() {
+		_ = connection.Close()
+		_ = os.RemoveAll(dir)
+	})
+	return connection
+}
+
+func getClosedConnection(t *testing.T) *bbolt.DB {
+	connection := getConnection(t)
+	_ = connection.Close()
+	return connection
+}
+
+func TestNewHandler(t *testing.T) {
+	t.Run("Should return instance of handler", func(t *testing.T) {
+		conn := getConnection(t)
+		repository := adapter.NewAdapter(conn)
+		assert.NotEmpty(t, NewHandler(repository))
+	})
+}
+
+func TestHandler_Options(t *testing.T) {
+	t.Run("Should return no content when call options", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodOptions, "/product", nil)
+		w := httptest.NewRecorder()
+		h.Options(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestHandler_Get(t *testing.T) {
+	t.Run("Should return ok when call getAll", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/product", nil)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return internal_error with database closed when call getAll", func(t *testing.T) {
+		conn := getClosedConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/product", nil)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return bad request when call getOne", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/product/123", nil)
+		r = params.Set(r, "ID", "123")
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return notfound when call getOne", func(t *testing.T) {
+		conn := getConnection(t)
+		ID := uuid.New()
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		productMock.ID = ID
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/product/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return internal_error with database closed when call getOne", func(t *testing.T) {
+		conn := getClosedConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		ID := uuid.New().String()
+		r, _ := http.NewRequest(http.MethodGet, "/product/"+ID, nil)
+		r = params.Set(r, "ID", ID)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return OK when call getOne", func(t *testing.T) {
+		conn := getConnection(t)
+		ID := uuid.New()
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		productMock.ID = ID
+		databaseAdapter := adapter.NewAdapter(conn)
+		_ = databaseAdapter.Create(productMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodGet, "/product/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestHandler_Post(t *testing.T) {
+	t.Run("Should return OK when call post", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		r, _ := http.NewRequest(http.MethodPost, "/product/", bytes.NewReader(productMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return bad request when call post; name is empty", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		productMock.Name = ""
+		r, _ := http.NewRequest(http.MethodPost, "/product/", bytes.NewReader(productMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return internal_error when call post; database closed", func(t *testing.T) {
+		conn := getClosedConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		r, _ := http.NewRequest(http.MethodPost, "/product/", bytes.NewReader(productMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Run("Should return bad request when call delete empty uuid", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodDelete, "/product/", nil)
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return bad request when call delete wrong uuid", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodDelete, "/product/123", nil)
+		r = params.Set(r, "ID", "123")
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return not found when call delete", func(t *testing.T) {
+		conn := getConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		ID := uuid.New()
+		r, _ := http.NewRequest(http.MethodDelete, "/product/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return internal_error when call delete", func(t *testing.T) {
+		conn := getClosedConnection(t)
+		h := NewHandler(adapter.NewAdapter(conn))
+		ID := uuid.New()
+		r, _ := http.NewRequest(http.MethodDelete, "/product/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return NoContent when call delete", func(t *testing.T) {
+		conn := getConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		_ = databaseAdapter.Create(productMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodDelete, "/product/"+productMock.ID.String(), nil)
+		r = params.Set(r, "ID", productMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestHandler_Put(t *testing.T) {
+	t.Run("Should return OK when call put", func(t *testing.T) {
+		conn := getConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		_ = databaseAdapter.Create(productMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
+		r = params.Set(r, "ID", productMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+	t.Run("Should return bad request when call put; wrong uuid", func(t *testing.T) {
+		conn := getConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		_ = databaseAdapter.Create(productMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/product/"+"123", bytes.NewReader(productMock.Bytes()))
+		r = params.Set(r, "ID", "123")
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return bad request when call put; empty uuid", func(t *testing.T) {
+		conn := getConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		_ = databaseAdapter.Create(productMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/product/", bytes.NewReader(productMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return bad request when call put; name is empty", func(t *testing.T) {
+		conn := getConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		productMock.Name = ""
+		_ = databaseAdapter.Create(productMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
+		r = params.Set(r, "ID", productMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return not found when call put;", func(t *testing.T) {
+		conn := getConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
+		r = params.Set(r, "ID", productMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return internal_error when call put;", func(t *testing.T) {
+		conn := getClosedConnection(t)
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := product.NewRules()
+		productMock := rules.GetMock()
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
+		r = params.Set(r, "ID", productMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,155 @@
+package routes
+
+import (
+	ServerConfig "example.com/golden/configs"
+	HealthHandler "example.com/golden/internal/handlers/health"
+	ProductHandler "example.com/golden/internal/handlers/product"
+	"example.com/golden/internal/utils/params"
+	"example.com/golden/pkg/repository/adapter"
+	"fmt"
+	"github.com/go-chi/chi/middleware"
+	"github.com/swaggo/http-swagger"
+	"net/http"
+	"strings"
+)
+
+const BasePath = "/api/v1"
+
+type Router struct {
+	config      *Config
+	router      *http.ServeMux
+	middlewares []func(http.Handler) http.Handler
+}
+
+// methods route the request to the handler of the method, the methods not registered return method not allowed
+type methods map[string]http.HandlerFunc
+
+func (m methods) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, ok := m[r.Method]
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	handler(w, r)
+}
+
+func NewRouter() *Router {
+	return &Router{
+		config: NewConfig().SetTimeout(ServerConfig.GetConfig().Timeout),
+		router: http.NewServeMux(),
+	}
+}
+
+func (r *Router) SetRouters(repository adapter.Interface) http.Handler {
+	r.setConfigsRouters()
+
+	r.RouterSwagger()
+	r.RouterHealth(repository)
+	r.RouterProduct(repository)
+
+	return r.handler()
+}
+
+func (r *Router) setConfigsRouters() {
+	r.EnableCORS()
+	r.EnableLogger()
+	r.EnableTimeout()
+	r.EnableRecover()
+	r.EnableRequestID()
+	r.EnableRealIP()
+}
+
+func (r *Router) RouterSwagger() {
+	swaggerHost := fmt.Sprintf("http://localhost:%v/swagger/doc.json", ServerConfig.GetConfig().Port)
+	r.router.Handle("/swagger/", methods{
+		http.MethodGet: httpSwagger.Handler(
+			httpSwagger.URL(swaggerHost),
+		),
+	})
+}
+
+func (r *Router) RouterHealth(repository adapter.Interface) {
+	handler := HealthHandler.NewHandler(repository)
+
+	route := methods{
+		http.MethodPost:    handler.Post,
+		http.MethodGet:     handler.Get,
+		http.MethodPut:     handler.Put,
+		http.MethodDelete:  handler.Delete,
+		http.MethodOptions: handler.Options,
+	}
+	r.router.Handle(BasePath+"/health", route)
+	r.router.Handle(BasePath+"/health/", withParam(BasePath+"/health/", "", route, nil))
+}
+
+func (r *Router) RouterProduct(repository adapter.Interface) {
+	handler := ProductHandler.NewHandler(repository)
+
+	route := methods{
+		http.MethodPost:    handler.Post,
+		http.MethodGet:     handler.Get,
+		http.MethodOptions: handler.Options,
+	}
+	routeID := methods{
+		http.MethodGet:    handler.Get,
+		http.MethodPut:    handler.Put,
+		http.MethodDelete: handler.Delete,
+	}
+	r.router.Handle(BasePath+"/product", route)
+	r.router.Handle(BasePath+"/product/", withParam(BasePath+"/product/", "ID", route, routeID))
+}
+
+func (r *Router) EnableLogger() *Router {
+	r.middlewares = append(r.middlewares, middleware.Logger)
+	return r
+}
+
+func (r *Router) EnableTimeout() *Router {
+	r.middlewares = append(r.middlewares, middleware.Timeout(r.config.GetTimeout()))
+	return r
+}
+
+func (r *Router) EnableCORS() *Router {
+	r.middlewares = append(r.middlewares, r.config.Cors)
+	return r
+}
+
+func (r *Router) EnableRecover() *Router {
+	r.middlewares = append(r.middlewares, middleware.Recoverer)
+	return r
+}
+
+func (r *Router) EnableRequestID() *Router {
+	r.middlewares = append(r.middlewares, middleware.RequestID)
+	return r
+}
+
+func (r *Router) EnableRealIP() *Router {
+	r.middlewares = append(r.middlewares, middleware.RealIP)
+	return r
+}
+
+// handler wrap the mux with the middlewares in the order that was enabled, the first enabled is the first executed
+func (r *Router) handler() http.Handler {
+	var handler http.Handler = r.router
+	for index := len(r.middlewares) - 1; index >= 0; index-- {
+		handler = r.middlewares[index](handler)
+	}
+	return handler
+}
+
+// withParam serve the prefix without param by root and the next segment of the path as the param key by handler,
+// when handler is nil or the path has more segments return not found
+func withParam(prefix, key string, root, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		value := strings.TrimPrefix(r.URL.Path, prefix)
+		switch {
+		case value == "":
+			root.ServeHTTP(w, r)
+		case handler == nil || strings.Contains(value, "/"):
+			http.NotFound(w, r)
+		default:
+			handler.ServeHTTP(w, params.Set(r, key, value))
+		}
+	}
+}
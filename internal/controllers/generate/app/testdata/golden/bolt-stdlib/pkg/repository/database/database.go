@@ -0,0 +1,24 @@
+package database
+
+import (
+	"errors"
+	"go.etcd.io/bbolt"
+	"log"
+	"time"
+)
+
+var ErrPathInvalid = errors.New("path not valid")
+
+func GetConnection(path string) *bbolt.DB {
+	if path == "" {
+		log.Panic("Error", ErrPathInvalid)
+	}
+
+	// the file of bbolt is locked by one process, the timeout avoid wait forever when other process is using it
+	connection, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		log.Panic("Error on open connection", err)
+	}
+
+	return connection
+}
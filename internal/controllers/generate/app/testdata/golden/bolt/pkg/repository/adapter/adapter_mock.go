@@ -0,0 +1,57 @@
+package adapter
+
+import (
+	"example.com/golden/pkg/repository/entities"
+	"example.com/golden/pkg/repository/response"
+	"github.com/stretchr/testify/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetLogMode() bool {
+	args := m.MethodCalled("GetLogMode")
+	return args.Get(0).(bool)
+}
+func (m *Mock) SetLogMode(logMode bool) Interface {
+	args := m.MethodCalled("SetLogMode")
+	return args.Get(0).(*Mock)
+}
+func (m *Mock) Health() bool {
+	args := m.MethodCalled("Health")
+	return args.Get(0).(bool)
+}
+
+func (m *Mock) StartTransaction() Interface {
+	args := m.MethodCalled("StartTransaction")
+	return args.Get(0).(*Mock)
+}
+func (m *Mock) CommitTransaction() *response.Response {
+	args := m.MethodCalled("CommitTransaction")
+	return args.Get(0).(*response.Response)
+}
+func (m *Mock) RollbackTransaction() *response.Response {
+	args := m.MethodCalled("RollbackTransaction")
+	return args.Get(0).(*response.Response)
+}
+func (m *Mock) Find(tableName string, key []byte, entity interface{}) *response.Response {
+	args := m.MethodCalled("Find")
+	return args.Get(0).(*response.Response)
+}
+func (m *Mock) FindByPrefix(tableName string, prefix []byte, entities interface{}) *response.Response {
+	args := m.MethodCalled("FindByPrefix")
+	return args.Get(0).(*response.Response)
+}
+func (m *Mock) Create(entity entities.Interface) *response.Response {
+	args := m.MethodCalled("Create")
+	return args.Get(0).(*response.Response)
+}
+func (m *Mock) Update(key []byte, entity entities.Interface) *response.Response {
+	args := m.MethodCalled("Update")
+	return args.Get(0).(*response.Response)
+}
+func (m *Mock) Delete(key []byte, tableName string) *response.Response {
+	args := m.MethodCalled("Delete")
+	return args.Get(0).(*response.Response)
+}
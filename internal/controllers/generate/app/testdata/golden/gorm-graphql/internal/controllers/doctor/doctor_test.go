@@ -0,0 +1,227 @@
+package doctor
+
+import (
+	EntitiesDoctor "example.com/golden/internal/entities/doctor"
+	EntitiesDoctorPatient "example.com/golden/internal/entities/doctorpatient"
+	RulesDoctor "example.com/golden/internal/rules/doctor"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewController(t *testing.T) {
+	t.Run("Should create instance controller", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewController(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestController_Create(t *testing.T) {
+	t.Run("Should create doctor on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		id, err := controller.Create(doctorMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+	})
+	t.Run("Should not create doctor on database because not migrate table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		id, err := controller.Create(doctorMock)
+		assert.Error(t, err)
+		assert.Equal(t, id, uuid.Nil)
+	})
+}
+
+func TestController_ListAll(t *testing.T) {
+	t.Run("Should return empty list of doctor on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.Equal(t, list, []EntitiesDoctor.Doctor{})
+	})
+	t.Run("Should not return empty list of doctor on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		id, err := controller.Create(doctorMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.NotEqual(t, list, []EntitiesDoctor.Doctor{})
+	})
+	t.Run("Should return error on list of doctor on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListAll()
+		assert.Error(t, err)
+		assert.Equal(t, list, []EntitiesDoctor.Doctor{})
+	})
+}
+
+func TestController_ListOne(t *testing.T) {
+	t.Run("Should return error record not found doctor on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error doctor on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should not return empty doctor on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		id, err := controller.Create(doctorMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(doctorMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, doctorMock.ID)
+	})
+}
+
+func TestController_Update(t *testing.T) {
+	t.Run("Should return error record not found doctor on database when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Update(ID, doctorMock)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error doctor on database because not migrate when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		err := controller.Update(uuid.New(), doctorMock)
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should udpdate doctor without error and check if names is different", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		id, err := controller.Create(doctorMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(doctorMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, doctorMock.ID)
+		doctorMockUpdate := rules.GetMock()
+		doctorMockUpdate.ID = doctorMock.ID
+		err = controller.Update(doctorMockUpdate.ID, doctorMockUpdate)
+		assert.NoError(t, err)
+		foundedUpdated, err := controller.ListOne(doctorMockUpdate.ID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, foundedUpdated.Name, doctorMock.Name)
+	})
+}
+
+func TestController_Delete(t *testing.T) {
+	t.Run("Should return error record not found doctor on database when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		rules.Migrate(conn, &EntitiesDoctorPatient.DoctorPatient{})
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Remove(ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error doctor on database because not migrate when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		err := controller.Remove(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should delete doctor without error and check if doctor not exists", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		doctorMock := rules.GetMock()
+		rules.Migrate(conn, doctorMock)
+		rules.Migrate(conn, &EntitiesDoctorPatient.DoctorPatient{})
+		id, err := controller.Create(doctorMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(doctorMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, doctorMock.ID)
+		err = controller.Remove(founded.ID)
+		assert.NoError(t, err)
+		_, err = controller.ListOne(founded.ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+}
+
+func TestController_ListByIDs(t *testing.T) {
+	t.Run("Should return empty list without query when IDs is empty", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListByIDs(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []EntitiesDoctor.Doctor{}, list)
+	})
+	t.Run("Should return only doctors with IDs informed", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctor.NewRules()
+		first := rules.GetMock()
+		second := rules.GetMock()
+		rules.Migrate(conn, first)
+		_, err := controller.Create(first)
+		assert.NoError(t, err)
+		_, err = controller.Create(second)
+		assert.NoError(t, err)
+		list, err := controller.ListByIDs([]uuid.UUID{first.ID, uuid.New()})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, first.ID, list[0].ID)
+	})
+	t.Run("Should return error on list by IDs because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListByIDs([]uuid.UUID{uuid.New()})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,227 @@
+package patient
+
+import (
+	EntitiesDoctorPatient "example.com/golden/internal/entities/doctorpatient"
+	EntitiesPatient "example.com/golden/internal/entities/patient"
+	RulesPatient "example.com/golden/internal/rules/patient"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewController(t *testing.T) {
+	t.Run("Should create instance controller", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewController(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestController_Create(t *testing.T) {
+	t.Run("Should create patient on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		id, err := controller.Create(patientMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+	})
+	t.Run("Should not create patient on database because not migrate table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		id, err := controller.Create(patientMock)
+		assert.Error(t, err)
+		assert.Equal(t, id, uuid.Nil)
+	})
+}
+
+func TestController_ListAll(t *testing.T) {
+	t.Run("Should return empty list of patient on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.Equal(t, list, []EntitiesPatient.Patient{})
+	})
+	t.Run("Should not return empty list of patient on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		id, err := controller.Create(patientMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.NotEqual(t, list, []EntitiesPatient.Patient{})
+	})
+	t.Run("Should return error on list of patient on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListAll()
+		assert.Error(t, err)
+		assert.Equal(t, list, []EntitiesPatient.Patient{})
+	})
+}
+
+func TestController_ListOne(t *testing.T) {
+	t.Run("Should return error record not found patient on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error patient on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should not return empty patient on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		id, err := controller.Create(patientMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(patientMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, patientMock.ID)
+	})
+}
+
+func TestController_Update(t *testing.T) {
+	t.Run("Should return error record not found patient on database when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Update(ID, patientMock)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error patient on database because not migrate when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		err := controller.Update(uuid.New(), patientMock)
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should udpdate patient without error and check if names is different", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		id, err := controller.Create(patientMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(patientMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, patientMock.ID)
+		patientMockUpdate := rules.GetMock()
+		patientMockUpdate.ID = patientMock.ID
+		err = controller.Update(patientMockUpdate.ID, patientMockUpdate)
+		assert.NoError(t, err)
+		foundedUpdated, err := controller.ListOne(patientMockUpdate.ID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, foundedUpdated.Name, patientMock.Name)
+	})
+}
+
+func TestController_Delete(t *testing.T) {
+	t.Run("Should return error record not found patient on database when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		rules.Migrate(conn, &EntitiesDoctorPatient.DoctorPatient{})
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Remove(ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error patient on database because not migrate when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		err := controller.Remove(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should delete patient without error and check if patient not exists", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		patientMock := rules.GetMock()
+		rules.Migrate(conn, patientMock)
+		rules.Migrate(conn, &EntitiesDoctorPatient.DoctorPatient{})
+		id, err := controller.Create(patientMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(patientMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, patientMock.ID)
+		err = controller.Remove(founded.ID)
+		assert.NoError(t, err)
+		_, err = controller.ListOne(founded.ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+}
+
+func TestController_ListByIDs(t *testing.T) {
+	t.Run("Should return empty list without query when IDs is empty", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListByIDs(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []EntitiesPatient.Patient{}, list)
+	})
+	t.Run("Should return only patients with IDs informed", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesPatient.NewRules()
+		first := rules.GetMock()
+		second := rules.GetMock()
+		rules.Migrate(conn, first)
+		_, err := controller.Create(first)
+		assert.NoError(t, err)
+		_, err = controller.Create(second)
+		assert.NoError(t, err)
+		list, err := controller.ListByIDs([]uuid.UUID{first.ID, uuid.New()})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, first.ID, list[0].ID)
+	})
+	t.Run("Should return error on list by IDs because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListByIDs([]uuid.UUID{uuid.New()})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+package restaurant
+
+import (
+	"example.com/golden/internal/entities/order"
+	"example.com/golden/internal/entities/restaurant"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	ListOne(ID uuid.UUID) (entity restaurant.Restaurant, err error)
+	ListAll() (entities []restaurant.Restaurant, err error)
+	Create(entity *restaurant.Restaurant) (uuid.UUID, error)
+	Update(ID uuid.UUID, entity *restaurant.Restaurant) error
+	Remove(ID uuid.UUID) error
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) ListOne(id uuid.UUID) (entity restaurant.Restaurant, err error) {
+	query := c.repository.Connection(entity.TableName()).Where(map[string]interface{}{"id": id})
+	response := c.repository.Find(query, &entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return restaurant.Restaurant{}, err
+	}
+
+	return entity, nil
+}
+
+func (c *Controller) ListAll() (entities []restaurant.Restaurant, err error) {
+	entity := &restaurant.Restaurant{}
+	query := c.repository.Connection(entity.TableName())
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
+
+func (c *Controller) Create(entity *restaurant.Restaurant) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Update(id uuid.UUID, entity *restaurant.Restaurant) error {
+	entity.SetUpdatedAt()
+	_, err := c.ListOne(id)
+	if err != nil {
+		return err
+	}
+	response := c.repository.Update(map[string]interface{}{"id": id}, &entity, entity.TableName())
+	return response.Error()
+}
+
+func (c *Controller) Remove(id uuid.UUID) error {
+	var entity restaurant.Restaurant
+	var relation order.Order
+
+	// the orders are removed here because AutoMigrate does not create the foreign keys with cascade
+	if err := c.repository.Delete(map[string]interface{}{"restaurant_id": id}, relation.TableName()).Error(); err != nil {
+		return err
+	}
+	response := c.repository.Delete(map[string]interface{}{"id": id}, entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
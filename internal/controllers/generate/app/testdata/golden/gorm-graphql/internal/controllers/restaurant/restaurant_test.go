@@ -0,0 +1,218 @@
+package restaurant
+
+import (
+	EntitiesOrder "example.com/golden/internal/entities/order"
+	EntitiesRestaurant "example.com/golden/internal/entities/restaurant"
+	RulesOrder "example.com/golden/internal/rules/order"
+	RulesRestaurant "example.com/golden/internal/rules/restaurant"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewController(t *testing.T) {
+	t.Run("Should create instance controller", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewController(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestController_Create(t *testing.T) {
+	t.Run("Should create restaurant on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		id, err := controller.Create(restaurantMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+	})
+	t.Run("Should not create restaurant on database because not migrate table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		id, err := controller.Create(restaurantMock)
+		assert.Error(t, err)
+		assert.Equal(t, id, uuid.Nil)
+	})
+}
+
+func TestController_ListAll(t *testing.T) {
+	t.Run("Should return empty list of restaurant on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.Equal(t, list, []EntitiesRestaurant.Restaurant{})
+	})
+	t.Run("Should not return empty list of restaurant on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		id, err := controller.Create(restaurantMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.NotEqual(t, list, []EntitiesRestaurant.Restaurant{})
+	})
+	t.Run("Should return error on list of restaurant on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListAll()
+		assert.Error(t, err)
+		assert.Equal(t, list, []EntitiesRestaurant.Restaurant{})
+	})
+}
+
+func TestController_ListOne(t *testing.T) {
+	t.Run("Should return error record not found restaurant on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error restaurant on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should not return empty restaurant on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		id, err := controller.Create(restaurantMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(restaurantMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, restaurantMock.ID)
+	})
+}
+
+func TestController_Update(t *testing.T) {
+	t.Run("Should return error record not found restaurant on database when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Update(ID, restaurantMock)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error restaurant on database because not migrate when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		err := controller.Update(uuid.New(), restaurantMock)
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should udpdate restaurant without error and check if names is different", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		id, err := controller.Create(restaurantMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(restaurantMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, restaurantMock.ID)
+		restaurantMockUpdate := rules.GetMock()
+		restaurantMockUpdate.ID = restaurantMock.ID
+		err = controller.Update(restaurantMockUpdate.ID, restaurantMockUpdate)
+		assert.NoError(t, err)
+		foundedUpdated, err := controller.ListOne(restaurantMockUpdate.ID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, foundedUpdated.Name, restaurantMock.Name)
+	})
+}
+
+func TestController_Delete(t *testing.T) {
+	t.Run("Should return error record not found restaurant on database when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		rules.Migrate(conn, &EntitiesOrder.Order{})
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Remove(ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error restaurant on database because not migrate when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		err := controller.Remove(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should delete restaurant without error and check if restaurant not exists", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		rules.Migrate(conn, &EntitiesOrder.Order{})
+		id, err := controller.Create(restaurantMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(restaurantMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, restaurantMock.ID)
+		err = controller.Remove(founded.ID)
+		assert.NoError(t, err)
+		_, err = controller.ListOne(founded.ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+}
+
+func TestController_Remove_Orders(t *testing.T) {
+	t.Run("Should delete orders of restaurant when delete restaurant", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		repository := adapter.NewAdapter(conn)
+		controller := NewController(repository)
+		rules := RulesRestaurant.NewRules()
+		restaurantMock := rules.GetMock()
+		rules.Migrate(conn, restaurantMock)
+		orderMock := RulesOrder.NewRules().GetMock()
+		orderMock.RestaurantID = restaurantMock.ID
+		rules.Migrate(conn, orderMock)
+		_, err := controller.Create(restaurantMock)
+		assert.NoError(t, err)
+		assert.NoError(t, repository.Create(orderMock, orderMock.TableName()).Error())
+		assert.NoError(t, controller.Remove(restaurantMock.ID))
+		var orders []EntitiesOrder.Order
+		assert.NoError(t, repository.Find(repository.Connection(orderMock.TableName()), &orders,
+			orderMock.TableName()).Error())
+		assert.Empty(t, orders)
+	})
+}
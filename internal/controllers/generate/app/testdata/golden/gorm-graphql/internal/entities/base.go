@@ -0,0 +1,12 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Base struct {
+	ID        uuid.UUID `gorm:"primary_key" json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
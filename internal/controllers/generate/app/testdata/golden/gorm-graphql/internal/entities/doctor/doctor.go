@@ -0,0 +1,34 @@
+package doctor
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Doctor struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (d *Doctor) TableName() string {
+	return "doctors"
+}
+
+func (d *Doctor) Bytes() []byte {
+	bytes, _ := json.Marshal(d)
+	return bytes
+}
+
+func (d *Doctor) GenerateID() {
+	d.ID = uuid.New()
+}
+
+func (d *Doctor) SetCreatedAt() {
+	d.CreatedAt = time.Now()
+}
+
+func (d *Doctor) SetUpdatedAt() {
+	d.UpdatedAt = time.Now()
+}
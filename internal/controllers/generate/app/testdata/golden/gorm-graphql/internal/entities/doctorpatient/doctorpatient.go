@@ -0,0 +1,35 @@
+package doctorpatient
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type DoctorPatient struct {
+	entities.Base
+	DoctorID  uuid.UUID `json:"doctorId"`
+	PatientID uuid.UUID `json:"patientId"`
+}
+
+func (dp *DoctorPatient) TableName() string {
+	return "doctors_patients"
+}
+
+func (dp *DoctorPatient) Bytes() []byte {
+	bytes, _ := json.Marshal(dp)
+	return bytes
+}
+
+func (dp *DoctorPatient) GenerateID() {
+	dp.ID = uuid.New()
+}
+
+func (dp *DoctorPatient) SetCreatedAt() {
+	dp.CreatedAt = time.Now()
+}
+
+func (dp *DoctorPatient) SetUpdatedAt() {
+	dp.UpdatedAt = time.Now()
+}
@@ -0,0 +1,35 @@
+package order
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Order struct {
+	entities.Base
+	Description  string    `json:"description"`
+	RestaurantID uuid.UUID `json:"restaurantId"`
+}
+
+func (o *Order) TableName() string {
+	return "orders"
+}
+
+func (o *Order) Bytes() []byte {
+	bytes, _ := json.Marshal(o)
+	return bytes
+}
+
+func (o *Order) GenerateID() {
+	o.ID = uuid.New()
+}
+
+func (o *Order) SetCreatedAt() {
+	o.CreatedAt = time.Now()
+}
+
+func (o *Order) SetUpdatedAt() {
+	o.UpdatedAt = time.Now()
+}
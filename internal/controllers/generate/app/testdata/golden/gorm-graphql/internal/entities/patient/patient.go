@@ -0,0 +1,34 @@
+package patient
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Patient struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (p *Patient) TableName() string {
+	return "patients"
+}
+
+func (p *Patient) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *Patient) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Patient) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Patient) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
@@ -0,0 +1,34 @@
+package restaurant
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Restaurant struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (r *Restaurant) TableName() string {
+	return "restaurants"
+}
+
+func (r *Restaurant) Bytes() []byte {
+	bytes, _ := json.Marshal(r)
+	return bytes
+}
+
+func (r *Restaurant) GenerateID() {
+	r.ID = uuid.New()
+}
+
+func (r *Restaurant) SetCreatedAt() {
+	r.CreatedAt = time.Now()
+}
+
+func (r *Restaurant) SetUpdatedAt() {
+	r.UpdatedAt = time.Now()
+}
@@ -0,0 +1,53 @@
+package resolvers
+
+import (
+	"errors"
+	"example.com/golden/pkg/repository/adapter"
+)
+
+var ErrIDInvalid = errors.New("ID is not uuid valid")
+var ErrRelationalNotOK = errors.New("{ERROR_HEALTH} Relational database not alive")
+
+// The codes follow the same status returned by the handlers of REST
+const (
+	CodeBadRequest          = "BAD_REQUEST"
+	CodeNotFound            = "NOT_FOUND"
+	CodeInternalServerError = "INTERNAL_SERVER_ERROR"
+)
+
+// Error is returned in the field errors of the response with the code in extensions
+type Error struct {
+	err  error
+	code string
+}
+
+func NewBadRequest(err error) *Error {
+	return &Error{err: err, code: CodeBadRequest}
+}
+
+func NewNotFound(err error) *Error {
+	return &Error{err: err, code: CodeNotFound}
+}
+
+func NewInternalServerError(err error) *Error {
+	return &Error{err: err, code: CodeInternalServerError}
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Code() string {
+	return e.code
+}
+
+func (e *Error) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+func validateErrorController(err error) error {
+	if err.Error() == adapter.ErrRecordNotFound.Error() {
+		return NewNotFound(err)
+	}
+	return NewInternalServerError(err)
+}
@@ -0,0 +1,136 @@
+package resolvers
+
+import (
+	"github.com/google/uuid"
+	"sync"
+)
+
+// The loaders are shared by all resolvers of the same list, so the relations of the list are loaded
+// with one query the first time that any item asks for them, instead of one query by item (N+1)
+
+type ordersLoader struct {
+	once          sync.Once
+	root          *Resolver
+	restaurantIDs []uuid.UUID
+	orders        map[uuid.UUID][]*orderResolver
+	err           error
+}
+
+func newOrdersLoader(root *Resolver, restaurantIDs []uuid.UUID) *ordersLoader {
+	return &ordersLoader{root: root, restaurantIDs: restaurantIDs}
+}
+
+func (l *ordersLoader) Load(restaurantID uuid.UUID) ([]*orderResolver, error) {
+	l.once.Do(l.fetch)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return append([]*orderResolver{}, l.orders[restaurantID]...), nil
+}
+
+func (l *ordersLoader) fetch() {
+	entities, err := l.root.OrderController.ListByRestaurantIDs(l.restaurantIDs)
+	if err != nil {
+		l.err = validateErrorController(err)
+		return
+	}
+	l.orders = map[uuid.UUID][]*orderResolver{}
+	for index := range entities {
+		restaurantID := entities[index].RestaurantID
+		l.orders[restaurantID] = append(l.orders[restaurantID], &orderResolver{entity: entities[index]})
+	}
+}
+
+type patientsLoader struct {
+	once      sync.Once
+	root      *Resolver
+	doctorIDs []uuid.UUID
+	patients  map[uuid.UUID][]*patientResolver
+	err       error
+}
+
+func newPatientsLoader(root *Resolver, doctorIDs []uuid.UUID) *patientsLoader {
+	return &patientsLoader{root: root, doctorIDs: doctorIDs}
+}
+
+func (l *patientsLoader) Load(doctorID uuid.UUID) ([]*patientResolver, error) {
+	l.once.Do(l.fetch)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return append([]*patientResolver{}, l.patients[doctorID]...), nil
+}
+
+func (l *patientsLoader) fetch() {
+	relations, err := l.root.DoctorPatientController.ListByDoctorIDs(l.doctorIDs)
+	if err != nil {
+		l.err = validateErrorController(err)
+		return
+	}
+	patientIDs := []uuid.UUID{}
+	for _, relation := range relations {
+		patientIDs = append(patientIDs, relation.PatientID)
+	}
+	entities, err := l.root.PatientController.ListByIDs(patientIDs)
+	if err != nil {
+		l.err = validateErrorController(err)
+		return
+	}
+	resolvers := map[uuid.UUID]*patientResolver{}
+	for _, resolver := range newPatientResolvers(l.root, entities) {
+		resolvers[resolver.entity.ID] = resolver
+	}
+	l.patients = map[uuid.UUID][]*patientResolver{}
+	for _, relation := range relations {
+		if resolver, ok := resolvers[relation.PatientID]; ok {
+			l.patients[relation.DoctorID] = append(l.patients[relation.DoctorID], resolver)
+		}
+	}
+}
+
+type doctorsLoader struct {
+	once       sync.Once
+	root       *Resolver
+	patientIDs []uuid.UUID
+	doctors    map[uuid.UUID][]*doctorResolver
+	err        error
+}
+
+func newDoctorsLoader(root *Resolver, patientIDs []uuid.UUID) *doctorsLoader {
+	return &doctorsLoader{root: root, patientIDs: patientIDs}
+}
+
+func (l *doctorsLoader) Load(patientID uuid.UUID) ([]*doctorResolver, error) {
+	l.once.Do(l.fetch)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return append([]*doctorResolver{}, l.doctors[patientID]...), nil
+}
+
+func (l *doctorsLoader) fetch() {
+	relations, err := l.root.DoctorPatientController.ListByPatientIDs(l.patientIDs)
+	if err != nil {
+		l.err = validateErrorController(err)
+		return
+	}
+	doctorIDs := []uuid.UUID{}
+	for _, relation := range relations {
+		doctorIDs = append(doctorIDs, relation.DoctorID)
+	}
+	entities, err := l.root.DoctorController.ListByIDs(doctorIDs)
+	if err != nil {
+		l.err = validateErrorController(err)
+		return
+	}
+	resolvers := map[uuid.UUID]*doctorResolver{}
+	for _, resolver := range newDoctorResolvers(l.root, entities) {
+		resolvers[resolver.entity.ID] = resolver
+	}
+	l.doctors = map[uuid.UUID][]*doctorResolver{}
+	for _, relation := range relations {
+		if resolver, ok := resolvers[relation.DoctorID]; ok {
+			l.doctors[relation.PatientID] = append(l.doctors[relation.PatientID], resolver)
+		}
+	}
+}
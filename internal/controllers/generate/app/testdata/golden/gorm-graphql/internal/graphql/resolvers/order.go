@@ -0,0 +1,126 @@
+package resolvers
+
+import (
+	"example.com/golden/internal/entities/order"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type orderResolver struct {
+	entity order.Order
+}
+
+type orderInput struct {
+	Description  string
+	RestaurantID graphql.ID
+}
+
+func (o *orderResolver) ID() graphql.ID {
+	return graphql.ID(o.entity.ID.String())
+}
+
+func (o *orderResolver) Description() string {
+	return o.entity.Description
+}
+
+func (o *orderResolver) RestaurantID() graphql.ID {
+	return graphql.ID(o.entity.RestaurantID.String())
+}
+
+func (o *orderResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: o.entity.CreatedAt}
+}
+
+func (o *orderResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: o.entity.UpdatedAt}
+}
+
+func (r *Resolver) Order(args struct{ ID graphql.ID }) (*orderResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.OrderController.ListOne(ID)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &orderResolver{entity: entity}, nil
+}
+
+func (r *Resolver) Orders() ([]*orderResolver, error) {
+	entities, err := r.OrderController.ListAll()
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	resolvers := []*orderResolver{}
+	for index := range entities {
+		resolvers = append(resolvers, &orderResolver{entity: entities[index]})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateOrder(args struct{ Input orderInput }) (*orderResolver, error) {
+	entity, err := r.getOrderOfInput(args.Input)
+	if err != nil {
+		return nil, err
+	}
+	entity.GenerateID()
+	if err := r.validateOrder(entity); err != nil {
+		return nil, err
+	}
+	if _, err := r.OrderController.Create(entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &orderResolver{entity: *entity}, nil
+}
+
+func (r *Resolver) UpdateOrder(args struct {
+	ID    graphql.ID
+	Input orderInput
+}) (*orderResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.getOrderOfInput(args.Input)
+	if err != nil {
+		return nil, err
+	}
+	entity.ID = ID
+	if err := r.validateOrder(entity); err != nil {
+		return nil, err
+	}
+	if err := r.OrderController.Update(ID, entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Order(struct{ ID graphql.ID }{ID: args.ID})
+}
+
+func (r *Resolver) DeleteOrder(args struct{ ID graphql.ID }) (graphql.ID, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := r.OrderController.Remove(ID); err != nil {
+		return "", validateErrorController(err)
+	}
+	return args.ID, nil
+}
+
+func (r *Resolver) getOrderOfInput(input orderInput) (*order.Order, error) {
+	restaurantID, err := parseID(input.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	return &order.Order{Description: input.Description, RestaurantID: restaurantID}, nil
+}
+
+// validateOrder check the fields and if the restaurant exists, because the relation is not migrated with foreign key
+func (r *Resolver) validateOrder(entity *order.Order) error {
+	if err := r.OrderRules.Validate(entity); err != nil {
+		return NewBadRequest(err)
+	}
+	if _, err := r.RestaurantController.ListOne(entity.RestaurantID); err != nil {
+		return validateErrorController(err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package resolvers
+
+import (
+	"example.com/golden/internal/entities/patient"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type patientResolver struct {
+	entity  patient.Patient
+	doctors *doctorsLoader
+}
+
+type patientInput struct {
+	Name string
+}
+
+func (p *patientResolver) ID() graphql.ID {
+	return graphql.ID(p.entity.ID.String())
+}
+
+func (p *patientResolver) Name() string {
+	return p.entity.Name
+}
+
+func (p *patientResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: p.entity.CreatedAt}
+}
+
+func (p *patientResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: p.entity.UpdatedAt}
+}
+
+func (p *patientResolver) Doctors() ([]*doctorResolver, error) {
+	return p.doctors.Load(p.entity.ID)
+}
+
+func (r *Resolver) Patient(args struct{ ID graphql.ID }) (*patientResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.PatientController.ListOne(ID)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newPatientResolvers(r, []patient.Patient{entity})[0], nil
+}
+
+func (r *Resolver) Patients() ([]*patientResolver, error) {
+	entities, err := r.PatientController.ListAll()
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newPatientResolvers(r, entities), nil
+}
+
+func (r *Resolver) CreatePatient(args struct{ Input patientInput }) (*patientResolver, error) {
+	entity := &patient.Patient{Name: args.Input.Name}
+	entity.GenerateID()
+	if err := r.PatientRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if _, err := r.PatientController.Create(entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newPatientResolvers(r, []patient.Patient{*entity})[0], nil
+}
+
+func (r *Resolver) UpdatePatient(args struct {
+	ID    graphql.ID
+	Input patientInput
+}) (*patientResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity := &patient.Patient{Name: args.Input.Name}
+	entity.ID = ID
+	if err := r.PatientRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if err := r.PatientController.Update(ID, entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Patient(struct{ ID graphql.ID }{ID: args.ID})
+}
+
+func (r *Resolver) DeletePatient(args struct{ ID graphql.ID }) (graphql.ID, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := r.PatientController.Remove(ID); err != nil {
+		return "", validateErrorController(err)
+	}
+	return args.ID, nil
+}
+
+func newPatientResolvers(root *Resolver, entities []patient.Patient) []*patientResolver {
+	IDs := []uuid.UUID{}
+	for index := range entities {
+		IDs = append(IDs, entities[index].ID)
+	}
+	loader := newDoctorsLoader(root, IDs)
+	resolvers := []*patientResolver{}
+	for index := range entities {
+		resolvers = append(resolvers, &patientResolver{entity: entities[index], doctors: loader})
+	}
+	return resolvers
+}
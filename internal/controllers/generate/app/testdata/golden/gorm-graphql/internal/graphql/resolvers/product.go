@@ -0,0 +1,96 @@
+package resolvers
+
+import (
+	"example.com/golden/internal/entities/product"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type productResolver struct {
+	entity product.Product
+}
+
+type productInput struct {
+	Name string
+}
+
+func (p *productResolver) ID() graphql.ID {
+	return graphql.ID(p.entity.ID.String())
+}
+
+func (p *productResolver) Name() string {
+	return p.entity.Name
+}
+
+func (p *productResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: p.entity.CreatedAt}
+}
+
+func (p *productResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: p.entity.UpdatedAt}
+}
+
+func (r *Resolver) Product(args struct{ ID graphql.ID }) (*productResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.ProductController.ListOne(ID)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &productResolver{entity: entity}, nil
+}
+
+func (r *Resolver) Products() ([]*productResolver, error) {
+	entities, err := r.ProductController.ListAll()
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	resolvers := []*productResolver{}
+	for index := range entities {
+		resolvers = append(resolvers, &productResolver{entity: entities[index]})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateProduct(args struct{ Input productInput }) (*productResolver, error) {
+	entity := &product.Product{Name: args.Input.Name}
+	entity.GenerateID()
+	if err := r.ProductRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if _, err := r.ProductController.Create(entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &productResolver{entity: *entity}, nil
+}
+
+func (r *Resolver) UpdateProduct(args struct {
+	ID    graphql.ID
+	Input productInput
+}) (*productResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity := &product.Product{Name: args.Input.Name}
+	entity.ID = ID
+	if err := r.ProductRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if err := r.ProductController.Update(ID, entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Product(struct{ ID graphql.ID }{ID: args.ID})
+}
+
+func (r *Resolver) DeleteProduct(args struct{ ID graphql.ID }) (graphql.ID, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := r.ProductController.Remove(ID); err != nil {
+		return "", validateErrorController(err)
+	}
+	return args.ID, nil
+}
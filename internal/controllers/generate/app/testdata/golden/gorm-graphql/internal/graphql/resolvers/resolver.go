@@ -0,0 +1,73 @@
+package resolvers
+
+import (
+	ControllersDoctor "example.com/golden/internal/controllers/doctor"
+	ControllersDoctorPatient "example.com/golden/internal/controllers/doctorpatient"
+	ControllersOrder "example.com/golden/internal/controllers/order"
+	ControllersPatient "example.com/golden/internal/controllers/patient"
+	ControllersProduct "example.com/golden/internal/controllers/product"
+	ControllersRestaurant "example.com/golden/internal/controllers/restaurant"
+	RulesDoctor "example.com/golden/internal/rules/doctor"
+	RulesDoctorPatient "example.com/golden/internal/rules/doctorpatient"
+	RulesOrder "example.com/golden/internal/rules/order"
+	RulesPatient "example.com/golden/internal/rules/patient"
+	RulesProduct "example.com/golden/internal/rules/product"
+	RulesRestaurant "example.com/golden/internal/rules/restaurant"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Resolver is the root of the schema, the queries and mutations of each entity are in the file of the entity
+type Resolver struct {
+	Repository adapter.Interface
+
+	ProductController       ControllersProduct.Interface
+	RestaurantController    ControllersRestaurant.Interface
+	OrderController         ControllersOrder.Interface
+	DoctorController        ControllersDoctor.Interface
+	PatientController       ControllersPatient.Interface
+	DoctorPatientController ControllersDoctorPatient.Interface
+
+	ProductRules       *RulesProduct.Rules
+	RestaurantRules    *RulesRestaurant.Rules
+	OrderRules         *RulesOrder.Rules
+	DoctorRules        *RulesDoctor.Rules
+	PatientRules       *RulesPatient.Rules
+	DoctorPatientRules *RulesDoctorPatient.Rules
+}
+
+func NewResolver(repository adapter.Interface) *Resolver {
+	return &Resolver{
+		Repository: repository,
+
+		ProductController:       ControllersProduct.NewController(repository),
+		RestaurantController:    ControllersRestaurant.NewController(repository),
+		OrderController:         ControllersOrder.NewController(repository),
+		DoctorController:        ControllersDoctor.NewController(repository),
+		PatientController:       ControllersPatient.NewController(repository),
+		DoctorPatientController: ControllersDoctorPatient.NewController(repository),
+
+		ProductRules:       RulesProduct.NewRules(),
+		RestaurantRules:    RulesRestaurant.NewRules(),
+		OrderRules:         RulesOrder.NewRules(),
+		DoctorRules:        RulesDoctor.NewRules(),
+		PatientRules:       RulesPatient.NewRules(),
+		DoctorPatientRules: RulesDoctorPatient.NewRules(),
+	}
+}
+
+func (r *Resolver) Health() (bool, error) {
+	if !r.Repository.Health() {
+		return false, NewInternalServerError(ErrRelationalNotOK)
+	}
+	return true, nil
+}
+
+func parseID(ID graphql.ID) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(string(ID))
+	if err != nil || parsed == uuid.Nil {
+		return uuid.Nil, NewBadRequest(ErrIDInvalid)
+	}
+	return parsed, nil
+}
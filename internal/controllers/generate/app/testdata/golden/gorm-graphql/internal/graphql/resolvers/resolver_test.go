@@ -0,0 +1,227 @@
+package resolvers
+
+import (
+	"context"
+	"encoding/json"
+	ControllersDoctorPatient "example.com/golden/internal/controllers/doctorpatient"
+	ControllersOrder "example.com/golden/internal/controllers/order"
+	EntitiesDoctorPatient "example.com/golden/internal/entities/doctorpatient"
+	EntitiesOrder "example.com/golden/internal/entities/order"
+	"example.com/golden/internal/graphql/schema"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+)
+
+type orderControllerCounter struct {
+	ControllersOrder.Interface
+	calls int32
+}
+
+func (o *orderControllerCounter) ListByRestaurantIDs(IDs []uuid.UUID) ([]EntitiesOrder.Order, error) {
+	atomic.AddInt32(&o.calls, 1)
+	return o.Interface.ListByRestaurantIDs(IDs)
+}
+
+type doctorPatientControllerCounter struct {
+	ControllersDoctorPatient.Interface
+	calls int32
+}
+
+func (d *doctorPatientControllerCounter) ListByDoctorIDs(IDs []uuid.UUID) ([]EntitiesDoctorPatient.DoctorPatient, error) {
+	atomic.AddInt32(&d.calls, 1)
+	return d.Interface.ListByDoctorIDs(IDs)
+}
+
+func getMigratedResolver() *Resolver {
+	resolver := NewResolver(adapter.NewAdapter(database.GetConnection("sqlite3", ":memory:")))
+	connection := resolver.Repository.Connection("")
+	resolver.ProductRules.Migrate(connection, resolver.ProductRules.GetMock())
+	resolver.RestaurantRules.Migrate(connection, resolver.RestaurantRules.GetMock())
+	resolver.OrderRules.Migrate(connection, resolver.OrderRules.GetMock())
+	resolver.DoctorRules.Migrate(connection, resolver.DoctorRules.GetMock())
+	resolver.PatientRules.Migrate(connection, resolver.PatientRules.GetMock())
+	resolver.DoctorPatientRules.Migrate(connection, resolver.DoctorPatientRules.GetMock())
+	return resolver
+}
+
+func execute(t *testing.T, resolver *Resolver, query string) (map[string]interface{}, []*errors.QueryError) {
+	response := graphql.MustParseSchema(schema.String(), resolver).Exec(context.Background(), query, "", nil)
+	data := map[string]interface{}{}
+	if response.Data != nil {
+		assert.NoError(t, json.Unmarshal(response.Data, &data))
+	}
+	return data, response.Errors
+}
+
+func create(t *testing.T, resolver *Resolver, mutation string) string {
+	data, errs := execute(t, resolver, mutation)
+	assert.Empty(t, errs)
+	for _, value := range data {
+		return value.(map[string]interface{})["id"].(string)
+	}
+	return ""
+}
+
+func TestNewResolver(t *testing.T) {
+	t.Run("Should return instance of resolver valid to schema", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotPanics(t, func() {
+			graphql.MustParseSchema(schema.String(), NewResolver(adapter.NewAdapter(conn)))
+		})
+	})
+}
+
+func TestResolver_Health(t *testing.T) {
+	t.Run("Should return true when database is alive", func(t *testing.T) {
+		data, errs := execute(t, getMigratedResolver(), `{ health }`)
+		assert.Empty(t, errs)
+		assert.Equal(t, true, data["health"])
+	})
+	t.Run("Should return internal server error when database is not alive", func(t *testing.T) {
+		mock := &adapter.Mock{}
+		mock.On("Health").Return(false)
+		_, errs := execute(t, NewResolver(mock), `{ health }`)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeInternalServerError, errs[0].Extensions["code"])
+	})
+}
+
+func TestResolver_Product(t *testing.T) {
+	t.Run("Should create, list, update and delete product", func(t *testing.T) {
+		resolver := getMigratedResolver()
+		ID := create(t, resolver, `mutation { createProduct(input: {name: "product"}) { id } }`)
+
+		data, errs := execute(t, resolver, fmt.Sprintf(`{ product(id: "%s") { id name createdAt } }`, ID))
+		assert.Empty(t, errs)
+		assert.Equal(t, "product", data["product"].(map[string]interface{})["name"])
+
+		data, errs = execute(t, resolver,
+			fmt.Sprintf(`mutation { updateProduct(id: "%s", input: {name: "updated"}) { name } }`, ID))
+		assert.Empty(t, errs)
+		assert.Equal(t, "updated", data["updateProduct"].(map[string]interface{})["name"])
+
+		data, errs = execute(t, resolver, `{ products { id } }`)
+		assert.Empty(t, errs)
+		assert.Len(t, data["products"], 1)
+
+		data, errs = execute(t, resolver, fmt.Sprintf(`mutation { deleteProduct(id: "%s") }`, ID))
+		assert.Empty(t, errs)
+		assert.Equal(t, ID, data["deleteProduct"])
+
+		_, errs = execute(t, resolver, fmt.Sprintf(`{ product(id: "%s") { id } }`, ID))
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeNotFound, errs[0].Extensions["code"])
+	})
+	t.Run("Should return bad request when id is not uuid", func(t *testing.T) {
+		_, errs := execute(t, getMigratedResolver(), `{ product(id: "wrong") { id } }`)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeBadRequest, errs[0].Extensions["code"])
+	})
+	t.Run("Should return bad request when name is invalid", func(t *testing.T) {
+		_, errs := execute(t, getMigratedResolver(), `mutation { createProduct(input: {name: ""}) { id } }`)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeBadRequest, errs[0].Extensions["code"])
+	})
+	t.Run("Should return internal server error without migrate", func(t *testing.T) {
+		resolver := NewResolver(adapter.NewAdapter(database.GetConnection("sqlite3", ":memory:")))
+		_, errs := execute(t, resolver, `{ products { id } }`)
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeInternalServerError, errs[0].Extensions["code"])
+	})
+}
+
+func TestResolver_Restaurant_Orders(t *testing.T) {
+	t.Run("Should return orders of restaurants loading all orders with only one query", func(t *testing.T) {
+		resolver := getMigratedResolver()
+		counter := &orderControllerCounter{Interface: resolver.OrderController}
+		resolver.OrderController = counter
+		for index := 0; index < 3; index++ {
+			restaurantID := create(t, resolver,
+				fmt.Sprintf(`mutation { createRestaurant(input: {name: "restaurant %d"}) { id } }`, index))
+			create(t, resolver, fmt.Sprintf(
+				`mutation { createOrder(input: {description: "order", restaurantId: "%s"}) { id } }`, restaurantID))
+		}
+
+		data, errs := execute(t, resolver, `{ restaurants { name orders { description restaurantId } } }`)
+		assert.Empty(t, errs)
+		restaurants := data["restaurants"].([]interface{})
+		assert.Len(t, restaurants, 3)
+		for _, restaurant := range restaurants {
+			assert.Len(t, restaurant.(map[string]interface{})["orders"], 1)
+		}
+		assert.Equal(t, int32(1), counter.calls)
+	})
+	t.Run("Should return not found when create order of restaurant not exists", func(t *testing.T) {
+		_, errs := execute(t, getMigratedResolver(), fmt.Sprintf(
+			`mutation { createOrder(input: {description: "order", restaurantId: "%s"}) { id } }`, uuid.New()))
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeNotFound, errs[0].Extensions["code"])
+	})
+	t.Run("Should delete orders when delete restaurant", func(t *testing.T) {
+		resolver := getMigratedResolver()
+		restaurantID := create(t, resolver, `mutation { createRestaurant(input: {name: "restaurant"}) { id } }`)
+		create(t, resolver, fmt.Sprintf(
+			`mutation { createOrder(input: {description: "order", restaurantId: "%s"}) { id } }`, restaurantID))
+		_, errs := execute(t, resolver, fmt.Sprintf(`mutation { deleteRestaurant(id: "%s") }`, restaurantID))
+		assert.Empty(t, errs)
+		data, errs := execute(t, resolver, `{ orders { id } }`)
+		assert.Empty(t, errs)
+		assert.Empty(t, data["orders"])
+	})
+}
+
+func TestResolver_Doctor_Patients(t *testing.T) {
+	t.Run("Should return patients of doctors and doctors of patients loading each level once", func(t *testing.T) {
+		resolver := getMigratedResolver()
+		counter := &doctorPatientControllerCounter{Interface: resolver.DoctorPatientController}
+		resolver.DoctorPatientController = counter
+		patientID := create(t, resolver, `mutation { createPatient(input: {name: "patient"}) { id } }`)
+		for index := 0; index < 3; index++ {
+			doctorID := create(t, resolver,
+				fmt.Sprintf(`mutation { createDoctor(input: {name: "doctor %d"}) { id } }`, index))
+			_, errs := execute(t, resolver, fmt.Sprintf(
+				`mutation { addPatientToDoctor(doctorId: "%s", patientId: "%s") { id } }`, doctorID, patientID))
+			assert.Empty(t, errs)
+		}
+		counter.calls = 0
+
+		data, errs := execute(t, resolver, `{ doctors { name patients { name doctors { name } } } }`)
+		assert.Empty(t, errs)
+		doctors := data["doctors"].([]interface{})
+		assert.Len(t, doctors, 3)
+		for _, doctor := range doctors {
+			patients := doctor.(map[string]interface{})["patients"].([]interface{})
+			assert.Len(t, patients, 1)
+			assert.Len(t, patients[0].(map[string]interface{})["doctors"], 3)
+		}
+		assert.Equal(t, int32(1), counter.calls)
+	})
+	t.Run("Should remove patient from doctor", func(t *testing.T) {
+		resolver := getMigratedResolver()
+		patientID := create(t, resolver, `mutation { createPatient(input: {name: "patient"}) { id } }`)
+		doctorID := create(t, resolver, `mutation { createDoctor(input: {name: "doctor"}) { id } }`)
+		_, errs := execute(t, resolver, fmt.Sprintf(
+			`mutation { addPatientToDoctor(doctorId: "%s", patientId: "%s") { id } }`, doctorID, patientID))
+		assert.Empty(t, errs)
+		data, errs := execute(t, resolver, fmt.Sprintf(
+			`mutation { removePatientFromDoctor(doctorId: "%s", patientId: "%s") { patients { id } } }`,
+			doctorID, patientID))
+		assert.Empty(t, errs)
+		assert.Empty(t, data["removePatientFromDoctor"].(map[string]interface{})["patients"])
+	})
+	t.Run("Should return not found when add patient not exists to doctor", func(t *testing.T) {
+		resolver := getMigratedResolver()
+		doctorID := create(t, resolver, `mutation { createDoctor(input: {name: "doctor"}) { id } }`)
+		_, errs := execute(t, resolver, fmt.Sprintf(
+			`mutation { addPatientToDoctor(doctorId: "%s", patientId: "%s") { id } }`, doctorID, uuid.New()))
+		assert.Len(t, errs, 1)
+		assert.Equal(t, CodeNotFound, errs[0].Extensions["code"])
+	})
+}
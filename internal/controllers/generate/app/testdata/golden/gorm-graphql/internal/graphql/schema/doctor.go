@@ -0,0 +1,28 @@
+package schema
+
+const doctor = `
+type Doctor {
+	id: ID!
+	name: String!
+	createdAt: Time!
+	updatedAt: Time!
+	patients: [Patient!]!
+}
+
+input DoctorInput {
+	name: String!
+}
+
+extend type Query {
+	doctor(id: ID!): Doctor!
+	doctors: [Doctor!]!
+}
+
+extend type Mutation {
+	createDoctor(input: DoctorInput!): Doctor!
+	updateDoctor(id: ID!, input: DoctorInput!): Doctor!
+	deleteDoctor(id: ID!): ID!
+	addPatientToDoctor(doctorId: ID!, patientId: ID!): Doctor!
+	removePatientFromDoctor(doctorId: ID!, patientId: ID!): Doctor!
+}
+`
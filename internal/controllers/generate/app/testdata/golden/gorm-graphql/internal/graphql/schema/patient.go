@@ -0,0 +1,26 @@
+package schema
+
+const patient = `
+type Patient {
+	id: ID!
+	name: String!
+	createdAt: Time!
+	updatedAt: Time!
+	doctors: [Doctor!]!
+}
+
+input PatientInput {
+	name: String!
+}
+
+extend type Query {
+	patient(id: ID!): Patient!
+	patients: [Patient!]!
+}
+
+extend type Mutation {
+	createPatient(input: PatientInput!): Patient!
+	updatePatient(id: ID!, input: PatientInput!): Patient!
+	deletePatient(id: ID!): ID!
+}
+`
@@ -0,0 +1,23 @@
+package schema
+
+import "strings"
+
+const root = `
+schema {
+	query: Query
+	mutation: Mutation
+}
+
+scalar Time
+
+type Query {
+	health: Boolean!
+}
+
+type Mutation {}
+`
+
+// String returns the schema of all entities, each entity extends the Query and Mutation with your own fields
+func String() string {
+	return strings.Join([]string{root, product, restaurant, order, doctor, patient}, "\n")
+}
@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	t.Run("Should return schema valid with all entities", func(t *testing.T) {
+		_, err := graphql.ParseSchema(String(), nil)
+		assert.NoError(t, err)
+	})
+	t.Run("Should contains queries and mutations of all entities", func(t *testing.T) {
+		for _, field := range []string{"products", "restaurants", "orders", "doctors", "patients",
+			"createProduct", "updateRestaurant", "deleteOrder", "addPatientToDoctor", "createPatient"} {
+			assert.Contains(t, String(), field)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	RulesProduct "example.com/golden/internal/rules/product"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("Should return instance of handler", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewHandler(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestHandler_Get(t *testing.T) {
+	t.Run("Should return playground with endpoint of route", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/api/v1/graphql", nil)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "GraphQLPlayground.init")
+		assert.Contains(t, w.Body.String(), "endpoint: '/api/v1/graphql'")
+	})
+}
+
+func TestHandler_Post(t *testing.T) {
+	t.Run("Should return bad request when body is invalid", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodPost, "/graphql", bytes.NewReader([]byte("wrong")))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return data of query executed", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		rules := RulesProduct.NewRules()
+		rules.Migrate(conn, rules.GetMock())
+		h := NewHandler(adapter.NewAdapter(conn))
+		body, _ := json.Marshal(map[string]interface{}{
+			"query":     `mutation Create($name: String!) { createProduct(input: {name: $name}) { name } }`,
+			"variables": map[string]interface{}{"name": "product"},
+		})
+		r, _ := http.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"data": {"createProduct": {"name": "product"}}}`, w.Body.String())
+	})
+	t.Run("Should return errors of query invalid", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		body, _ := json.Marshal(map[string]interface{}{"query": `{ notExists }`})
+		r, _ := http.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"errors"`)
+	})
+}
+
+func TestHandler_Put(t *testing.T) {
+	t.Run("Should return method not allowed when call put", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodPut, "/graphql", nil)
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Run("Should return method not allowed when call delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodDelete, "/graphql", nil)
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestHandler_Options(t *testing.T) {
+	t.Run("Should return no content when call options", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodOptions, "/graphql", nil)
+		w := httptest.NewRecorder()
+		h.Options(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
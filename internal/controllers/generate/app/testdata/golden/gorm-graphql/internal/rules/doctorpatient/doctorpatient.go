@@ -0,0 +1,44 @@
+package doctorpatient
+
+import (
+	"example.com/golden/internal/entities"
+	"example.com/golden/internal/entities/doctorpatient"
+	RepositoryEntity "example.com/golden/pkg/repository/entities"
+	Validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"time"
+)
+
+type Rules struct{}
+
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+func (p *Rules) GetMock() *doctorpatient.DoctorPatient {
+	return &doctorpatient.DoctorPatient{
+		Base: entities.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		DoctorID:  uuid.New(),
+		PatientID: uuid.New(),
+	}
+}
+
+func (p *Rules) Migrate(connection *gorm.DB, model RepositoryEntity.Interface) *gorm.DB {
+	connection = connection.Table(model.TableName())
+	connection.AutoMigrate(model)
+	return connection
+}
+
+func (p *Rules) Validate(doctorPatientEntity *doctorpatient.DoctorPatient) error {
+	return Validation.ValidateStruct(doctorPatientEntity,
+		Validation.Field(&doctorPatientEntity.ID, Validation.Required, is.UUIDv4),
+		Validation.Field(&doctorPatientEntity.DoctorID, Validation.Required, is.UUIDv4),
+		Validation.Field(&doctorPatientEntity.PatientID, Validation.Required, is.UUIDv4),
+	)
+}
@@ -0,0 +1,41 @@
+package patient
+
+import (
+	"example.com/golden/internal/entities/patient"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewRules(t *testing.T) {
+	t.Run("Should return instance rules", func(t *testing.T) {
+		assert.IsType(t, NewRules(), &Rules{})
+	})
+}
+
+func TestRules_GetMock(t *testing.T) {
+	t.Run("should return mock correctly", func(t *testing.T) {
+		r := NewRules()
+		mock := r.GetMock()
+		assert.NotEqual(t, mock.ID, uuid.Nil)
+		assert.NoError(t, r.Validate(mock))
+	})
+}
+
+func TestRules_Migrate(t *testing.T) {
+	t.Run("should migrate new table correctly", func(t *testing.T) {
+		r := NewRules()
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NoError(t, conn.Error)
+		conn = r.Migrate(conn, r.GetMock())
+		assert.NoError(t, conn.Error)
+	})
+}
+
+func TestRules_Validate(t *testing.T) {
+	t.Run("Should return error if fields are empty", func(t *testing.T) {
+		r := NewRules()
+		assert.Error(t, r.Validate(&patient.Patient{}))
+	})
+}
@@ -0,0 +1,42 @@
+package restaurant
+
+import (
+	"example.com/golden/internal/entities"
+	"example.com/golden/internal/entities/restaurant"
+	RepositoryEntity "example.com/golden/pkg/repository/entities"
+	Validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"time"
+)
+
+type Rules struct{}
+
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+func (p *Rules) GetMock() *restaurant.Restaurant {
+	return &restaurant.Restaurant{
+		Base: entities.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		Name: uuid.New().String(),
+	}
+}
+
+func (p *Rules) Migrate(connection *gorm.DB, model RepositoryEntity.Interface) *gorm.DB {
+	connection = connection.Table(model.TableName())
+	connection.AutoMigrate(model)
+	return connection
+}
+
+func (p *Rules) Validate(restaurantEntity *restaurant.Restaurant) error {
+	return Validation.ValidateStruct(restaurantEntity,
+		Validation.Field(&restaurantEntity.ID, Validation.Required, is.UUIDv4),
+		Validation.Field(&restaurantEntity.Name, Validation.Required, Validation.Length(3, 50)),
+	)
+}
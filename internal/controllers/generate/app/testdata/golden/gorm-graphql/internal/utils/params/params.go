@@ -0,0 +1,18 @@
+package params
+
+import (
+	"context"
+	"net/http"
+)
+
+type contextKey string
+
+// Get return the value of the param of the path set by the router, so the handlers not depend of the router used
+func Get(r *http.Request, key string) string {
+	value, _ := r.Context().Value(contextKey(key)).(string)
+	return value
+}
+
+func Set(r *http.Request, key, value string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), contextKey(key), value))
+}
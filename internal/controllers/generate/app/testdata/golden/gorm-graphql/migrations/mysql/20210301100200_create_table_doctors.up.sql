@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS `doctors`
+(
+    `id` varchar(255) NOT NULL,
+    `name` varchar(50) NOT NULL,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    PRIMARY KEY (id)
+);
+
+COMMIT;
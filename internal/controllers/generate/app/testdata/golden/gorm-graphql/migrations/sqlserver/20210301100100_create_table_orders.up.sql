@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE [orders]
+(
+    [id] varchar(255) NOT NULL,
+    [description] varchar(255) NOT NULL,
+    [restaurant_id] varchar(255) NOT NULL,
+    [created_at] varchar(50) NOT NULL,
+    [updated_at] varchar(50) NOT NULL,
+    PRIMARY KEY ([id]),
+    FOREIGN KEY ([restaurant_id]) REFERENCES [restaurants]([id]) ON DELETE CASCADE
+);
+
+END;
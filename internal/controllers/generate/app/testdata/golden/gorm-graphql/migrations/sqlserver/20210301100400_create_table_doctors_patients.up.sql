@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE [doctors_patients]
+(
+    [id] varchar(255) NOT NULL,
+    [doctor_id] varchar(255) NOT NULL,
+    [patient_id] varchar(255) NOT NULL,
+    [created_at] varchar(50) NOT NULL,
+    [updated_at] varchar(50) NOT NULL,
+    PRIMARY KEY ([id]),
+    FOREIGN KEY ([doctor_id]) REFERENCES [doctors]([id]) ON DELETE CASCADE,
+    FOREIGN KEY ([patient_id]) REFERENCES [patients]([id]) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX [UK_doctors_patients_doctor_id_patient_id] ON [doctors_patients]([doctor_id], [patient_id]);
+
+END;
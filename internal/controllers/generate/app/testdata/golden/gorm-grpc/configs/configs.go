@@ -0,0 +1,23 @@
+package config
+
+import "example.com/golden/internal/utils/environment"
+
+type Config struct {
+	Port        int
+	GrpcPort    int
+	Timeout     int
+	Dialect     string
+	DatabaseURI string
+	SwaggerHost string
+}
+
+func GetConfig() Config {
+	return Config{
+		Port:        environment.GetEnvAndParseToInt("PORT", 8080),
+		GrpcPort:    environment.GetEnvAndParseToInt("GRPC_PORT", 9090),
+		Timeout:     environment.GetEnvAndParseToInt("TIMEOUT", 30),
+		Dialect:     environment.GetEnvString("DATABASE_DIALECT", "sqlite3"),
+		DatabaseURI: environment.GetEnvString("DATABASE_URI", ":memory:"),
+		SwaggerHost: environment.GetEnvString("SWAGGER_HOST", "localhost:8080"),
+	}
+}
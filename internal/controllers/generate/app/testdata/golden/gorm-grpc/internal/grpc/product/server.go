@@ -0,0 +1,119 @@
+package product
+
+import (
+	"context"
+	"errors"
+	ProtoProduct "example.com/golden/api/proto/product"
+	ControllersProduct "example.com/golden/internal/controllers/product"
+	EntitiesProduct "example.com/golden/internal/entities/product"
+	RulesProduct "example.com/golden/internal/rules/product"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrIDInvalid = errors.New("ID is not uuid valid")
+
+type Server struct {
+	ProtoProduct.UnimplementedProductServiceServer
+	Controller ControllersProduct.Interface
+	Rules      *RulesProduct.Rules
+}
+
+func NewServer(repository adapter.Interface) ProtoProduct.ProductServiceServer {
+	return &Server{
+		Controller: ControllersProduct.NewController(repository),
+		Rules:      RulesProduct.NewRules(),
+	}
+}
+
+func (s *Server) Get(_ context.Context, request *ProtoProduct.GetRequest) (*ProtoProduct.Product, error) {
+	ID, err := parseID(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	response, err := s.Controller.ListOne(ID)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return convertProductToProto(&response), nil
+}
+
+func (s *Server) List(_ context.Context, _ *ProtoProduct.ListRequest) (*ProtoProduct.ListResponse, error) {
+	response, err := s.Controller.ListAll()
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	products := make([]*ProtoProduct.Product, 0, len(response))
+	for index := range response {
+		products = append(products, convertProductToProto(&response[index]))
+	}
+	return &ProtoProduct.ListResponse{Products: products}, nil
+}
+
+func (s *Server) Create(_ context.Context, request *ProtoProduct.CreateRequest) (*ProtoProduct.CreateResponse, error) {
+	productBody := &EntitiesProduct.Product{Name: request.GetName()}
+	productBody.GenerateID()
+	if err := s.Rules.Validate(productBody); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ID, err := s.Controller.Create(productBody)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &ProtoProduct.CreateResponse{Id: ID.String()}, nil
+}
+
+func (s *Server) Update(_ context.Context, request *ProtoProduct.UpdateRequest) (*emptypb.Empty, error) {
+	ID, err := parseID(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	productBody := &EntitiesProduct.Product{Name: request.GetName()}
+	productBody.ID = ID
+	if err := s.Rules.Validate(productBody); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.Controller.Update(ID, productBody); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) Delete(_ context.Context, request *ProtoProduct.DeleteRequest) (*emptypb.Empty, error) {
+	ID, err := parseID(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.Controller.Remove(ID); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func parseID(value string) (uuid.UUID, error) {
+	ID, err := uuid.Parse(value)
+	if err != nil || ID == uuid.Nil {
+		return uuid.Nil, ErrIDInvalid
+	}
+	return ID, nil
+}
+
+func convertProductToProto(entity *EntitiesProduct.Product) *ProtoProduct.Product {
+	return &ProtoProduct.Product{
+		Id:        entity.ID.String(),
+		Name:      entity.Name,
+		CreatedAt: timestamppb.New(entity.CreatedAt),
+		UpdatedAt: timestamppb.New(entity.UpdatedAt),
+	}
+}
+
+func validateErrorController(err error) error {
+	if err.Error() == adapter.ErrRecordNotFound.Error() {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
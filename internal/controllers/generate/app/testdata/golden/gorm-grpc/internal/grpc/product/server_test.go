@@ -0,0 +1,171 @@
+package product
+
+import (
+	"context"
+	ProtoProduct "example.com/golden/api/proto/product"
+	RulesProduct "example.com/golden/internal/rules/product"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+func getClient(t *testing.T, conn *gorm.DB) ProtoProduct.ProductServiceClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	ProtoProduct.RegisterProductServiceServer(server, NewServer(adapter.NewAdapter(conn)))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	connection, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = connection.Close()
+		server.Stop()
+	})
+	return ProtoProduct.NewProductServiceClient(connection)
+}
+
+func getMigratedConnection() *gorm.DB {
+	conn := database.GetConnection("sqlite3", ":memory:")
+	rules := RulesProduct.NewRules()
+	rules.Migrate(conn, rules.GetMock())
+	return conn
+}
+
+func TestNewServer(t *testing.T) {
+	t.Run("Should return instance of server", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewServer(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestServer_Get(t *testing.T) {
+	t.Run("Should return invalid argument when id is not uuid", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Get(context.Background(), &ProtoProduct.GetRequest{Id: "wrong"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Should return not found when product not exists", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Get(context.Background(), &ProtoProduct.GetRequest{Id: uuid.New().String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Should return internal without migrate", func(t *testing.T) {
+		client := getClient(t, database.GetConnection("sqlite3", ":memory:"))
+		_, err := client.Get(context.Background(), &ProtoProduct.GetRequest{Id: uuid.New().String()})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("Should return product created", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		created, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{Name: "product"})
+		assert.NoError(t, err)
+		response, err := client.Get(context.Background(), &ProtoProduct.GetRequest{Id: created.GetId()})
+		assert.NoError(t, err)
+		assert.Equal(t, created.GetId(), response.GetId())
+		assert.Equal(t, "product", response.GetName())
+		assert.NotNil(t, response.GetCreatedAt())
+	})
+}
+
+func TestServer_List(t *testing.T) {
+	t.Run("Should return empty list", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		response, err := client.List(context.Background(), &ProtoProduct.ListRequest{})
+		assert.NoError(t, err)
+		assert.Empty(t, response.GetProducts())
+	})
+	t.Run("Should return list with product created", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{Name: "product"})
+		assert.NoError(t, err)
+		response, err := client.List(context.Background(), &ProtoProduct.ListRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, response.GetProducts(), 1)
+	})
+	t.Run("Should return internal without migrate", func(t *testing.T) {
+		client := getClient(t, database.GetConnection("sqlite3", ":memory:"))
+		_, err := client.List(context.Background(), &ProtoProduct.ListRequest{})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestServer_Create(t *testing.T) {
+	t.Run("Should return invalid argument when name is empty", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Should return internal without migrate", func(t *testing.T) {
+		client := getClient(t, database.GetConnection("sqlite3", ":memory:"))
+		_, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{Name: "product"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+	t.Run("Should return id of product created", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		response, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{Name: "product"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil.String(), response.GetId())
+	})
+}
+
+func TestServer_Update(t *testing.T) {
+	t.Run("Should return invalid argument when id is not uuid", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Update(context.Background(), &ProtoProduct.UpdateRequest{Id: "wrong", Name: "product"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Should return invalid argument when name is empty", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Update(context.Background(), &ProtoProduct.UpdateRequest{Id: uuid.New().String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Should return not found when product not exists", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Update(context.Background(),
+			&ProtoProduct.UpdateRequest{Id: uuid.New().String(), Name: "product"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Should update name of product", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		created, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{Name: "product"})
+		assert.NoError(t, err)
+		_, err = client.Update(context.Background(), &ProtoProduct.UpdateRequest{Id: created.GetId(), Name: "updated"})
+		assert.NoError(t, err)
+		response, err := client.Get(context.Background(), &ProtoProduct.GetRequest{Id: created.GetId()})
+		assert.NoError(t, err)
+		assert.Equal(t, "updated", response.GetName())
+	})
+}
+
+func TestServer_Delete(t *testing.T) {
+	t.Run("Should return invalid argument when id is not uuid", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Delete(context.Background(), &ProtoProduct.DeleteRequest{Id: "wrong"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Should return not found when product not exists", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		_, err := client.Delete(context.Background(), &ProtoProduct.DeleteRequest{Id: uuid.New().String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("Should delete product and return not found after", func(t *testing.T) {
+		client := getClient(t, getMigratedConnection())
+		created, err := client.Create(context.Background(), &ProtoProduct.CreateRequest{Name: "product"})
+		assert.NoError(t, err)
+		_, err = client.Delete(context.Background(), &ProtoProduct.DeleteRequest{Id: created.GetId()})
+		assert.NoError(t, err)
+		_, err = client.Get(context.Background(), &ProtoProduct.GetRequest{Id: created.GetId()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
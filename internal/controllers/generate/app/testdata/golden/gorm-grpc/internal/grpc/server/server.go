@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	ProtoProduct "example.com/golden/api/proto/product"
+	GrpcProduct "example.com/golden/internal/grpc/product"
+	"example.com/golden/pkg/repository/adapter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const ProductServiceName = "product.v1.ProductService"
+
+type Health struct {
+	*health.Server
+	repository adapter.Interface
+}
+
+func NewServer(repository adapter.Interface) *grpc.Server {
+	server := grpc.NewServer()
+	ProtoProduct.RegisterProductServiceServer(server, GrpcProduct.NewServer(repository))
+	grpc_health_v1.RegisterHealthServer(server, NewHealth(repository))
+	reflection.Register(server)
+	return server
+}
+
+func NewHealth(repository adapter.Interface) grpc_health_v1.HealthServer {
+	server := health.NewServer()
+	server.SetServingStatus(ProductServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	return &Health{
+		Server:     server,
+		repository: repository,
+	}
+}
+
+// Check returns not serving when the connection with database is not alive, like the route /health
+func (h *Health) Check(ctx context.Context,
+	request *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if !h.repository.Health() {
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return h.Server.Check(ctx, request)
+}
@@ -0,0 +1,34 @@
+package product
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Product struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (p *Product) TableName() string {
+	return "products"
+}
+
+func (p *Product) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *Product) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Product) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Product) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
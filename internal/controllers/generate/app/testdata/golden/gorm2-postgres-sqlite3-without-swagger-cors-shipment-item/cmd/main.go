@@ -0,0 +1,26 @@
+package main
+
+import (
+	"example.com/golden/configs"
+	"example.com/golden/internal/entities/shipmentitem"
+	"example.com/golden/internal/routes"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+func main() {
+	configs := config.GetConfig()
+	entity := &shipmentitem.ShipmentItem{}
+	connection := database.GetConnection(configs.Dialect, configs.DatabaseURI)
+	if err := connection.Table(entity.TableName()).AutoMigrate(entity); err != nil {
+		log.Fatal("Error on migrate table ", err)
+	}
+	port := fmt.Sprintf(":%v", configs.Port)
+	router := routes.NewRouter().SetRouters(adapter.NewAdapter(connection))
+	log.Println("service running on port ", port)
+	server := http.ListenAndServe(port, router)
+	log.Fatal(server)
+}
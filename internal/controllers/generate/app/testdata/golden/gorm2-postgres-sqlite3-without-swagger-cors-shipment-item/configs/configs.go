@@ -0,0 +1,19 @@
+package config
+
+import "example.com/golden/internal/utils/environment"
+
+type Config struct {
+	Port        int
+	Timeout     int
+	Dialect     string
+	DatabaseURI string
+}
+
+func GetConfig() Config {
+	return Config{
+		Port:        environment.GetEnvAndParseToInt("PORT", 8080),
+		Timeout:     environment.GetEnvAndParseToInt("TIMEOUT", 30),
+		Dialect:     environment.GetEnvString("DATABASE_DIALECT", "sqlite3"),
+		DatabaseURI: environment.GetEnvString("DATABASE_URI", ":memory:"),
+	}
+}
@@ -0,0 +1,80 @@
+package shipmentitem
+
+import (
+	"context"
+	"example.com/golden/internal/entities/shipmentitem"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	WithContext(ctx context.Context) Interface
+	ListOne(ID uuid.UUID) (entity shipmentitem.ShipmentItem, err error)
+	ListAll() (entities []shipmentitem.ShipmentItem, err error)
+	Create(entity *shipmentitem.ShipmentItem) (uuid.UUID, error)
+	Update(ID uuid.UUID, entity *shipmentitem.ShipmentItem) error
+	Remove(ID uuid.UUID) error
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) WithContext(ctx context.Context) Interface {
+	return &Controller{repository: c.repository.WithContext(ctx)}
+}
+
+func (c *Controller) ListOne(id uuid.UUID) (entity shipmentitem.ShipmentItem, err error) {
+	query := c.repository.Connection(entity.TableName()).Where(map[string]interface{}{"id": id})
+	response := c.repository.Find(query, &entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return shipmentitem.ShipmentItem{}, err
+	}
+
+	return entity, nil
+}
+
+func (c *Controller) ListAll() (entities []shipmentitem.ShipmentItem, err error) {
+	entity := &shipmentitem.ShipmentItem{}
+	entities = []shipmentitem.ShipmentItem{}
+	query := c.repository.Connection(entity.TableName())
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
+
+func (c *Controller) Create(entity *shipmentitem.ShipmentItem) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Update(id uuid.UUID, entity *shipmentitem.ShipmentItem) error {
+	entity.SetUpdatedAt()
+	_, err := c.ListOne(id)
+	if err != nil {
+		return err
+	}
+	response := c.repository.Update(map[string]interface{}{"id": id}, &entity, entity.TableName())
+	return response.Error()
+}
+
+func (c *Controller) Remove(id uuid.UUID) error {
+	var entity shipmentitem.ShipmentItem
+
+	response := c.repository.Delete(map[string]interface{}{"id": id}, entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
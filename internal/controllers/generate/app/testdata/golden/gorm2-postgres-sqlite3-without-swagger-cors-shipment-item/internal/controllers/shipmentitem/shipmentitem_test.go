@@ -0,0 +1,205 @@
+package shipmentitem
+
+import (
+	"context"
+	EntitiesShipmentItem "example.com/golden/internal/entities/shipmentitem"
+	RulesShipmentItem "example.com/golden/internal/rules/shipmentitem"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewController(t *testing.T) {
+	t.Run("Should create instance controller", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewController(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestController_WithContext(t *testing.T) {
+	t.Run("Should return error when context is canceled", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		rules := RulesShipmentItem.NewRules()
+		assert.NoError(t, rules.Migrate(conn, rules.GetMock()))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := NewController(adapter.NewAdapter(conn)).WithContext(ctx).ListAll()
+		assert.Error(t, err)
+	})
+}
+
+func TestController_Create(t *testing.T) {
+	t.Run("Should create shipment item on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		id, err := controller.Create(shipmentItemMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+	})
+	t.Run("Should not create shipment item on database because not migrate table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		id, err := controller.Create(shipmentItemMock)
+		assert.Error(t, err)
+		assert.Equal(t, id, uuid.Nil)
+	})
+}
+
+func TestController_ListAll(t *testing.T) {
+	t.Run("Should return empty list of shipment item on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.Equal(t, list, []EntitiesShipmentItem.ShipmentItem{})
+	})
+	t.Run("Should not return empty list of shipment item on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		id, err := controller.Create(shipmentItemMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.NotEqual(t, list, []EntitiesShipmentItem.ShipmentItem{})
+	})
+	t.Run("Should return error on list of shipment item on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListAll()
+		assert.Error(t, err)
+		assert.Equal(t, list, []EntitiesShipmentItem.ShipmentItem{})
+	})
+}
+
+func TestController_ListOne(t *testing.T) {
+	t.Run("Should return error record not found shipment item on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error shipment item on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should not return empty shipment item on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		id, err := controller.Create(shipmentItemMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(shipmentItemMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, shipmentItemMock.ID)
+	})
+}
+
+func TestController_Update(t *testing.T) {
+	t.Run("Should return error record not found shipment item on database when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Update(ID, shipmentItemMock)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error shipment item on database because not migrate when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		err := controller.Update(uuid.New(), shipmentItemMock)
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should udpdate shipment item without error and check if names is different", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		id, err := controller.Create(shipmentItemMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(shipmentItemMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, shipmentItemMock.ID)
+		shipmentItemMockUpdate := rules.GetMock()
+		shipmentItemMockUpdate.ID = shipmentItemMock.ID
+		err = controller.Update(shipmentItemMockUpdate.ID, shipmentItemMockUpdate)
+		assert.NoError(t, err)
+		foundedUpdated, err := controller.ListOne(shipmentItemMockUpdate.ID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, foundedUpdated.Name, shipmentItemMock.Name)
+	})
+}
+
+func TestController_Delete(t *testing.T) {
+	t.Run("Should return error record not found shipment item on database when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Remove(ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error shipment item on database because not migrate when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		err := controller.Remove(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should delete shipment item without error and check if shipment item not exists", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesShipmentItem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		id, err := controller.Create(shipmentItemMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(shipmentItemMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, shipmentItemMock.ID)
+		err = controller.Remove(founded.ID)
+		assert.NoError(t, err)
+		_, err = controller.ListOne(founded.ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+}
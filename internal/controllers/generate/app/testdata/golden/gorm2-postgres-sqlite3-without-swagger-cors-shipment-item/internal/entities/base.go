@@ -0,0 +1,12 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Base struct {
+	ID        uuid.UUID `gorm:"type:varchar(36);primaryKey" json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,34 @@
+package shipmentitem
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ShipmentItem struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (p *ShipmentItem) TableName() string {
+	return "shipment_items"
+}
+
+func (p *ShipmentItem) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *ShipmentItem) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *ShipmentItem) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *ShipmentItem) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
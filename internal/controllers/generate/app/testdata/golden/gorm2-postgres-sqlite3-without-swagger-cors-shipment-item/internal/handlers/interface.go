@@ -0,0 +1,11 @@
+package handlers
+
+import "net/http"
+
+type Interface interface {
+	Get(w http.ResponseWriter, r *http.Request)
+	Post(w http.ResponseWriter, r *http.Request)
+	Put(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request)
+	Options(w http.ResponseWriter, r *http.Request)
+}
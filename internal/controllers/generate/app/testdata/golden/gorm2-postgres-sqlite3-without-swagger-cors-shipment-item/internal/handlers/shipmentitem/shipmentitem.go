@@ -0,0 +1,126 @@
+package shipmentitem
+
+import (
+	"errors"
+	ControllersShipmentItem "example.com/golden/internal/controllers/shipmentitem"
+	entitiesShipmentItem "example.com/golden/internal/entities/shipmentitem"
+	"example.com/golden/internal/handlers"
+	RulesShipmentItem "example.com/golden/internal/rules/shipmentitem"
+	HttpStatus "example.com/golden/internal/utils/http"
+	"example.com/golden/internal/utils/params"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+type Handler struct {
+	handlers.Interface
+	Controller ControllersShipmentItem.Interface
+	Rules      *RulesShipmentItem.Rules
+}
+
+func NewHandler(repository adapter.Interface) handlers.Interface {
+	return &Handler{
+		Controller: ControllersShipmentItem.NewController(repository),
+		Rules:      RulesShipmentItem.NewRules(),
+	}
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	if params.Get(r, "ID") != "" {
+		h.getOne(w, r)
+	} else {
+		h.getAll(w, r)
+	}
+}
+
+func (h *Handler) getOne(w http.ResponseWriter, r *http.Request) {
+	ID, err := uuid.Parse(params.Get(r, "ID"))
+	if err != nil || ID == uuid.Nil {
+		HttpStatus.StatusBadRequest(w, r, errors.New("ID is not uuid valid"))
+		return
+	}
+
+	response, err := h.Controller.WithContext(r.Context()).ListOne(ID)
+	if err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+
+	HttpStatus.StatusOK(w, r, response)
+}
+
+func (h *Handler) getAll(w http.ResponseWriter, r *http.Request) {
+	response, err := h.Controller.WithContext(r.Context()).ListAll()
+	if err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+
+	HttpStatus.StatusOK(w, r, response)
+}
+
+func (h *Handler) Post(w http.ResponseWriter, r *http.Request) {
+	shipmentItemBody, err := h.Rules.ConvertIoReaderToShipmentItem(r.Body, uuid.Nil)
+	if err != nil {
+		HttpStatus.StatusBadRequest(w, r, err)
+		return
+	}
+	ID, err := h.Controller.WithContext(r.Context()).Create(shipmentItemBody)
+	if err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+
+	HttpStatus.StatusOK(w, r, map[string]interface{}{"id": ID.String()})
+}
+
+func (h *Handler) Put(w http.ResponseWriter, r *http.Request) {
+	ID, shipmentItemBody, err := h.getBodyAndIDParam(r)
+	if err != nil {
+		HttpStatus.StatusBadRequest(w, r, err)
+		return
+	}
+	if err := h.Controller.WithContext(r.Context()).Update(ID, shipmentItemBody); err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+	HttpStatus.StatusNoContent(w, r)
+}
+
+func (h *Handler) getBodyAndIDParam(r *http.Request) (uuid.UUID, *entitiesShipmentItem.ShipmentItem, error) {
+	ID, err := uuid.Parse(params.Get(r, "ID"))
+	if err != nil || ID == uuid.Nil {
+		return uuid.Nil, &entitiesShipmentItem.ShipmentItem{}, errors.New("ID is not uuid valid")
+	}
+	shipmentItemBody, err := h.Rules.ConvertIoReaderToShipmentItem(r.Body, ID)
+	if err != nil {
+		return uuid.Nil, &entitiesShipmentItem.ShipmentItem{}, err
+	}
+	return ID, shipmentItemBody, nil
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ID, err := uuid.Parse(params.Get(r, "ID"))
+	if err != nil || ID == uuid.Nil {
+		HttpStatus.StatusBadRequest(w, r, errors.New("ID is not uuid valid"))
+		return
+	}
+	if err := h.Controller.WithContext(r.Context()).Remove(ID); err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+	HttpStatus.StatusNoContent(w, r)
+}
+
+func validateErrorController(w http.ResponseWriter, r *http.Request, err error) {
+	if err.Error() == adapter.ErrRecordNotFound.Error() {
+		HttpStatus.StatusNotfound(w, r, err)
+		return
+	}
+	HttpStatus.StatusInternalServerError(w, r, err)
+}
+
+func (h *Handler) Options(w http.ResponseWriter, r *http.Request) {
+	HttpStatus.StatusNoContent(w, r)
+}
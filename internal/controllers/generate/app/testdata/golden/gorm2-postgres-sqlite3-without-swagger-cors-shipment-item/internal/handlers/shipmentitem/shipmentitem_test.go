@@ -0,0 +1,289 @@
+package shipmentitem
+
+import (
+	"bytes"
+	"example.com/golden/internal/rules/shipmentitem"
+	"example.com/golden/internal/utils/params"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("Should return instance of handler", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		repository := adapter.NewAdapter(conn)
+		assert.NotEmpty(t, NewHandler(repository))
+	})
+}
+
+func TestHandler_Options(t *testing.T) {
+	t.Run("Should return no content when call options", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodOptions, "/shipment-item", nil)
+		w := httptest.NewRecorder()
+		h.Options(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestHandler_Get(t *testing.T) {
+	t.Run("Should return ok when call getAll", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		rules := shipmentitem.NewRules()
+		rules.Migrate(conn, rules.GetMock())
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/shipment-item", nil)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return internal_error without migrate when call getAll", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/shipment-item", nil)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return bad request when call getOne", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/shipment-item/123", nil)
+		r = params.Set(r, "ID", "123")
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return notfound when call getOne", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		ID := uuid.New()
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		shipmentItemMock.ID = ID
+		rules.Migrate(conn, shipmentItemMock)
+		h := NewHandler(adapter.NewAdapter(conn))
+		r, _ := http.NewRequest(http.MethodGet, "/shipment-item/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return internal_error without migrate when call getOne", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		ID := uuid.New().String()
+		r, _ := http.NewRequest(http.MethodGet, "/shipment-item/"+ID, nil)
+		r = params.Set(r, "ID", ID)
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return OK when call getOne", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		ID := uuid.New()
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		shipmentItemMock.ID = ID
+		rules.Migrate(conn, shipmentItemMock)
+		databaseAdapter := adapter.NewAdapter(conn)
+		_ = databaseAdapter.Create(shipmentItemMock, shipmentItemMock.TableName())
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodGet, "/shipment-item/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Get(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
+func TestHandler_Post(t *testing.T) {
+	t.Run("Should return OK when call post", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		r, _ := http.NewRequest(http.MethodPost, "/shipment-item/", bytes.NewReader(shipmentItemMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return bad request when call post; name is empty", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		shipmentItemMock.Name = ""
+		rules.Migrate(conn, shipmentItemMock)
+		r, _ := http.NewRequest(http.MethodPost, "/shipment-item/", bytes.NewReader(shipmentItemMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return internal_error when call post; no exists table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		r, _ := http.NewRequest(http.MethodPost, "/shipment-item/", bytes.NewReader(shipmentItemMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Post(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHandler_Delete(t *testing.T) {
+	t.Run("Should return bad request when call delete empty uuid", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		r, _ := http.NewRequest(http.MethodDelete, "/shipment-item/", nil)
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return bad request when call delete wrong uuid", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		r, _ := http.NewRequest(http.MethodDelete, "/shipment-item/123", nil)
+		r = params.Set(r, "ID", "123")
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return not found when call delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		ID := uuid.New()
+		r, _ := http.NewRequest(http.MethodDelete, "/shipment-item/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return internal_error when call delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		h := NewHandler(adapter.NewAdapter(conn))
+		ID := uuid.New()
+		r, _ := http.NewRequest(http.MethodDelete, "/shipment-item/"+ID.String(), nil)
+		r = params.Set(r, "ID", ID.String())
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return NoContent when call delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		_ = databaseAdapter.Create(shipmentItemMock, shipmentItemMock.TableName())
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodDelete, "/shipment-item/"+shipmentItemMock.ID.String(), nil)
+		r = params.Set(r, "ID", shipmentItemMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Delete(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestHandler_Put(t *testing.T) {
+	t.Run("Should return OK when call put", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		_ = databaseAdapter.Create(shipmentItemMock, shipmentItemMock.TableName())
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/shipment-item/"+shipmentItemMock.ID.String(), bytes.NewReader(shipmentItemMock.Bytes()))
+		r = params.Set(r, "ID", shipmentItemMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+	t.Run("Should return bad request when call put; wrong uuid", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		_ = databaseAdapter.Create(shipmentItemMock, shipmentItemMock.TableName())
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/shipment-item/"+"123", bytes.NewReader(shipmentItemMock.Bytes()))
+		r = params.Set(r, "ID", "123")
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return bad request when call put; empty uuid", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		_ = databaseAdapter.Create(shipmentItemMock, shipmentItemMock.TableName())
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/shipment-item/", bytes.NewReader(shipmentItemMock.Bytes()))
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return bad request when call put; name is empty", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		shipmentItemMock.Name = ""
+		rules.Migrate(conn, shipmentItemMock)
+		_ = databaseAdapter.Create(shipmentItemMock, shipmentItemMock.TableName())
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/shipment-item/"+shipmentItemMock.ID.String(), bytes.NewReader(shipmentItemMock.Bytes()))
+		r = params.Set(r, "ID", shipmentItemMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Should return not found when call put;", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		rules.Migrate(conn, shipmentItemMock)
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/shipment-item/"+shipmentItemMock.ID.String(), bytes.NewReader(shipmentItemMock.Bytes()))
+		r = params.Set(r, "ID", shipmentItemMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return internal_error when call put;", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		databaseAdapter := adapter.NewAdapter(conn)
+		rules := shipmentitem.NewRules()
+		shipmentItemMock := rules.GetMock()
+		h := NewHandler(databaseAdapter)
+		r, _ := http.NewRequest(http.MethodPut, "/shipment-item/"+shipmentItemMock.ID.String(), bytes.NewReader(shipmentItemMock.Bytes()))
+		r = params.Set(r, "ID", shipmentItemMock.ID.String())
+		w := httptest.NewRecorder()
+		h.Put(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
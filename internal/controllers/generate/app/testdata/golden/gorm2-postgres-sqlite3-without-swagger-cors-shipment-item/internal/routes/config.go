@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"time"
+)
+
+type Config struct {
+	timeout time.Duration
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
+
+func (c *Config) SetTimeout(timeInSeconds int) *Config {
+	c.timeout = time.Duration(timeInSeconds) * time.Second
+	return c
+}
+
+func (c *Config) GetTimeout() time.Duration {
+	return c.timeout
+}
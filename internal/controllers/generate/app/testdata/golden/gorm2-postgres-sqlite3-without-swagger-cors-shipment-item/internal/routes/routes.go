@@ -0,0 +1,105 @@
+package routes
+
+import (
+	ServerConfig "example.com/golden/configs"
+	HealthHandler "example.com/golden/internal/handlers/health"
+	ShipmentItemHandler "example.com/golden/internal/handlers/shipmentitem"
+	"example.com/golden/internal/utils/params"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"net/http"
+)
+
+const BasePath = "/api/v1"
+
+type Router struct {
+	config *Config
+	router *chi.Mux
+}
+
+func NewRouter() *Router {
+	return &Router{
+		config: NewConfig().SetTimeout(ServerConfig.GetConfig().Timeout),
+		router: chi.NewRouter(),
+	}
+}
+
+func (r *Router) SetRouters(repository adapter.Interface) http.Handler {
+	r.setConfigsRouters()
+
+	r.RouterHealth(repository)
+	r.RouterShipmentItem(repository)
+
+	return r.router
+}
+
+func (r *Router) setConfigsRouters() {
+	r.EnableLogger()
+	r.EnableTimeout()
+	r.EnableRecover()
+	r.EnableRequestID()
+	r.EnableRealIP()
+}
+
+func (r *Router) RouterHealth(repository adapter.Interface) {
+	handler := HealthHandler.NewHandler(repository)
+
+	r.router.Route(BasePath+"/health", func(route chi.Router) {
+		route.Post("/", handler.Post)
+		route.Get("/", handler.Get)
+		route.Put("/", handler.Put)
+		route.Delete("/", handler.Delete)
+		route.Options("/", handler.Options)
+	})
+}
+
+func (r *Router) RouterShipmentItem(repository adapter.Interface) {
+	handler := ShipmentItemHandler.NewHandler(repository)
+
+	r.router.Route(BasePath+"/shipment-item", func(route chi.Router) {
+		route.Post("/", handler.Post)
+		route.Get("/", handler.Get)
+		route.Get("/{ID}", withParams(handler.Get))
+		route.Put("/{ID}", withParams(handler.Put))
+		route.Delete("/{ID}", withParams(handler.Delete))
+		route.Options("/", handler.Options)
+	})
+}
+
+func (r *Router) EnableLogger() *Router {
+	r.router.Use(middleware.Logger)
+	return r
+}
+
+func (r *Router) EnableTimeout() *Router {
+	r.router.Use(middleware.Timeout(r.config.GetTimeout()))
+	return r
+}
+
+func (r *Router) EnableRecover() *Router {
+	r.router.Use(middleware.Recoverer)
+	return r
+}
+
+func (r *Router) EnableRequestID() *Router {
+	r.router.Use(middleware.RequestID)
+	return r
+}
+
+func (r *Router) EnableRealIP() *Router {
+	r.router.Use(middleware.RealIP)
+	return r
+}
+
+// withParams copy the params of the path found by chi to the request, so the handlers read it with params.Get
+func withParams(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if ctx := chi.RouteContext(r.Context()); ctx != nil {
+			for index, key := range ctx.URLParams.Keys {
+				r = params.Set(r, key, ctx.URLParams.Values[index])
+			}
+		}
+		handler(w, r)
+	}
+}
@@ -0,0 +1,50 @@
+package routes
+
+import (
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serve(repository adapter.Interface, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	NewRouter().SetRouters(repository).ServeHTTP(w, r)
+	return w
+}
+
+func TestNewRouter(t *testing.T) {
+	t.Run("Should not return empty instance", func(t *testing.T) {
+		assert.NotNil(t, NewRouter())
+	})
+}
+
+func TestRouter_SetRouters(t *testing.T) {
+	t.Run("Should set routes and not return panics", func(t *testing.T) {
+		r := NewRouter()
+		assert.NotPanics(t, func() {
+			mock := &adapter.Mock{}
+			r.SetRouters(mock)
+		})
+	})
+	t.Run("Should return no content when call options of shipment item", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodOptions, BasePath+"/shipment-item", nil))
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+	t.Run("Should return method not allowed when call shipment item with method not registered", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodPatch, BasePath+"/shipment-item", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+	t.Run("Should return not found when route not exists", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodGet, BasePath+"/not-exists", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should send param of path to handler of shipment item", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+			w := serve(&adapter.Mock{}, httptest.NewRequest(method, BasePath+"/shipment-item/wrong", nil))
+			assert.Equal(t, http.StatusBadRequest, w.Code, method)
+			assert.Contains(t, w.Body.String(), "ID is not uuid valid", method)
+		}
+	})
+}
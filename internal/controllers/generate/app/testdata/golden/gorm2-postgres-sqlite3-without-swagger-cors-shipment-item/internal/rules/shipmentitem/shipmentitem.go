@@ -0,0 +1,59 @@
+package shipmentitem
+
+import (
+	"encoding/json"
+	"errors"
+	"example.com/golden/internal/entities"
+	"example.com/golden/internal/entities/shipmentitem"
+	RepositoryEntity "example.com/golden/pkg/repository/entities"
+	Validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"io"
+	"time"
+)
+
+type Rules struct{}
+
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+func (p *Rules) ConvertIoReaderToShipmentItem(data io.Reader, id uuid.UUID) (model *shipmentitem.ShipmentItem, err error) {
+	if data == nil {
+		return model, errors.New("body is invalid")
+	}
+	err = json.NewDecoder(data).Decode(&model)
+	if err != nil {
+		return model, err
+	}
+	if id == uuid.Nil {
+		model.GenerateID()
+	} else {
+		model.ID = id
+	}
+	return model, p.Validate(model)
+}
+
+func (p *Rules) GetMock() *shipmentitem.ShipmentItem {
+	return &shipmentitem.ShipmentItem{
+		Base: entities.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		Name: uuid.New().String(),
+	}
+}
+
+func (p *Rules) Migrate(connection *gorm.DB, model RepositoryEntity.Interface) error {
+	return connection.Table(model.TableName()).AutoMigrate(model)
+}
+
+func (p *Rules) Validate(shipmentItemEntity *shipmentitem.ShipmentItem) error {
+	return Validation.ValidateStruct(shipmentItemEntity,
+		Validation.Field(&shipmentItemEntity.ID, Validation.Required, is.UUIDv4),
+		Validation.Field(&shipmentItemEntity.Name, Validation.Required, Validation.Length(3, 50)),
+	)
+}
@@ -0,0 +1,75 @@
+package shipmentitem
+
+import (
+	"bytes"
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"example.com/golden/internal/entities/shipmentitem"
+	"example.com/golden/pkg/repository/database"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewRules(t *testing.T) {
+	t.Run("Should return instance rules", func(t *testing.T) {
+		assert.IsType(t, NewRules(), &Rules{})
+	})
+}
+
+func TestRules_ConvertIoReaderToShipmentItem(t *testing.T) {
+	t.Run("Should parse ioRead to shipment item", func(t *testing.T) {
+		r := NewRules()
+		ID := uuid.New()
+		data := &shipmentitem.ShipmentItem{
+			Base: entities.Base{
+				ID:        ID,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+			Name: uuid.New().String(),
+		}
+		shipmentItems, err := r.ConvertIoReaderToShipmentItem(bytes.NewReader(data.Bytes()), ID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, shipmentItems)
+	})
+	t.Run("Should return err when parse data nil", func(t *testing.T) {
+		r := NewRules()
+		shipmentItems, err := r.ConvertIoReaderToShipmentItem(nil, uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, shipmentItems)
+	})
+	t.Run("Should return err when parse data wrong", func(t *testing.T) {
+		r := NewRules()
+		b, _ := json.Marshal(math.NaN())
+		shipmentItems, err := r.ConvertIoReaderToShipmentItem(bytes.NewReader(b), uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, shipmentItems)
+	})
+}
+
+func TestRules_GetMock(t *testing.T) {
+	t.Run("should return mock correctly", func(t *testing.T) {
+		r := NewRules()
+		p := r.GetMock()
+		assert.NotEqual(t, p.ID, uuid.Nil)
+	})
+}
+
+func TestRules_Migrate(t *testing.T) {
+	t.Run("should migrate new table correctly", func(t *testing.T) {
+		r := NewRules()
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NoError(t, conn.Error)
+		assert.NoError(t, r.Migrate(conn, r.GetMock()))
+	})
+}
+
+func TestRules_Validate(t *testing.T) {
+	t.Run("Should return error if name is empty", func(t *testing.T) {
+		r := NewRules()
+		assert.Error(t, r.Validate(&shipmentitem.ShipmentItem{}))
+	})
+}
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "shipment_items"
+(
+    "id"   uuid NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "created_at" varchar(50) NOT NULL,
+    "updated_at" varchar(50) NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX "UK_shipment_items_name" ON "shipment_items"("name");
+
+COMMIT;
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"log"
+)
+
+var ErrDialectInvalid = errors.New("dialect not valid")
+var ErrURIInvalid = errors.New("uri not valid")
+
+func GetConnection(dialectName, uri string) *gorm.DB {
+	if !dialectIsAllowed(dialectName) && ErrDialectInvalid != nil {
+		log.Panic("Error", ErrDialectInvalid)
+	}
+	if uri == "" {
+		log.Panic("Error", ErrURIInvalid)
+	}
+
+	connection, err := gorm.Open(getDialector(dialectName, uri), &gorm.Config{
+		PrepareStmt: true,
+		Logger:      logger.Default.LogMode(logger.Silent),
+		// sqlite not support add constraints after create table
+		DisableForeignKeyConstraintWhenMigrating: dialectName == "sqlite3",
+	})
+	if err != nil {
+		log.Panic("Error on open connection", err)
+	}
+	if uri == ":memory:" {
+		// each connection of sqlite in memory is a new database
+		setMaxOpenConns(connection, 1)
+	}
+
+	return connection
+}
+
+func getDialector(dialectName, uri string) gorm.Dialector {
+	switch dialectName {
+	case "postgres":
+		return postgres.Open(uri)
+	case "sqlite3":
+		return sqlite.Open(uri)
+	default:
+		log.Panic("Error", ErrDialectInvalid)
+		return nil
+	}
+}
+
+func setMaxOpenConns(connection *gorm.DB, maxOpenConns int) {
+	sqlDB, err := connection.DB()
+	if err != nil {
+		log.Panic("Error on get connection", err)
+	}
+	sqlDB.SetMaxOpenConns(maxOpenConns)
+}
+
+func dialectIsAllowed(name string) bool {
+	for _, value := range typesValid() {
+		if value == name {
+			return true
+		}
+	}
+	return false
+}
+
+func typesValid() []string {
+	return []string{"postgres", "sqlite3"}
+}
@@ -0,0 +1,169 @@
+package entities
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Interface interface {
+	TableName() string
+	GenerateID()
+	Bytes() []byte
+	SetCreatedAt()
+	SetUpdatedAt()
+}
+
+/*
+======================== MANY_TO_MANY ========================
+*/
+
+type Doctor struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DoctorsPatients []DoctorPatient `gorm:"foreignKey:DoctorID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (d *Doctor) TableName() string {
+	return "doctors"
+}
+
+func (d *Doctor) SetCreatedAt() {
+	d.CreatedAt = time.Now()
+}
+
+func (d *Doctor) SetUpdatedAt() {
+	d.UpdatedAt = time.Now()
+}
+
+type DoctorPatient struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	PatientID uuid.UUID `gorm:"type:uuid"`
+	DoctorID  uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Patient   Patient `gorm:"foreignKey:PatientID;references:ID;constraint:OnDelete:CASCADE"`
+	Doctor    Doctor  `gorm:"foreignKey:DoctorID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (dp *DoctorPatient) TableName() string {
+	return "doctors_patients"
+}
+
+func (dp *DoctorPatient) SetCreatedAt() {
+	dp.CreatedAt = time.Now()
+}
+
+func (dp *DoctorPatient) SetUpdatedAt() {
+	dp.UpdatedAt = time.Now()
+}
+
+type Patient struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name            string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DoctorsPatients []DoctorPatient `gorm:"foreignKey:PatientID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (p *Patient) TableName() string {
+	return "patients"
+}
+
+func (p *Patient) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Patient) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
+
+/*
+======================== ONE_TO_MANY ========================
+*/
+
+type Restaurant struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Orders    []Order `gorm:"foreignKey:RestaurantID;references:ID;constraint:OnDelete:CASCADE"`
+}
+
+func (r *Restaurant) TableName() string {
+	return "restaurants"
+}
+
+func (r *Restaurant) SetCreatedAt() {
+	r.CreatedAt = time.Now()
+}
+
+func (r *Restaurant) SetUpdatedAt() {
+	r.UpdatedAt = time.Now()
+}
+
+type Order struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Description  string
+	RestaurantID uuid.UUID `gorm:"type:uuid"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (o *Order) TableName() string {
+	return "orders"
+}
+
+func (o *Order) SetCreatedAt() {
+	o.CreatedAt = time.Now()
+}
+
+func (o *Order) SetUpdatedAt() {
+	o.UpdatedAt = time.Now()
+}
+
+/*
+======================== ONE_TO_ONE ========================
+*/
+
+type Student struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	FirstName string
+	LastName  string
+	ContactID uuid.UUID `gorm:"type:uuid"`
+	Contact   Contact   `gorm:"foreignKey:ContactID;references:ID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (s *Student) TableName() string {
+	return "students"
+}
+
+func (s *Student) SetCreatedAt() {
+	s.CreatedAt = time.Now()
+}
+
+func (s *Student) SetUpdatedAt() {
+	s.UpdatedAt = time.Now()
+}
+
+type Contact struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	City      string
+	Phone     string
+	Address   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c *Contact) TableName() string {
+	return "contacts"
+}
+func (c *Contact) SetCreatedAt() {
+	c.CreatedAt = time.Now()
+}
+func (c *Contact) SetUpdatedAt() {
+	c.UpdatedAt = time.Now()
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetConfig(t *testing.T) {
+	t.Run("Should return configs", func(t *testing.T) {
+		c := GetConfig()
+		assert.Empty(t, c.SnapshotPath)
+		assert.NotEqual(t, c.Port, 0)
+		assert.NotEqual(t, c.Timeout, 0)
+	})
+}
@@ -0,0 +1,310 @@
+package adapter
+
+import (
+	"encoding/json"
+	"example.com/golden/pkg/repository/database"
+	"example.com/golden/pkg/repository/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type Product struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+}
+
+func (p *Product) TableName() string {
+	return "products"
+}
+
+func (p *Product) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Product) Key() []byte {
+	return []byte(p.ID.String())
+}
+
+func (p *Product) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *Product) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Product) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
+
+func GetMockProduct() *Product {
+	product := &Product{Name: uuid.New().String()}
+	product.GenerateID()
+	product.SetCreatedAt()
+	product.SetUpdatedAt()
+	return product
+}
+
+func TestMock(t *testing.T) {
+	mock := &Mock{}
+	product := &Product{}
+
+	t.Run("should return expected value in mock of GetLogMode", func(t *testing.T) {
+		mock.On("GetLogMode").Return(true)
+		assert.Equal(t, mock.GetLogMode(), true)
+	})
+	t.Run("should return expected value in mock of SetLogMode", func(t *testing.T) {
+		mock.On("SetLogMode").Return(mock)
+		assert.Equal(t, mock.SetLogMode(true), mock)
+	})
+	t.Run("should return expected value in mock of StartTransaction", func(t *testing.T) {
+		mock.On("StartTransaction").Return(mock)
+		assert.Equal(t, mock.StartTransaction(), mock)
+	})
+	t.Run("should return expected value in mock of CommitTransaction", func(t *testing.T) {
+		mock.On("CommitTransaction").Return(&response.Response{})
+		assert.Equal(t, mock.CommitTransaction(), &response.Response{})
+	})
+	t.Run("should return expected value in mock of RollbackTransaction", func(t *testing.T) {
+		mock.On("RollbackTransaction").Return(&response.Response{})
+		assert.Equal(t, mock.RollbackTransaction(), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Find", func(t *testing.T) {
+		mock.On("Find").Return(&response.Response{})
+		assert.Equal(t, mock.Find(product.TableName(), product.Key(), product), &response.Response{})
+	})
+	t.Run("should return expected value in mock of FindByPrefix", func(t *testing.T) {
+		mock.On("FindByPrefix").Return(&response.Response{})
+		assert.Equal(t, mock.FindByPrefix(product.TableName(), nil, &[]Product{}), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Create", func(t *testing.T) {
+		mock.On("Create").Return(&response.Response{})
+		assert.Equal(t, mock.Create(product), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Update", func(t *testing.T) {
+		mock.On("Update").Return(&response.Response{})
+		assert.Equal(t, mock.Update(product.Key(), product), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Delete", func(t *testing.T) {
+		mock.On("Delete").Return(&response.Response{})
+		assert.Equal(t, mock.Delete(product.Key(), product.TableName()), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Health", func(t *testing.T) {
+		mock.On("Health").Return(true)
+		assert.Equal(t, mock.Health(), true)
+	})
+}
+
+func TestNewAdapter(t *testing.T) {
+	t.Run("should return type of Database", func(t *testing.T) {
+		assert.IsType(t, &Database{}, NewAdapter(&database.Connection{}))
+	})
+}
+
+func TestDatabase_SetLogMode_GetLogMode(t *testing.T) {
+	t.Run("should setLog correctly", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		adapter.SetLogMode(true)
+		assert.True(t, adapter.GetLogMode())
+		adapter.SetLogMode(false)
+		assert.False(t, adapter.GetLogMode())
+	})
+}
+
+func TestDatabase_Health(t *testing.T) {
+	t.Run("should return true when connection is opened", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		assert.True(t, adapter.Health())
+	})
+	t.Run("should return false when connection is closed", func(t *testing.T) {
+		connection := database.GetConnection()
+		_ = connection.Close()
+		assert.False(t, NewAdapter(connection).Health())
+	})
+}
+
+func TestDatabase_Find(t *testing.T) {
+	t.Run("should return error record not found when table not exists", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		assert.Equal(t, ErrRecordNotFound, adapter.Find("not_exists", []byte("key"), &Product{}).Error())
+	})
+	t.Run("should return error when connection is closed", func(t *testing.T) {
+		connection := database.GetConnection()
+		_ = connection.Close()
+		response := NewAdapter(connection).Find("products", []byte("key"), &Product{})
+		assert.Error(t, response.Error())
+		assert.NotEqual(t, ErrRecordNotFound, response.Error())
+	})
+}
+
+func TestDatabase_FindByPrefix(t *testing.T) {
+	t.Run("should return error when entities is not pointer to slice", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		entities := []Product{}
+		assert.Equal(t, ErrEntityInvalid, adapter.FindByPrefix("products", nil, entities).Error())
+		assert.Equal(t, ErrEntityInvalid, adapter.FindByPrefix("products", nil, &Product{}).Error())
+	})
+	t.Run("should return empty list when table not exists", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		var entities []Product
+		response := adapter.FindByPrefix("not_exists", nil, &entities)
+		assert.NoError(t, response.Error())
+		assert.Equal(t, []Product{}, entities)
+	})
+	t.Run("should return only entities with key starting with prefix", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		first := GetMockProduct()
+		second := GetMockProduct()
+		assert.NoError(t, adapter.Create(first).Error())
+		assert.NoError(t, adapter.Create(second).Error())
+
+		entities := []*Product{}
+		response := adapter.FindByPrefix("products", nil, &entities)
+		assert.NoError(t, response.Error())
+		assert.Equal(t, int64(2), response.RowsAffected())
+
+		entities = []*Product{}
+		response = adapter.FindByPrefix("products", first.Key(), &entities)
+		assert.NoError(t, response.Error())
+		assert.Equal(t, int64(1), response.RowsAffected())
+		assert.Equal(t, first.ID, entities[0].ID)
+	})
+}
+
+func Test_Create_Read_Update_Delete(t *testing.T) {
+	t.Run("Should run tests to execute Create, Read, Update, Delete", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection()).SetLogMode(true)
+		product := GetMockProduct()
+
+		responseCreate := adapter.Create(product)
+		assert.NoError(t, responseCreate.Error())
+		assert.Equal(t, int64(1), responseCreate.RowsAffected())
+
+		entityToCheckFindAll := []Product{}
+		responseFindAll := adapter.FindByPrefix(product.TableName(), nil, &entityToCheckFindAll)
+		assert.NoError(t, responseFindAll.Error())
+		assert.Len(t, entityToCheckFindAll, 1)
+
+		entityToCheckFindOne := Product{}
+		responseFindOne := adapter.Find(product.TableName(), product.Key(), &entityToCheckFindOne)
+		assert.NoError(t, responseFindOne.Error())
+		assert.Equal(t, product.ID, entityToCheckFindOne.ID)
+		assert.Equal(t, product.Name, entityToCheckFindOne.Name)
+
+		product.Name = uuid.New().String()
+		responseUpdate := adapter.Update(product.Key(), product)
+		assert.NoError(t, responseUpdate.Error())
+		assert.Equal(t, int64(1), responseUpdate.RowsAffected())
+
+		entityToCheckUpdate := Product{}
+		assert.NoError(t, adapter.Find(product.TableName(), product.Key(), &entityToCheckUpdate).Error())
+		assert.Equal(t, product.Name, entityToCheckUpdate.Name)
+
+		responseDelete := adapter.Delete(product.Key(), product.TableName())
+		assert.NoError(t, responseDelete.Error())
+		assert.Equal(t, int64(1), responseDelete.RowsAffected())
+
+		responseFindOneDelete := adapter.Find(product.TableName(), product.Key(), &Product{})
+		assert.Equal(t, ErrRecordNotFound, responseFindOneDelete.Error())
+	})
+	t.Run("Should return error when create duplicated entity", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		product := GetMockProduct()
+		assert.NoError(t, adapter.Create(product).Error())
+		assert.Equal(t, ErrRecordAlreadyExists, adapter.Create(product).Error())
+	})
+	t.Run("Should return error record not found when update entity not created", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		product := GetMockProduct()
+		assert.Equal(t, ErrRecordNotFound, adapter.Update(product.Key(), product).Error())
+	})
+	t.Run("Should return zero rows affected when delete entity not created", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		response := adapter.Delete([]byte(uuid.New().String()), "products")
+		assert.NoError(t, response.Error())
+		assert.Equal(t, int64(0), response.RowsAffected())
+	})
+}
+
+func Test_Transaction(t *testing.T) {
+	t.Run("Should persist entity when commit transaction", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		product := GetMockProduct()
+
+		transaction := adapter.StartTransaction()
+		assert.NoError(t, transaction.Create(product).Error())
+		assert.NoError(t, transaction.Find(product.TableName(), product.Key(), &Product{}).Error())
+		assert.NoError(t, transaction.CommitTransaction().Error())
+
+		assert.NoError(t, adapter.Find(product.TableName(), product.Key(), &Product{}).Error())
+	})
+	t.Run("Should discard entity when rollback transaction", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		product := GetMockProduct()
+
+		transaction := adapter.StartTransaction()
+		assert.NoError(t, transaction.Create(product).Error())
+		assert.NoError(t, transaction.RollbackTransaction().Error())
+
+		assert.Equal(t, ErrRecordNotFound, adapter.Find(product.TableName(), product.Key(), &Product{}).Error())
+	})
+	t.Run("Should restore entities updated and deleted when rollback transaction", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		updated := GetMockProduct()
+		deleted := GetMockProduct()
+		assert.NoError(t, adapter.Create(updated).Error())
+		assert.NoError(t, adapter.Create(deleted).Error())
+		name := updated.Name
+
+		transaction := adapter.StartTransaction()
+		updated.Name = uuid.New().String()
+		assert.NoError(t, transaction.Update(updated.Key(), updated).Error())
+		assert.NoError(t, transaction.Delete(deleted.Key(), deleted.TableName()).Error())
+		assert.NoError(t, transaction.RollbackTransaction().Error())
+		assert.Equal(t, database.ErrTransactionClosed, transaction.CommitTransaction().Error())
+
+		entity := Product{}
+		assert.NoError(t, adapter.Find(updated.TableName(), updated.Key(), &entity).Error())
+		assert.Equal(t, name, entity.Name)
+		assert.NoError(t, adapter.Find(deleted.TableName(), deleted.Key(), &Product{}).Error())
+	})
+	t.Run("Should return error when commit or rollback without transaction", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		assert.Equal(t, ErrTransactionNotStarted, adapter.CommitTransaction().Error())
+		assert.Equal(t, ErrTransactionNotStarted, adapter.RollbackTransaction().Error())
+	})
+	t.Run("Should return error of begin when connection is closed", func(t *testing.T) {
+		connection := database.GetConnection()
+		_ = connection.Close()
+		transaction := NewAdapter(connection).StartTransaction()
+		assert.Error(t, transaction.Create(GetMockProduct()).Error())
+		assert.Error(t, transaction.CommitTransaction().Error())
+		assert.NotEqual(t, ErrTransactionNotStarted, transaction.RollbackTransaction().Error())
+	})
+}
+
+func Test_Concurrency(t *testing.T) {
+	t.Run("Should create all entities when write concurrently", func(t *testing.T) {
+		adapter := NewAdapter(database.GetConnection())
+		group := sync.WaitGroup{}
+		for i := 0; i < 50; i++ {
+			group.Add(1)
+			go func() {
+				defer group.Done()
+				product := GetMockProduct()
+				assert.NoError(t, adapter.Create(product).Error())
+				assert.NoError(t, adapter.Find(product.TableName(), product.Key(), &Product{}).Error())
+				assert.NoError(t, adapter.FindByPrefix(product.TableName(), nil, &[]Product{}).Error())
+			}()
+		}
+		group.Wait()
+		entities := []Product{}
+		assert.Equal(t, int64(50), adapter.FindByPrefix("products", nil, &entities).RowsAffected())
+	})
+}
@@ -0,0 +1,119 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetConnection(t *testing.T) {
+	t.Run("should return connection opened and empty", func(t *testing.T) {
+		connection := GetConnection()
+		assert.False(t, connection.IsClosed())
+		assert.NoError(t, connection.View(func(store Store) error {
+			assert.Empty(t, store.Keys("products", ""))
+			return nil
+		}))
+	})
+}
+
+func TestConnection_Close(t *testing.T) {
+	t.Run("should return error connection closed when use connection closed", func(t *testing.T) {
+		connection := GetConnection()
+		assert.NoError(t, connection.Close())
+		assert.True(t, connection.IsClosed())
+		assert.Equal(t, ErrConnectionClosed, connection.View(func(store Store) error { return nil }))
+		assert.Equal(t, ErrConnectionClosed, connection.Update(func(store Store) error { return nil }))
+		_, err := connection.Begin()
+		assert.Equal(t, ErrConnectionClosed, err)
+	})
+}
+
+func TestTables(t *testing.T) {
+	t.Run("should put, get, list by prefix sorted and delete values", func(t *testing.T) {
+		tables := Tables{}
+		tables.Put("products", "b2", []byte(`"b2"`))
+		tables.Put("products", "a1", []byte(`"a1"`))
+		tables.Put("products", "b1", []byte(`"b1"`))
+		assert.Equal(t, []byte(`"a1"`), tables.Get("products", "a1"))
+		assert.Equal(t, []string{"a1", "b1", "b2"}, tables.Keys("products", ""))
+		assert.Equal(t, []string{"b1", "b2"}, tables.Keys("products", "b"))
+		tables.Delete("products", "b1")
+		assert.Nil(t, tables.Get("products", "b1"))
+		assert.Nil(t, tables.Get("not_exists", "b1"))
+	})
+}
+
+func TestTransaction(t *testing.T) {
+	t.Run("should keep changes when commit", func(t *testing.T) {
+		connection := GetConnection()
+		transaction, err := connection.Begin()
+		assert.NoError(t, err)
+		transaction.Put("products", "key", []byte(`{}`))
+		assert.NoError(t, transaction.Commit())
+		assert.Equal(t, ErrTransactionClosed, transaction.Commit())
+		assert.NoError(t, connection.View(func(store Store) error {
+			assert.NotNil(t, store.Get("products", "key"))
+			return nil
+		}))
+	})
+	t.Run("should undo changes in reverse order when rollback", func(t *testing.T) {
+		connection := GetConnection()
+		assert.NoError(t, connection.Update(func(store Store) error {
+			store.Put("products", "key", []byte(`"first"`))
+			return nil
+		}))
+		transaction, err := connection.Begin()
+		assert.NoError(t, err)
+		transaction.Put("products", "key", []byte(`"second"`))
+		transaction.Delete("products", "key")
+		transaction.Put("products", "other", []byte(`"other"`))
+		assert.NoError(t, transaction.Rollback())
+		assert.Equal(t, ErrTransactionClosed, transaction.Rollback())
+		assert.NoError(t, connection.View(func(store Store) error {
+			assert.Equal(t, []byte(`"first"`), store.Get("products", "key"))
+			assert.Nil(t, store.Get("products", "other"))
+			return nil
+		}))
+	})
+}
+
+func TestConnection_Save_Load(t *testing.T) {
+	dir, err := ioutil.TempDir("", "standartmemory")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("should not return error when path is empty", func(t *testing.T) {
+		assert.NoError(t, GetConnection().Save(""))
+		assert.NoError(t, GetConnection().Load(""))
+	})
+	t.Run("should not return error when snapshot not exists", func(t *testing.T) {
+		assert.NoError(t, GetConnection().Load(filepath.Join(dir, "not_exists.json")))
+	})
+	t.Run("should return error when snapshot is invalid", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("invalid"), 0600))
+		assert.Error(t, GetConnection().Load(path))
+	})
+	t.Run("should return error when folder of snapshot not exists", func(t *testing.T) {
+		assert.Error(t, GetConnection().Save(filepath.Join(dir, "not_exists", "snapshot.json")))
+	})
+	t.Run("should load the same tables saved in snapshot", func(t *testing.T) {
+		path := filepath.Join(dir, "snapshot.json")
+		connection := GetConnection()
+		assert.NoError(t, connection.Update(func(store Store) error {
+			store.Put("products", "key", []byte(`{"name":"product"}`))
+			return nil
+		}))
+		assert.NoError(t, connection.Save(path))
+
+		loaded := GetConnection()
+		assert.NoError(t, loaded.Load(path))
+		assert.NoError(t, loaded.View(func(store Store) error {
+			assert.JSONEq(t, `{"name":"product"}`, string(store.Get("products", "key")))
+			return nil
+		}))
+	})
+}
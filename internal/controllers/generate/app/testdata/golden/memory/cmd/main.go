@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"example.com/golden/configs"
+	"example.com/golden/docs"
+	"example.com/golden/internal/routes"
+	"example.com/golden/pkg/repository/adapter"
+	"example.com/golden/pkg/repository/database"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// @title Standart Memory
+// @version 1.0
+// @description This is a sample server using standart in-memory database.
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name Standart Memory Support
+// @contact.url https://github.com/wilian746/go-generator/issues
+// @contact.email support@swagger.io
+
+// @license.name MIT
+// @license.url https://github.com/wilian746/go-generator/blob/master/LICENSE
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+func main() {
+	configs := config.GetConfig()
+	connection := database.GetConnection()
+	if err := connection.Load(configs.SnapshotPath); err != nil {
+		log.Fatal("Error on load snapshot ", err)
+	}
+	port := fmt.Sprintf(":%v", configs.Port)
+	router := routes.NewRouter().SetRouters(adapter.NewAdapter(connection))
+	server := &http.Server{Addr: port, Handler: router}
+	log.Println("service running on port ", port)
+	setupSwagger()
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+	waitShutdown(server, connection, configs)
+}
+
+// waitShutdown stop the server when receive a signal and save the snapshot of the database,
+// the connection is closed after save to not lose any change
+func waitShutdown(server *http.Server, connection *database.Connection, configs config.Config) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	<-signals
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(configs.Timeout)*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Println("Error on shutdown server ", err)
+	}
+	if err := connection.Save(configs.SnapshotPath); err != nil {
+		log.Fatal("Error on save snapshot ", err)
+	}
+	_ = connection.Close()
+	log.Println("service stopped")
+}
+
+func setupSwagger() {
+	configs := config.GetConfig()
+	// If your change host to Ex.: 192.168.1.0 is necessary change manually you field of search
+	// to your host too in your browser
+	docs.SwaggerInfo.Host = configs.SwaggerHost
+	docs.SwaggerInfo.BasePath = routes.BasePath
+	log.Println("swagger running on url: ", fmt.Sprintf("http://%s/swagger/index.html", docs.SwaggerInfo.Host))
+}
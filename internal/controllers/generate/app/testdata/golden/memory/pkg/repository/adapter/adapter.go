@@ -0,0 +1,201 @@
+package adapter
+
+import (
+	"encoding/json"
+	"errors"
+	"example.com/golden/pkg/repository/database"
+	"example.com/golden/pkg/repository/entities"
+	"example.com/golden/pkg/repository/response"
+	"log"
+	"reflect"
+)
+
+var ErrRecordNotFound = errors.New("record not found")
+var ErrRecordAlreadyExists = errors.New("record already exists")
+var ErrEntityInvalid = errors.New("entity must be a pointer to slice")
+var ErrTransactionNotStarted = errors.New("transaction not started")
+
+type Database struct {
+	connection  *database.Connection
+	transaction *database.Transaction
+	logMode     bool
+	err         error
+}
+
+type Interface interface {
+	GetLogMode() bool
+	SetLogMode(logMode bool) Interface
+
+	Health() bool
+
+	StartTransaction() Interface
+	CommitTransaction() *response.Response
+	RollbackTransaction() *response.Response
+
+	Find(tableName string, key []byte, entity interface{}) *response.Response
+	FindByPrefix(tableName string, prefix []byte, entities interface{}) *response.Response
+	Create(entity entities.Interface) *response.Response
+	Update(key []byte, entity entities.Interface) *response.Response
+	Delete(key []byte, tableName string) *response.Response
+}
+
+func NewAdapter(connection *database.Connection) Interface {
+	return &Database{
+		connection: connection,
+	}
+}
+
+func (d *Database) GetLogMode() bool {
+	return d.logMode
+}
+
+func (d *Database) SetLogMode(logMode bool) Interface {
+	d.logMode = logMode
+	return d
+}
+
+func (d *Database) Health() bool {
+	return !d.connection.IsClosed()
+}
+
+func (d *Database) StartTransaction() Interface {
+	transaction, err := d.connection.Begin()
+	return &Database{
+		connection:  d.connection,
+		transaction: transaction,
+		logMode:     d.logMode,
+		err:         err,
+	}
+}
+
+func (d *Database) CommitTransaction() *response.Response {
+	if d.transaction == nil {
+		return response.NewDefaultResponse(d.getTransactionError(), 0)
+	}
+	return response.NewDefaultResponse(d.transaction.Commit(), 0)
+}
+
+func (d *Database) RollbackTransaction() *response.Response {
+	if d.transaction == nil {
+		return response.NewDefaultResponse(d.getTransactionError(), 0)
+	}
+	return response.NewDefaultResponse(d.transaction.Rollback(), 0)
+}
+
+func (d *Database) getTransactionError() error {
+	if d.err != nil {
+		return d.err
+	}
+	return ErrTransactionNotStarted
+}
+
+func (d *Database) Find(tableName string, key []byte, entity interface{}) *response.Response {
+	d.log("find", tableName, key)
+	err := d.view(func(store database.Store) error {
+		value := store.Get(tableName, string(key))
+		if value == nil {
+			return ErrRecordNotFound
+		}
+		return json.Unmarshal(value, entity)
+	})
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	return response.NewDefaultResponse(nil, 1)
+}
+
+func (d *Database) FindByPrefix(tableName string, prefix []byte, entities interface{}) *response.Response {
+	value := reflect.ValueOf(entities)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Slice {
+		return response.NewDefaultResponse(ErrEntityInvalid, 0)
+	}
+	d.log("find by prefix", tableName, prefix)
+	slice := value.Elem()
+	result := reflect.MakeSlice(slice.Type(), 0, 0)
+	err := d.view(func(store database.Store) error {
+		for _, key := range store.Keys(tableName, string(prefix)) {
+			element := reflect.New(slice.Type().Elem())
+			if err := json.Unmarshal(store.Get(tableName, key), element.Interface()); err != nil {
+				return err
+			}
+			result = reflect.Append(result, element.Elem())
+		}
+		return nil
+	})
+	slice.Set(result)
+	return response.NewDefaultResponse(err, int64(result.Len()))
+}
+
+func (d *Database) Create(entity entities.Interface) *response.Response {
+	d.log("create", entity.TableName(), entity.Key())
+	err := d.update(func(store database.Store) error {
+		if store.Get(entity.TableName(), string(entity.Key())) != nil {
+			return ErrRecordAlreadyExists
+		}
+		store.Put(entity.TableName(), string(entity.Key()), entity.Bytes())
+		return nil
+	})
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	return response.NewDefaultResponse(nil, 1)
+}
+
+func (d *Database) Update(key []byte, entity entities.Interface) *response.Response {
+	d.log("update", entity.TableName(), key)
+	err := d.update(func(store database.Store) error {
+		if store.Get(entity.TableName(), string(key)) == nil {
+			return ErrRecordNotFound
+		}
+		store.Put(entity.TableName(), string(key), entity.Bytes())
+		return nil
+	})
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	return response.NewDefaultResponse(nil, 1)
+}
+
+func (d *Database) Delete(key []byte, tableName string) *response.Response {
+	d.log("delete", tableName, key)
+	var rowsAffected int64
+	err := d.update(func(store database.Store) error {
+		if store.Get(tableName, string(key)) == nil {
+			return nil
+		}
+		rowsAffected = 1
+		store.Delete(tableName, string(key))
+		return nil
+	})
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	return response.NewDefaultResponse(nil, rowsAffected)
+}
+
+// view and update use the transaction started, otherwise each operation locks the connection only while runs
+func (d *Database) view(callback func(store database.Store) error) error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.transaction != nil {
+		return callback(d.transaction)
+	}
+	return d.connection.View(callback)
+}
+
+func (d *Database) update(callback func(store database.Store) error) error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.transaction != nil {
+		return callback(d.transaction)
+	}
+	return d.connection.Update(callback)
+}
+
+func (d *Database) log(operation, tableName string, key []byte) {
+	if d.logMode {
+		log.Println(operation, tableName, string(key))
+	}
+}
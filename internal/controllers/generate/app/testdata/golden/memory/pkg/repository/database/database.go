@@ -0,0 +1,205 @@
+package database
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var ErrConnectionClosed = errors.New("connection closed")
+var ErrTransactionClosed = errors.New("transaction already committed or rolled back")
+
+type Store interface {
+	Get(tableName, key string) []byte
+	Put(tableName, key string, value []byte)
+	Delete(tableName, key string)
+	Keys(tableName, prefix string) []string
+}
+
+// Tables are saved as JSON in the snapshot, so each value must be a valid JSON
+type Tables map[string]map[string]json.RawMessage
+
+type Connection struct {
+	mutex  sync.RWMutex
+	tables Tables
+	closed bool
+}
+
+func GetConnection() *Connection {
+	return &Connection{tables: Tables{}}
+}
+
+func (c *Connection) View(callback func(store Store) error) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.closed {
+		return ErrConnectionClosed
+	}
+	return callback(c.tables)
+}
+
+func (c *Connection) Update(callback func(store Store) error) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.closed {
+		return ErrConnectionClosed
+	}
+	return callback(c.tables)
+}
+
+// Begin locks the connection to write until the transaction is committed or rolled back
+func (c *Connection) Begin() (*Transaction, error) {
+	c.mutex.Lock()
+	if c.closed {
+		c.mutex.Unlock()
+		return nil, ErrConnectionClosed
+	}
+	return &Transaction{connection: c}, nil
+}
+
+func (c *Connection) IsClosed() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.closed
+}
+
+func (c *Connection) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closed = true
+	return nil
+}
+
+// Load fill the connection with the snapshot saved in path, if path is empty or not exists nothing is loaded
+func (c *Connection) Load(path string) error {
+	if path == "" {
+		return nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	tables := Tables{}
+	if err := json.Unmarshal(content, &tables); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.closed {
+		return ErrConnectionClosed
+	}
+	c.tables = tables
+	return nil
+}
+
+// Save write the snapshot of all tables in path, if path is empty nothing is saved
+func (c *Connection) Save(path string) error {
+	if path == "" {
+		return nil
+	}
+	c.mutex.RLock()
+	content, err := json.MarshalIndent(c.tables, "", "  ")
+	c.mutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	// write in other file and rename to not lose the last snapshot if the write fails
+	temporaryPath := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := ioutil.WriteFile(temporaryPath, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(temporaryPath, path)
+}
+
+func (t Tables) Get(tableName, key string) []byte {
+	return t[tableName][key]
+}
+
+func (t Tables) Put(tableName, key string, value []byte) {
+	if _, ok := t[tableName]; !ok {
+		t[tableName] = map[string]json.RawMessage{}
+	}
+	t[tableName][key] = value
+}
+
+func (t Tables) Delete(tableName, key string) {
+	delete(t[tableName], key)
+}
+
+func (t Tables) Keys(tableName, prefix string) (keys []string) {
+	for key := range t[tableName] {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+type Transaction struct {
+	connection *Connection
+	changes    []change
+	closed     bool
+}
+
+type change struct {
+	tableName string
+	key       string
+	value     []byte
+}
+
+func (t *Transaction) Get(tableName, key string) []byte {
+	return t.connection.tables.Get(tableName, key)
+}
+
+func (t *Transaction) Put(tableName, key string, value []byte) {
+	t.saveChange(tableName, key)
+	t.connection.tables.Put(tableName, key, value)
+}
+
+func (t *Transaction) Delete(tableName, key string) {
+	t.saveChange(tableName, key)
+	t.connection.tables.Delete(tableName, key)
+}
+
+func (t *Transaction) Keys(tableName, prefix string) []string {
+	return t.connection.tables.Keys(tableName, prefix)
+}
+
+func (t *Transaction) saveChange(tableName, key string) {
+	t.changes = append(t.changes, change{tableName: tableName, key: key, value: t.Get(tableName, key)})
+}
+
+func (t *Transaction) Commit() error {
+	if t.closed {
+		return ErrTransactionClosed
+	}
+	t.closed = true
+	t.connection.mutex.Unlock()
+	return nil
+}
+
+func (t *Transaction) Rollback() error {
+	if t.closed {
+		return ErrTransactionClosed
+	}
+	for i := len(t.changes) - 1; i >= 0; i-- {
+		previous := t.changes[i]
+		if previous.value == nil {
+			t.connection.tables.Delete(previous.tableName, previous.key)
+		} else {
+			t.connection.tables.Put(previous.tableName, previous.key, previous.value)
+		}
+	}
+	t.closed = true
+	t.connection.mutex.Unlock()
+	return nil
+}
@@ -0,0 +1,10 @@
+package entities
+
+type Interface interface {
+	TableName() string
+	GenerateID()
+	Key() []byte
+	Bytes() []byte
+	SetCreatedAt()
+	SetUpdatedAt()
+}
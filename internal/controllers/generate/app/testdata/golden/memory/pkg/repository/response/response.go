@@ -0,0 +1,21 @@
+package response
+
+type Response struct {
+	err          error
+	rowsAffected int64
+}
+
+func NewDefaultResponse(err error, rowsAffected int64) *Response {
+	return &Response{
+		err:          err,
+		rowsAffected: rowsAffected,
+	}
+}
+
+func (d *Response) RowsAffected() int64 {
+	return d.rowsAffected
+}
+
+func (d *Response) Error() error {
+	return d.err
+}
@@ -0,0 +1,74 @@
+package invoice
+
+import (
+	"example.com/golden/internal/entities/invoice"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	ListOne(ID uuid.UUID) (entity invoice.Invoice, err error)
+	ListAll() (entities []invoice.Invoice, err error)
+	Create(entity *invoice.Invoice) (uuid.UUID, error)
+	Update(ID uuid.UUID, entity *invoice.Invoice) error
+	Remove(ID uuid.UUID) error
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) ListOne(id uuid.UUID) (entity invoice.Invoice, err error) {
+	query := c.repository.Connection(entity.TableName()).Where(map[string]interface{}{"id": id})
+	response := c.repository.Find(query, &entity)
+	if err := response.Error(); err != nil {
+		return invoice.Invoice{}, err
+	}
+
+	return entity, nil
+}
+
+func (c *Controller) ListAll() (entities []invoice.Invoice, err error) {
+	entity := &invoice.Invoice{}
+	query := c.repository.Connection(entity.TableName())
+	response := c.repository.Find(query, &entities)
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
+
+func (c *Controller) Create(entity *invoice.Invoice) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity)
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Update(id uuid.UUID, entity *invoice.Invoice) error {
+	entity.SetUpdatedAt()
+	_, err := c.ListOne(id)
+	if err != nil {
+		return err
+	}
+	values := map[string]interface{}{"name": entity.Name, "updated_at": entity.UpdatedAt}
+	response := c.repository.Update(map[string]interface{}{"id": id}, values, entity.TableName())
+	return response.Error()
+}
+
+func (c *Controller) Remove(id uuid.UUID) error {
+	var entity invoice.Invoice
+
+	response := c.repository.Delete(map[string]interface{}{"id": id}, entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
@@ -0,0 +1,46 @@
+package invoice
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Invoice struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (p *Invoice) TableName() string {
+	return "invoices"
+}
+
+func (p *Invoice) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *Invoice) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Invoice) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Invoice) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Invoice) Columns() []string {
+	return []string{"id", "name", "created_at", "updated_at"}
+}
+
+func (p *Invoice) Values() []interface{} {
+	return []interface{}{p.ID, p.Name, p.CreatedAt, p.UpdatedAt}
+}
+
+func (p *Invoice) Fields() []interface{} {
+	return []interface{}{&p.ID, &p.Name, &p.CreatedAt, &p.UpdatedAt}
+}
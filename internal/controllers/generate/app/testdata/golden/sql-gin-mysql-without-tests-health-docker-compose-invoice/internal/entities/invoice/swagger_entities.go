@@ -0,0 +1,19 @@
+package invoice
+
+type RequestBodyToCreateOrUpdateInvoice struct {
+	Name string `json:"name"`
+}
+
+type ResponseCreateInvoice struct {
+	ID string `json:"id"`
+}
+
+type ResponseListAllInvoice struct {
+	Status int       `json:"status"`
+	Result []Invoice `json:"result"`
+}
+
+type ResponseListOneInvoice struct {
+	Status int     `json:"status"`
+	Result Invoice `json:"result"`
+}
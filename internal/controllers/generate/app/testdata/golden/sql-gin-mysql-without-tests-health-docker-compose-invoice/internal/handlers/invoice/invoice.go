@@ -0,0 +1,179 @@
+package invoice
+
+import (
+	"errors"
+	ControllersInvoice "example.com/golden/internal/controllers/invoice"
+	_ "example.com/golden/internal/entities" // import used in swagger
+	entitiesInvoice "example.com/golden/internal/entities/invoice"
+	"example.com/golden/internal/handlers"
+	RulesInvoice "example.com/golden/internal/rules/invoice"
+	HttpStatus "example.com/golden/internal/utils/http"
+	"example.com/golden/internal/utils/params"
+	"example.com/golden/pkg/repository/adapter"
+	"github.com/google/uuid"
+	"net/http"
+)
+
+type Handler struct {
+	handlers.Interface
+	Controller ControllersInvoice.Interface
+	Rules      *RulesInvoice.Rules
+}
+
+func NewHandler(repository adapter.Interface) handlers.Interface {
+	return &Handler{
+		Controller: ControllersInvoice.NewController(repository),
+		Rules:      RulesInvoice.NewRules(),
+	}
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	if params.Get(r, "ID") != "" {
+		h.getOne(w, r)
+	} else {
+		h.getAll(w, r)
+	}
+}
+
+// @Tags Invoice
+// @Summary List invoice by id
+// @ID get-one-invoice
+// @Accept  json
+// @Produce  json
+// @Param ID path string true "ID of the invoice"
+// @Success 200 {object} invoice.ResponseListOneInvoice
+// @Failure 400 {object} http.ResponseError
+// @Failure 404 {object} http.ResponseError
+// @Failure 500 {object} http.ResponseError
+// @Router /invoice/{ID} [get]
+func (h *Handler) getOne(w http.ResponseWriter, r *http.Request) {
+	ID, err := uuid.Parse(params.Get(r, "ID"))
+	if err != nil || ID == uuid.Nil {
+		HttpStatus.StatusBadRequest(w, r, errors.New("ID is not uuid valid"))
+		return
+	}
+
+	response, err := h.Controller.ListOne(ID)
+	if err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+
+	HttpStatus.StatusOK(w, r, response)
+}
+
+// @Tags Invoice
+// @Summary List all invoices
+// @ID get-all-invoices
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} invoice.ResponseListAllInvoice
+// @Failure 500 {object} http.ResponseError
+// @Router /invoice [get]
+func (h *Handler) getAll(w http.ResponseWriter, r *http.Request) {
+	response, err := h.Controller.ListAll()
+	if err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+
+	HttpStatus.StatusOK(w, r, response)
+}
+
+// @Tags Invoice
+// @Summary Create an invoice
+// @ID post-invoice
+// @Accept json
+// @Produce json
+// @Param invoice body invoice.RequestBodyToCreateOrUpdateInvoice true "Body of add invoice"
+// @Success 200 {object} invoice.ResponseCreateInvoice
+// @Failure 500 {object} http.ResponseError
+// @Failure 400 {object} http.ResponseError
+// @Router /invoice [post]
+func (h *Handler) Post(w http.ResponseWriter, r *http.Request) {
+	invoiceBody, err := h.Rules.ConvertIoReaderToInvoice(r.Body, uuid.Nil)
+	if err != nil {
+		HttpStatus.StatusBadRequest(w, r, err)
+		return
+	}
+	ID, err := h.Controller.Create(invoiceBody)
+	if err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+
+	HttpStatus.StatusOK(w, r, map[string]interface{}{"id": ID.String()})
+}
+
+// @Tags Invoice
+// @Summary Update an invoice
+// @ID put-invoice
+// @Accept  json
+// @Produce  json
+// @Param ID path string true "ID of the invoice"
+// @Param invoice body invoice.RequestBodyToCreateOrUpdateInvoice true "Body of update invoice"
+// @Success 204
+// @Failure 400 {object} http.ResponseError
+// @Failure 404 {object} http.ResponseError
+// @Failure 500 {object} http.ResponseError
+// @Router /invoice/{ID} [put]
+func (h *Handler) Put(w http.ResponseWriter, r *http.Request) {
+	ID, invoiceBody, err := h.getBodyAndIDParam(r)
+	if err != nil {
+		HttpStatus.StatusBadRequest(w, r, err)
+		return
+	}
+	if err := h.Controller.Update(ID, invoiceBody); err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+	HttpStatus.StatusNoContent(w, r)
+}
+
+func (h *Handler) getBodyAndIDParam(r *http.Request) (uuid.UUID, *entitiesInvoice.Invoice, error) {
+	ID, err := uuid.Parse(params.Get(r, "ID"))
+	if err != nil || ID == uuid.Nil {
+		return uuid.Nil, &entitiesInvoice.Invoice{}, errors.New("ID is not uuid valid")
+	}
+	invoiceBody, err := h.Rules.ConvertIoReaderToInvoice(r.Body, ID)
+	if err != nil {
+		return uuid.Nil, &entitiesInvoice.Invoice{}, err
+	}
+	return ID, invoiceBody, nil
+}
+
+// @Tags Invoice
+// @Summary Delete an invoice
+// @ID delete-invoice
+// @Accept  json
+// @Produce  json
+// @Param ID path string true "ID of the invoice"
+// @Success 204
+// @Failure 400 {object} http.ResponseError
+// @Failure 404 {object} http.ResponseError
+// @Failure 500 {object} http.ResponseError
+// @Router /invoice/{ID} [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ID, err := uuid.Parse(params.Get(r, "ID"))
+	if err != nil || ID == uuid.Nil {
+		HttpStatus.StatusBadRequest(w, r, errors.New("ID is not uuid valid"))
+		return
+	}
+	if err := h.Controller.Remove(ID); err != nil {
+		validateErrorController(w, r, err)
+		return
+	}
+	HttpStatus.StatusNoContent(w, r)
+}
+
+func validateErrorController(w http.ResponseWriter, r *http.Request, err error) {
+	if err.Error() == adapter.ErrRecordNotFound.Error() {
+		HttpStatus.StatusNotfound(w, r, err)
+		return
+	}
+	HttpStatus.StatusInternalServerError(w, r, err)
+}
+
+func (h *Handler) Options(w http.ResponseWriter, r *http.Request) {
+	HttpStatus.StatusNoContent(w, r)
+}
@@ -0,0 +1,53 @@
+package invoice
+
+import (
+	"encoding/json"
+	"errors"
+	"example.com/golden/internal/entities"
+	"example.com/golden/internal/entities/invoice"
+	Validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"io"
+	"time"
+)
+
+type Rules struct{}
+
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+func (p *Rules) ConvertIoReaderToInvoice(data io.Reader, id uuid.UUID) (model *invoice.Invoice, err error) {
+	if data == nil {
+		return model, errors.New("body is invalid")
+	}
+	err = json.NewDecoder(data).Decode(&model)
+	if err != nil {
+		return model, err
+	}
+	if id == uuid.Nil {
+		model.GenerateID()
+	} else {
+		model.ID = id
+	}
+	return model, p.Validate(model)
+}
+
+func (p *Rules) GetMock() *invoice.Invoice {
+	return &invoice.Invoice{
+		Base: entities.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		Name: uuid.New().String(),
+	}
+}
+
+func (p *Rules) Validate(invoiceEntity *invoice.Invoice) error {
+	return Validation.ValidateStruct(invoiceEntity,
+		Validation.Field(&invoiceEntity.ID, Validation.Required, is.UUIDv4),
+		Validation.Field(&invoiceEntity.Name, Validation.Required, Validation.Length(3, 50)),
+	)
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	_ "github.com/go-sql-driver/mysql" // database/sql driver
+	"log"
+)
+
+var ErrDialectInvalid = errors.New("dialect not valid")
+var ErrURIInvalid = errors.New("uri not valid")
+
+func GetConnection(dialectName, uri string) *sql.DB {
+	if !dialectIsAllowed(dialectName) && ErrDialectInvalid != nil {
+		log.Panic("Error", ErrDialectInvalid)
+	}
+	if uri == "" {
+		log.Panic("Error", ErrURIInvalid)
+	}
+
+	connection, err := sql.Open(getDriverName(dialectName), uri)
+	if err != nil {
+		log.Panic("Error on open connection", err)
+	}
+	if err := connection.Ping(); err != nil {
+		log.Panic("Error on open connection", err)
+	}
+	if uri == ":memory:" {
+		// each connection of sqlite in memory is a new database
+		connection.SetMaxOpenConns(1)
+	}
+
+	return connection
+}
+
+func getDriverName(dialectName string) string {
+	if dialectName == "mssql" {
+		return "sqlserver"
+	}
+	return dialectName
+}
+
+func dialectIsAllowed(name string) bool {
+	for _, value := range typesValid() {
+		if value == name {
+			return true
+		}
+	}
+	return false
+}
+
+func typesValid() []string {
+	return []string{"mysql"}
+}
@@ -0,0 +1,12 @@
+package entities
+
+type Interface interface {
+	TableName() string
+	GenerateID()
+	Bytes() []byte
+	SetCreatedAt()
+	SetUpdatedAt()
+	Columns() []string
+	Values() []interface{}
+	Fields() []interface{}
+}
@@ -0,0 +1,46 @@
+package product
+
+import (
+	"encoding/json"
+	"example.com/golden/internal/entities"
+	"github.com/google/uuid"
+	"time"
+)
+
+type Product struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (p *Product) TableName() string {
+	return "products"
+}
+
+func (p *Product) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *Product) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Product) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Product) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Product) Columns() []string {
+	return []string{"id", "name", "created_at", "updated_at"}
+}
+
+func (p *Product) Values() []interface{} {
+	return []interface{}{p.ID, p.Name, p.CreatedAt, p.UpdatedAt}
+}
+
+func (p *Product) Fields() []interface{} {
+	return []interface{}{&p.ID, &p.Name, &p.CreatedAt, &p.UpdatedAt}
+}
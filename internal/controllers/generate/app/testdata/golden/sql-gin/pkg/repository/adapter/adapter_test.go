@@ -0,0 +1,279 @@
+package adapter
+
+import (
+	"database/sql"
+	"example.com/golden/pkg/repository/database"
+	"example.com/golden/pkg/repository/query"
+	"example.com/golden/pkg/repository/response"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type Product struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+}
+
+func (p *Product) TableName() string {
+	return "products"
+}
+
+func (p *Product) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Product) Bytes() []byte {
+	return []byte(p.Name)
+}
+
+func (p *Product) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Product) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Product) Columns() []string {
+	return []string{"id", "created_at", "updated_at", "name"}
+}
+
+func (p *Product) Values() []interface{} {
+	return []interface{}{p.ID, p.CreatedAt, p.UpdatedAt, p.Name}
+}
+
+func (p *Product) Fields() []interface{} {
+	return []interface{}{&p.ID, &p.CreatedAt, &p.UpdatedAt, &p.Name}
+}
+
+func GetMemoryDatabase() *sql.DB {
+	connection := database.GetConnection("sqlite3", ":memory:")
+	_, _ = connection.Exec(`CREATE TABLE products (
+		id varchar(255) NOT NULL PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		name varchar(50) NOT NULL UNIQUE
+	)`)
+	return connection
+}
+
+func GetMockProduct() *Product {
+	product := &Product{Name: uuid.New().String()}
+	product.GenerateID()
+	product.SetCreatedAt()
+	product.SetUpdatedAt()
+	return product
+}
+
+func TestMock(t *testing.T) {
+	mock := &Mock{}
+	product := &Product{}
+
+	t.Run("should return expected value in mock of GetLogMode", func(t *testing.T) {
+		mock.On("GetLogMode").Return(true)
+		assert.Equal(t, mock.GetLogMode(), true)
+	})
+	t.Run("should return expected value in mock of SetLogMode", func(t *testing.T) {
+		mock.On("SetLogMode").Return(mock)
+		assert.Equal(t, mock.SetLogMode(true), mock)
+	})
+	t.Run("should return expected value in mock of Connection", func(t *testing.T) {
+		mock.On("Connection").Return(&query.Query{})
+		assert.Equal(t, mock.Connection("product"), &query.Query{})
+	})
+	t.Run("should return expected value in mock of StartTransaction", func(t *testing.T) {
+		mock.On("StartTransaction").Return(mock)
+		assert.Equal(t, mock.StartTransaction(), mock)
+	})
+	t.Run("should return expected value in mock of CommitTransaction", func(t *testing.T) {
+		mock.On("CommitTransaction").Return(&response.Response{})
+		assert.Equal(t, mock.CommitTransaction(), &response.Response{})
+	})
+	t.Run("should return expected value in mock of RollbackTransaction", func(t *testing.T) {
+		mock.On("RollbackTransaction").Return(&response.Response{})
+		assert.Equal(t, mock.RollbackTransaction(), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Find", func(t *testing.T) {
+		mock.On("Find").Return(&response.Response{})
+		assert.Equal(t, mock.Find(&query.Query{}, product), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Create", func(t *testing.T) {
+		mock.On("Create").Return(&response.Response{})
+		assert.Equal(t, mock.Create(product), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Update", func(t *testing.T) {
+		mock.On("Update").Return(&response.Response{})
+		assert.Equal(t, mock.Update(map[string]interface{}{}, map[string]interface{}{}, product.TableName()), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Delete", func(t *testing.T) {
+		mock.On("Delete").Return(&response.Response{})
+		assert.Equal(t, mock.Delete(map[string]interface{}{}, product.TableName()), &response.Response{})
+	})
+	t.Run("should return expected value in mock of Health", func(t *testing.T) {
+		mock.On("Health").Return(true)
+		assert.Equal(t, mock.Health(), true)
+	})
+}
+
+func TestNewAdapter(t *testing.T) {
+	t.Run("should return type of Database", func(t *testing.T) {
+		assert.IsType(t, &Database{}, NewAdapter(&sql.DB{}, "sqlite3"))
+	})
+}
+
+func TestDatabase_SetLogMode_GetLogMode(t *testing.T) {
+	t.Run("should setLog correctly", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		adapter.SetLogMode(true)
+		assert.True(t, adapter.GetLogMode())
+		adapter.SetLogMode(false)
+		assert.False(t, adapter.GetLogMode())
+	})
+}
+
+func TestDatabase_Connection(t *testing.T) {
+	t.Run("should return query of table", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		assert.Equal(t, "products", adapter.Connection("products").TableName())
+	})
+}
+
+func TestDatabase_Health(t *testing.T) {
+	t.Run("should return true when database is connected", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		assert.True(t, adapter.Health())
+	})
+	t.Run("should return false when database is closed", func(t *testing.T) {
+		connection := GetMemoryDatabase()
+		_ = connection.Close()
+		assert.False(t, NewAdapter(connection, "sqlite3").Health())
+	})
+}
+
+func TestDatabase_Find(t *testing.T) {
+	t.Run("should return error when entity is invalid", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		entities := []string{}
+		assert.Equal(t, ErrEntityInvalid, adapter.Find(adapter.Connection("products"), &entities).Error())
+		assert.Equal(t, ErrEntityInvalid, adapter.Find(adapter.Connection("products"), entities).Error())
+	})
+	t.Run("should return error when table not exists", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		entities := []Product{}
+		assert.Error(t, adapter.Find(adapter.Connection("not_exists"), &entities).Error())
+		assert.Error(t, adapter.Find(adapter.Connection("not_exists"), &Product{}).Error())
+	})
+	t.Run("should fill slice of pointers", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		assert.NoError(t, adapter.Create(GetMockProduct()).Error())
+		entities := []*Product{}
+		response := adapter.Find(adapter.Connection("products"), &entities)
+		assert.NoError(t, response.Error())
+		assert.Equal(t, int64(1), response.RowsAffected())
+		assert.Len(t, entities, 1)
+	})
+}
+
+func Test_Create_Read_Update_Delete(t *testing.T) {
+	t.Run("Should run tests to execute Create, Read, Update, Delete", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3").SetLogMode(true)
+		product := GetMockProduct()
+
+		responseCreate := adapter.Create(product)
+		assert.NoError(t, responseCreate.Error())
+		assert.Equal(t, int64(1), responseCreate.RowsAffected())
+
+		entityToCheckFindAll := []Product{}
+		responseFindAll := adapter.Find(adapter.Connection(product.TableName()), &entityToCheckFindAll)
+		assert.NoError(t, responseFindAll.Error())
+		assert.Len(t, entityToCheckFindAll, 1)
+
+		queryFindOne := adapter.Connection(product.TableName()).Where(map[string]interface{}{"id": product.ID})
+		entityToCheckFindOne := Product{}
+		responseFindOne := adapter.Find(queryFindOne, &entityToCheckFindOne)
+		assert.NoError(t, responseFindOne.Error())
+		assert.Equal(t, product.ID, entityToCheckFindOne.ID)
+		assert.Equal(t, product.Name, entityToCheckFindOne.Name)
+
+		nameToUpdate := uuid.New().String()
+		responseUpdate := adapter.Update(map[string]interface{}{"id": product.ID},
+			map[string]interface{}{"name": nameToUpdate}, product.TableName())
+		assert.NoError(t, responseUpdate.Error())
+		assert.Equal(t, int64(1), responseUpdate.RowsAffected())
+
+		queryFindOneUpdate := adapter.Connection(product.TableName()).Where(map[string]interface{}{"id": product.ID})
+		entityToCheckUpdate := Product{}
+		assert.NoError(t, adapter.Find(queryFindOneUpdate, &entityToCheckUpdate).Error())
+		assert.Equal(t, nameToUpdate, entityToCheckUpdate.Name)
+
+		responseDelete := adapter.Delete(map[string]interface{}{"id": product.ID}, product.TableName())
+		assert.NoError(t, responseDelete.Error())
+		assert.Equal(t, int64(1), responseDelete.RowsAffected())
+
+		queryFindOneDelete := adapter.Connection(product.TableName()).Where(map[string]interface{}{"id": product.ID})
+		responseFindOneDelete := adapter.Find(queryFindOneDelete, &Product{})
+		assert.Equal(t, ErrRecordNotFound, responseFindOneDelete.Error())
+	})
+	t.Run("Should return error when create duplicated entity", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		product := GetMockProduct()
+		assert.NoError(t, adapter.Create(product).Error())
+		assert.Error(t, adapter.Create(product).Error())
+	})
+}
+
+func Test_Transaction(t *testing.T) {
+	t.Run("Should persist entity when commit transaction", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		product := GetMockProduct()
+
+		transaction := adapter.StartTransaction()
+		assert.NoError(t, transaction.Create(product).Error())
+		assert.NoError(t, transaction.CommitTransaction().Error())
+
+		query := adapter.Connection(product.TableName()).Where(map[string]interface{}{"id": product.ID})
+		assert.NoError(t, adapter.Find(query, &Product{}).Error())
+	})
+	t.Run("Should discard entity when rollback transaction", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		product := GetMockProduct()
+
+		transaction := adapter.StartTransaction()
+		assert.NoError(t, transaction.Create(product).Error())
+		assert.NoError(t, transaction.RollbackTransaction().Error())
+
+		query := adapter.Connection(product.TableName()).Where(map[string]interface{}{"id": product.ID})
+		assert.Equal(t, ErrRecordNotFound, adapter.Find(query, &Product{}).Error())
+	})
+	t.Run("Should return error when commit or rollback without transaction", func(t *testing.T) {
+		adapter := NewAdapter(GetMemoryDatabase(), "sqlite3")
+		assert.Equal(t, ErrTransactionNotStarted, adapter.CommitTransaction().Error())
+		assert.Equal(t, ErrTransactionNotStarted, adapter.RollbackTransaction().Error())
+	})
+	t.Run("Should return error of begin when database is closed", func(t *testing.T) {
+		connection := GetMemoryDatabase()
+		_ = connection.Close()
+		transaction := NewAdapter(connection, "sqlite3").StartTransaction()
+		assert.Error(t, transaction.CommitTransaction().Error())
+		assert.NotEqual(t, ErrTransactionNotStarted, transaction.RollbackTransaction().Error())
+	})
+	t.Run("Should return error of begin in the operations of the transaction when begin fails", func(t *testing.T) {
+		connection := GetMemoryDatabase()
+		_ = connection.Close()
+		transaction := NewAdapter(connection, "sqlite3").StartTransaction()
+		product := GetMockProduct()
+		condition := map[string]interface{}{"id": product.ID}
+		query := transaction.Connection(product.TableName()).Where(condition)
+
+		assert.Error(t, transaction.Create(product).Error())
+		assert.Error(t, transaction.Find(query, &Product{}).Error())
+		assert.Error(t, transaction.Find(query, &[]Product{}).Error())
+		values := map[string]interface{}{"name": "other"}
+		assert.Error(t, transaction.Update(condition, values, product.TableName()).Error())
+		assert.Error(t, transaction.Delete(condition, product.TableName()).Error())
+	})
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestQuery_Select(t *testing.T) {
+	t.Run("Should return select without conditions", func(t *testing.T) {
+		statement, args := NewQuery("sqlite3", "products").Select([]string{"id", "name"})
+		assert.Equal(t, "SELECT id, name FROM products", statement)
+		assert.Empty(t, args)
+	})
+	t.Run("Should return select with conditions sorted and limit", func(t *testing.T) {
+		statement, args := NewQuery("postgres", "products").
+			Where(map[string]interface{}{"name": "example", "id": 1}).
+			Limit(1).
+			Select([]string{"id"})
+		assert.Equal(t, "SELECT id FROM products WHERE id = $1 AND name = $2 LIMIT 1", statement)
+		assert.Equal(t, []interface{}{1, "example"}, args)
+	})
+	t.Run("Should return select with limit of sql server", func(t *testing.T) {
+		statement, _ := NewQuery("mssql", "products").Limit(1).Select([]string{"id"})
+		assert.Equal(t, "SELECT id FROM products ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 1 ROWS ONLY", statement)
+	})
+}
+
+func TestQuery_Insert(t *testing.T) {
+	t.Run("Should return insert with placeholders of dialect", func(t *testing.T) {
+		statement, args := NewQuery("mssql", "products").Insert([]string{"id", "name"}, []interface{}{1, "example"})
+		assert.Equal(t, "INSERT INTO products (id, name) VALUES (@p1, @p2)", statement)
+		assert.Equal(t, []interface{}{1, "example"}, args)
+	})
+}
+
+func TestQuery_Update(t *testing.T) {
+	t.Run("Should return update with values before conditions", func(t *testing.T) {
+		statement, args := NewQuery("postgres", "products").
+			Where(map[string]interface{}{"id": 1}).
+			Update(map[string]interface{}{"name": "example", "updated_at": "now"})
+		assert.Equal(t, "UPDATE products SET name = $1, updated_at = $2 WHERE id = $3", statement)
+		assert.Equal(t, []interface{}{"example", "now", 1}, args)
+	})
+}
+
+func TestQuery_Delete(t *testing.T) {
+	t.Run("Should return delete with conditions", func(t *testing.T) {
+		statement, args := NewQuery("mysql", "products").Where(map[string]interface{}{"id": 1}).Delete()
+		assert.Equal(t, "DELETE FROM products WHERE id = ?", statement)
+		assert.Equal(t, []interface{}{1}, args)
+	})
+}
+
+func TestQuery_TableName(t *testing.T) {
+	t.Run("Should return table name", func(t *testing.T) {
+		assert.Equal(t, "products", NewQuery("mysql", "products").TableName())
+	})
+}
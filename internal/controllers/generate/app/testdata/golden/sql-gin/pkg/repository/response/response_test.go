@@ -0,0 +1,27 @@
+package response
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewDefaultResponse(t *testing.T) {
+	t.Run("should return equals type when create new default response", func(t *testing.T) {
+		assert.IsType(t, &Response{}, NewDefaultResponse(nil, 0))
+	})
+}
+
+func TestDefaultResponse_RowsAffected(t *testing.T) {
+	t.Run("should return equals number of rowsAffected", func(t *testing.T) {
+		assert.Equal(t, NewDefaultResponse(nil, 1).RowsAffected(), int64(1))
+	})
+}
+
+func TestDefaultResponse_Error(t *testing.T) {
+	t.Run("should return equals error", func(t *testing.T) {
+		err := errors.New("error when find data")
+
+		assert.Equal(t, NewDefaultResponse(err, 0).Error(), errors.New("error when find data"))
+	})
+}
@@ -0,0 +1,122 @@
+package lintarch
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/entities/architecture"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConfigFile is the file of the dependency matrix read in the [PATH] of the project when exists
+const ConfigFile = ".go-generator-arch.json"
+
+var ignoredFolders = map[string]bool{"vendor": true, "testdata": true, "node_modules": true}
+
+type Interface interface {
+	Lint(pathProject string, config *architecture.Config, tests bool) ([]architecture.Violation, error)
+	LoadConfig(pathConfig string) (*architecture.Config, error)
+	WriteConfig(pathConfig string) error
+}
+
+type LintArch struct{}
+
+func NewLintArch() Interface {
+	return &LintArch{}
+}
+
+// Lint return the imports of the files of the layers of the project that not respect the dependency matrix, the
+// files of tests are checked only when tests is true
+func (l *LintArch) Lint(pathProject string, config *architecture.Config,
+	tests bool) (violations []architecture.Violation, err error) {
+	module, err := gomod.GetModulePath(pathProject)
+	if err != nil || module == "" {
+		return nil, errors.ErrLintArchModuleNotFound
+	}
+	err = filepath.Walk(pathProject, func(current string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if current != pathProject && (ignoredFolders[info.Name()] || strings.HasPrefix(info.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(current) != ".go" || (!tests && strings.HasSuffix(current, "_test.go")) {
+			return nil
+		}
+		relative, _ := filepath.Rel(pathProject, current)
+		fileViolations, err := lintFile(current, filepath.ToSlash(relative), module, config)
+		violations = append(violations, fileViolations...)
+		return err
+	})
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].File != violations[j].File {
+			return violations[i].File < violations[j].File
+		}
+		return violations[i].Line < violations[j].Line
+	})
+	return violations, err
+}
+
+func lintFile(path, relative, module string, config *architecture.Config) ([]architecture.Violation, error) {
+	layer := config.GetLayer(filepath.ToSlash(filepath.Dir(relative)))
+	if _, ok := config.Rules[layer]; !ok {
+		return nil, nil
+	}
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, path, nil, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	violations := []architecture.Violation{}
+	for _, importSpec := range file.Imports {
+		importPath, _ := strconv.Unquote(importSpec.Path.Value)
+		reason := config.GetReasonOfExternal(layer, importPath)
+		if importPath == module || strings.HasPrefix(importPath, module+"/") {
+			reason = config.GetReasonOfProject(layer, config.GetLayer(strings.TrimPrefix(importPath, module+"/")))
+		}
+		if reason != "" {
+			line := fileSet.Position(importSpec.Pos()).Line
+			violations = append(violations, architecture.NewViolation(relative, line, layer, importPath, reason))
+		}
+	}
+	return violations, nil
+}
+
+// LoadConfig return the dependency matrix of the file, the default matrix is returned when the file not exists
+func (l *LintArch) LoadConfig(pathConfig string) (*architecture.Config, error) {
+	content, err := ioutil.ReadFile(pathConfig)
+	if os.IsNotExist(err) {
+		return architecture.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	config := &architecture.Config{}
+	if err := json.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("%s: %w", pathConfig, err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", pathConfig, err)
+	}
+	return config, nil
+}
+
+// WriteConfig write the default dependency matrix in the file, to be changed by the project
+func (l *LintArch) WriteConfig(pathConfig string) error {
+	content, err := json.MarshalIndent(architecture.NewConfig(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(pathConfig, append(content, '\n'), 0600)
+}
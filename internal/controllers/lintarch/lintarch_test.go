@@ -0,0 +1,79 @@
+package lintarch
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/entities/architecture"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/testutil"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLintArch_Lint(t *testing.T) {
+	dir := testutil.CopyTemplate(t)
+	defer os.RemoveAll(dir)
+	t.Run("Should return without violations the template", func(t *testing.T) {
+		violations, err := NewLintArch().Lint(dir, architecture.NewConfig(), false)
+		assert.NoError(t, err)
+		assert.Empty(t, violations)
+	})
+	t.Run("Should return the violations of the files of tests when tests is true", func(t *testing.T) {
+		violations, err := NewLintArch().Lint(dir, architecture.NewConfig(), true)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, violations)
+		for _, violation := range violations {
+			assert.True(t, strings.HasSuffix(violation.File, "_test.go"))
+		}
+	})
+	t.Run("Should return the violations with the file and the line of the import", func(t *testing.T) {
+		controller := filepath.Join(dir, "internal", "controllers", "product", "product.go")
+		content, err := ioutil.ReadFile(controller)
+		assert.NoError(t, err)
+		changed := strings.Replace(string(content), "import (\n", "import (\n\t\"gorm.io/gorm\"\n\t\""+
+			testutil.TemplateModule+"/internal/handlers\"\n", 1)
+		assert.NoError(t, ioutil.WriteFile(controller, []byte(changed), 0600))
+		violations, err := NewLintArch().Lint(dir, architecture.NewConfig(), false)
+		assert.NoError(t, err)
+		assert.Equal(t, []architecture.Violation{
+			architecture.NewViolation("internal/controllers/product/product.go", 4, "controllers", "gorm.io/gorm",
+				"controllers can not import gorm.io"),
+			architecture.NewViolation("internal/controllers/product/product.go", 5, "controllers",
+				testutil.TemplateModule+"/internal/handlers",
+				"controllers can not import handlers, allowed: adapter, entities, rules"),
+		}, violations)
+	})
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		_, err := NewLintArch().Lint(filepath.Join(dir, "internal"), architecture.NewConfig(), false)
+		assert.Equal(t, errors.ErrLintArchModuleNotFound, err)
+	})
+}
+
+func TestLintArch_Config(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lintarch")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pathConfig := filepath.Join(dir, ConfigFile)
+	t.Run("Should return the default config when the file not exists", func(t *testing.T) {
+		config, err := NewLintArch().LoadConfig(pathConfig)
+		assert.NoError(t, err)
+		assert.Equal(t, architecture.NewConfig(), config)
+	})
+	t.Run("Should write and load the default config", func(t *testing.T) {
+		assert.NoError(t, NewLintArch().WriteConfig(pathConfig))
+		config, err := NewLintArch().LoadConfig(pathConfig)
+		assert.NoError(t, err)
+		assert.Equal(t, architecture.NewConfig(), config)
+	})
+	t.Run("Should return error when the config is invalid", func(t *testing.T) {
+		content := `{"layers": {"handlers": "internal/handlers"}, "rules": {"handlers": {"allow": ["services"]}}}`
+		assert.NoError(t, ioutil.WriteFile(pathConfig, []byte(content), 0600))
+		_, err := NewLintArch().LoadConfig(pathConfig)
+		assert.Error(t, err)
+		assert.NoError(t, ioutil.WriteFile(pathConfig, []byte("{"), 0600))
+		_, err = NewLintArch().LoadConfig(pathConfig)
+		assert.Error(t, err)
+	})
+}
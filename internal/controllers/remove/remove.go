@@ -0,0 +1,296 @@
+package remove
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/folders"
+	"github.com/wilian746/go-generator/internal/utils/goast"
+	"github.com/wilian746/go-generator/internal/utils/gomod"
+	"github.com/wilian746/go-generator/internal/utils/inflection"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const timestampLayout = "20060102150405"
+
+// resourceFolders are the folders with a package by resource, the swagger entities and the tests are removed with them
+var resourceFolders = []folders.Folders{
+	folders.InternalEntities,
+	folders.InternalRules,
+	folders.InternalControllers,
+	folders.InternalHandlers,
+}
+
+var unsupportedFolders = []string{"internal/graphql", "internal/grpc"}
+
+var ignoredFolders = map[string]bool{"vendor": true, "testdata": true, "node_modules": true}
+
+type Interface interface {
+	GetTable(pathProject, name string) (string, error)
+	GetMigrationsFolders(pathProject string) []string
+	RemoveResource(pathProject, name string) ([]string, error)
+	GenerateDropMigrations(pathProject, table string) ([]string, error)
+}
+
+type Remove struct {
+	now func() time.Time
+}
+
+func NewRemove() Interface {
+	return &Remove{now: time.Now}
+}
+
+// GetTable return the table of the method TableName of the entity of the resource, or the name of the resource in
+// plural when the entity not have the method
+func (r *Remove) GetTable(pathProject, name string) (string, error) {
+	resource := inflection.NewName(name)
+	if resource.IsEmpty() {
+		return "", errors.ErrResourceInvalid
+	}
+	if len(getPackages(pathProject, resource)) == 0 {
+		return "", errors.ErrRemoveResourceNotFound
+	}
+	packages, _ := parser.ParseDir(token.NewFileSet(),
+		filepath.Join(pathProject, string(folders.InternalEntities), resource.Package()), nil, 0)
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			if table := getTableName(file); table != "" {
+				return table, nil
+			}
+		}
+	}
+	return resource.Plural().Snake(), nil
+}
+
+// GetMigrationsFolders return the folders of the dialects of the migrations of the project, like migrations/mysql
+func (r *Remove) GetMigrationsFolders(pathProject string) (migrations []string) {
+	infos, _ := ioutil.ReadDir(filepath.Join(pathProject, string(folders.Migrations)))
+	for _, info := range infos {
+		if info.IsDir() {
+			migrations = append(migrations, filepath.Join(pathProject, string(folders.Migrations), info.Name()))
+		}
+	}
+	return migrations
+}
+
+// RemoveResource unregister the routes of the resource of the internal/routes and the AutoMigrate of the cmd/main.go
+// and delete the packages of the resource, it return the files changed and the folders deleted
+func (r *Remove) RemoveResource(pathProject, name string) ([]string, error) {
+	resource := inflection.NewName(name)
+	if resource.IsEmpty() {
+		return nil, errors.ErrResourceInvalid
+	}
+	module, err := gomod.GetModulePath(pathProject)
+	if err != nil || module == "" {
+		return nil, errors.ErrRemoveModuleNotFound
+	}
+	for _, folder := range unsupportedFolders {
+		if _, err := os.Stat(filepath.Join(pathProject, folder)); err == nil {
+			return nil, errors.ErrRemoveResourceNotSupported
+		}
+	}
+	packages := getPackages(pathProject, resource)
+	if len(packages) == 0 {
+		return nil, errors.ErrRemoveResourceNotFound
+	}
+	if files := getFilesUsing(pathProject, module, packages); len(files) > 0 {
+		return nil, fmt.Errorf("%w: %s", errors.ErrRemoveResourceInUse, strings.Join(files, ", "))
+	}
+	changed, err := unregister(pathProject, module, resource)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range packages {
+		if err := os.RemoveAll(filepath.Join(pathProject, pkg)); err != nil {
+			return nil, err
+		}
+		changed = append(changed, filepath.Join(pathProject, pkg))
+	}
+	return changed, nil
+}
+
+// GenerateDropMigrations write in each folder of the migrations a migration to drop the table, the down of the
+// migration is the up of the migration that created the table, so the historical migrations are kept
+func (r *Remove) GenerateDropMigrations(pathProject, table string) (generated []string, err error) {
+	prefix := r.now().UTC().Format(timestampLayout) + "_drop_table_" + table
+	for _, folder := range r.GetMigrationsFolders(pathProject) {
+		up, down := getDropTable(filepath.Base(folder), table), []byte(nil)
+		if content, err := readCreateTable(folder, table, "down"); err == nil {
+			up = content
+		}
+		if content, err := readCreateTable(folder, table, "up"); err == nil {
+			down = content
+		}
+		for index, content := range [][]byte{up, down} {
+			if content == nil {
+				continue
+			}
+			file := filepath.Join(folder, prefix+[]string{".up.sql", ".down.sql"}[index])
+			if err := ioutil.WriteFile(file, content, 0600); err != nil {
+				return nil, err
+			}
+			generated = append(generated, file)
+		}
+	}
+	return generated, nil
+}
+
+func unregister(pathProject, module string, resource inflection.Name) (changed []string, err error) {
+	handlers := module + "/" + path.Join(string(folders.InternalHandlers), resource.Package())
+	entities := module + "/" + path.Join(string(folders.InternalEntities), resource.Package())
+	routes, _ := filepath.Glob(filepath.Join(pathProject, string(folders.InternalRoutes), "*.go"))
+	for _, file := range routes {
+		ok, err := editFile(file, func(editor *goast.Editor) *goast.Editor {
+			if strings.HasSuffix(file, "_test.go") {
+				return editor.RemoveTests(getTitleRegex(resource))
+			}
+			return editor.RemoveCalls("SetRouters", "Router"+resource.Pascal()).
+				RemoveFunc("Router" + resource.Pascal()).RemoveImport(handlers)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if ok {
+			changed = append(changed, file)
+		}
+	}
+	main := filepath.Join(pathProject, string(folders.Cmd), "main.go")
+	ok, err := editFile(main, func(editor *goast.Editor) *goast.Editor {
+		return editor.RemoveStmtsUsing("main", resource.Package(), resource.Pascal()).
+			RemoveImportIfUnused(entities, resource.Package())
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", main, err)
+	}
+	if ok {
+		changed = append(changed, main)
+	}
+	return changed, nil
+}
+
+// editFile write the changes of the editor in the file and return if the file was changed, files not found are ignored
+func editFile(file string, change func(editor *goast.Editor) *goast.Editor) (bool, error) {
+	content, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	edited, err := change(goast.NewEditor(content)).Bytes()
+	if err != nil || bytes.Equal(content, edited) {
+		return false, err
+	}
+	return true, ioutil.WriteFile(file, edited, 0600)
+}
+
+// getTitleRegex return the regex of the titles of the tests of the resource, like "Should call shipment item"
+func getTitleRegex(resource inflection.Name) *regexp.Regexp {
+	forms := []string{}
+	for _, form := range []string{resource.Words(), resource.Kebab(), resource.Snake(), resource.Package()} {
+		forms = append(forms, regexp.QuoteMeta(form))
+	}
+	return regexp.MustCompile(`(?i)\b(` + strings.Join(forms, "|") + `)\b`)
+}
+
+func getPackages(pathProject string, resource inflection.Name) (packages []string) {
+	for _, folder := range resourceFolders {
+		pkg := path.Join(string(folder), resource.Package())
+		if info, err := os.Stat(filepath.Join(pathProject, pkg)); err == nil && info.IsDir() {
+			packages = append(packages, pkg)
+		}
+	}
+	return packages
+}
+
+// getFilesUsing return the files that import the packages and not are removed or changed with the resource
+func getFilesUsing(pathProject, module string, packages []string) (files []string) {
+	imports := map[string]bool{}
+	for _, pkg := range packages {
+		imports[module+"/"+pkg] = true
+	}
+	_ = filepath.Walk(pathProject, func(current string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relative, _ := filepath.Rel(pathProject, current)
+		relative = filepath.ToSlash(relative)
+		if info.IsDir() {
+			if current != pathProject && (ignoredFolders[info.Name()] || strings.HasPrefix(info.Name(), ".") ||
+				imports[module+"/"+relative]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(current) != ".go" || path.Dir(relative) == string(folders.InternalRoutes) ||
+			relative == path.Join(string(folders.Cmd), "main.go") {
+			return nil
+		}
+		if isImporting(current, imports) {
+			files = append(files, relative)
+		}
+		return nil
+	})
+	return files
+}
+
+func isImporting(file string, imports map[string]bool) bool {
+	parsed, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ImportsOnly)
+	if err != nil {
+		return false
+	}
+	for _, spec := range parsed.Imports {
+		if value, _ := strconv.Unquote(spec.Path.Value); imports[value] {
+			return true
+		}
+	}
+	return false
+}
+
+func getTableName(file *ast.File) string {
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Name.Name != "TableName" || function.Body == nil || len(function.Body.List) != 1 {
+			continue
+		}
+		ret, ok := function.Body.List[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			continue
+		}
+		if literal, ok := ret.Results[0].(*ast.BasicLit); ok && literal.Kind == token.STRING {
+			table, _ := strconv.Unquote(literal.Value)
+			return table
+		}
+	}
+	return ""
+}
+
+func readCreateTable(folder, table, direction string) ([]byte, error) {
+	files, _ := filepath.Glob(filepath.Join(folder, "*_create_table_"+table+"."+direction+".sql"))
+	if len(files) == 0 {
+		return nil, os.ErrNotExist
+	}
+	return ioutil.ReadFile(files[len(files)-1])
+}
+
+// getDropTable return the drop of the table with the quotes of the dialect of the folder of the migrations
+func getDropTable(dialect, table string) []byte {
+	switch dialect {
+	case "mysql":
+		return []byte("BEGIN;\n\nDROP TABLE IF EXISTS `" + table + "`;\n\nCOMMIT;\n")
+	case "sqlserver":
+		return []byte("BEGIN TRANSACTION;\n\nDROP TABLE IF EXISTS [" + table + "];\n\nCOMMIT;\n")
+	default:
+		return []byte("BEGIN;\n\nDROP TABLE IF EXISTS \"" + table + "\";\n\nCOMMIT;\n")
+	}
+}
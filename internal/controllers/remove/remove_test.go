@@ -0,0 +1,138 @@
+package remove
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/testutil"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func readFile(t *testing.T, elem ...string) string {
+	content, err := ioutil.ReadFile(filepath.Join(elem...))
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestRemove_GetTable(t *testing.T) {
+	dir := testutil.CopyTemplate(t)
+	defer os.RemoveAll(dir)
+	t.Run("Should return the table of the method TableName of the entity", func(t *testing.T) {
+		table, err := NewRemove().GetTable(dir, "Product")
+		assert.NoError(t, err)
+		assert.Equal(t, "products", table)
+	})
+	t.Run("Should return the resource in plural when entity not have the method TableName", func(t *testing.T) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "internal", "rules", "shipmentitem"), os.ModePerm))
+		table, err := NewRemove().GetTable(dir, "shipment-item")
+		assert.NoError(t, err)
+		assert.Equal(t, "shipment_items", table)
+	})
+	t.Run("Should return error when resource not exists", func(t *testing.T) {
+		_, err := NewRemove().GetTable(dir, "invoice")
+		assert.Equal(t, errors.ErrRemoveResourceNotFound, err)
+	})
+	t.Run("Should return error when name is empty", func(t *testing.T) {
+		_, err := NewRemove().GetTable(dir, "_")
+		assert.Equal(t, errors.ErrResourceInvalid, err)
+	})
+}
+
+func TestRemove_RemoveResource(t *testing.T) {
+	t.Run("Should remove the packages and unregister the routes and the AutoMigrate", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		changed, err := NewRemove().RemoveResource(dir, "product")
+		assert.NoError(t, err)
+		assert.Contains(t, changed, filepath.Join(dir, "cmd", "main.go"))
+		assert.Contains(t, changed, filepath.Join(dir, "internal", "routes", "routes_gin.go"))
+		for _, folder := range []string{"entities", "rules", "controllers", "handlers"} {
+			assert.Contains(t, changed, filepath.Join(dir, "internal", folder, "product"))
+			assert.NoDirExists(t, filepath.Join(dir, "internal", folder, "product"))
+		}
+		for _, file := range []string{"routes.go", "routes_gin.go", "routes_echo.go", "routes_stdlib.go"} {
+			content := readFile(t, dir, "internal", "routes", file)
+			assert.NotContains(t, content, "Product", file)
+			assert.Contains(t, content, "RouterHealth(repository)", file)
+		}
+		tests := readFile(t, dir, "internal", "routes", "routes_test.go")
+		assert.NotContains(t, tests, "handler of product")
+		assert.Contains(t, tests, "Should pass request by middleware of cors")
+		main := readFile(t, dir, "cmd", "main.go")
+		assert.NotContains(t, main, "product")
+		assert.NotContains(t, main, "AutoMigrate")
+		assert.Contains(t, main, "connection := database.GetConnection(configs.Dialect, configs.DatabaseURI)")
+		_, err = os.Stat(filepath.Join(dir, "migrations", "mysql", "20200607175350_create_table_products.up.sql"))
+		assert.NoError(t, err)
+	})
+	t.Run("Should not remove the resource imported by other packages", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "internal", "entities", "order"), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "internal", "entities", "order", "order.go"),
+			[]byte("package order\n\nimport _ \""+testutil.TemplateModule+"/internal/entities/product\"\n"), 0600))
+		_, err := NewRemove().RemoveResource(dir, "product")
+		assert.Contains(t, err.Error(), errors.ErrRemoveResourceInUse.Error())
+		assert.Contains(t, err.Error(), "internal/entities/order/order.go")
+		assert.DirExists(t, filepath.Join(dir, "internal", "entities", "product"))
+	})
+	t.Run("Should return error when project not have go.mod", func(t *testing.T) {
+		_, err := NewRemove().RemoveResource(filepath.Join("..", "..", "..", "pkg", "standart-gorm"), "product")
+		assert.Equal(t, errors.ErrRemoveModuleNotFound, err)
+	})
+	t.Run("Should return error when project is of grpc", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "internal", "grpc"), os.ModePerm))
+		_, err := NewRemove().RemoveResource(dir, "product")
+		assert.Equal(t, errors.ErrRemoveResourceNotSupported, err)
+	})
+	t.Run("Should return error when resource not exists", func(t *testing.T) {
+		dir := testutil.CopyTemplate(t)
+		defer os.RemoveAll(dir)
+		_, err := NewRemove().RemoveResource(dir, "invoice")
+		assert.Equal(t, errors.ErrRemoveResourceNotFound, err)
+	})
+}
+
+func TestRemove_GenerateDropMigrations(t *testing.T) {
+	dir := testutil.CopyTemplate(t)
+	defer os.RemoveAll(dir)
+	remove := &Remove{now: func() time.Time {
+		return time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	}}
+	t.Run("Should generate the drop of the table with the down of the create table", func(t *testing.T) {
+		generated, err := remove.GenerateDropMigrations(dir, "products")
+		assert.NoError(t, err)
+		assert.Len(t, generated, 6)
+		for _, dialect := range []string{"mysql", "postgres", "sqlserver"} {
+			folder := filepath.Join(dir, "migrations", dialect)
+			assert.Equal(t, readFile(t, folder, "20200607175350_create_table_products.down.sql"),
+				readFile(t, folder, "20210102030405_drop_table_products.up.sql"))
+			assert.Equal(t, readFile(t, folder, "20200607175350_create_table_products.up.sql"),
+				readFile(t, folder, "20210102030405_drop_table_products.down.sql"))
+		}
+	})
+	t.Run("Should generate only the drop of the table when create table not exists", func(t *testing.T) {
+		generated, err := remove.GenerateDropMigrations(dir, "invoices")
+		assert.NoError(t, err)
+		assert.Len(t, generated, 3)
+		assert.Contains(t, readFile(t, dir, "migrations", "mysql", "20210102030405_drop_table_invoices.up.sql"),
+			"DROP TABLE IF EXISTS `invoices`;")
+		assert.Contains(t, readFile(t, dir, "migrations", "sqlserver", "20210102030405_drop_table_invoices.up.sql"),
+			"DROP TABLE IF EXISTS [invoices];")
+		assert.Contains(t, readFile(t, dir, "migrations", "postgres", "20210102030405_drop_table_invoices.up.sql"),
+			`DROP TABLE IF EXISTS "invoices";`)
+	})
+	t.Run("Should not generate when project not have migrations", func(t *testing.T) {
+		empty, err := ioutil.TempDir("", "remove")
+		assert.NoError(t, err)
+		defer os.RemoveAll(empty)
+		generated, err := remove.GenerateDropMigrations(empty, "products")
+		assert.NoError(t, err)
+		assert.Empty(t, generated)
+	})
+}
@@ -0,0 +1,113 @@
+package typescript
+
+// templateClient is the client in typescript with the interfaces of the definitions and one method by operation of
+// the spec, the requests use the fetch of the browser or of the options
+const templateClient = `// Code generated by go-generator typescript. DO NOT EDIT.
+
+export const BasePath = "{{.BasePath}}";
+
+/** Envelope is the body of all responses of the API, with the status and the result of the route */
+export interface Envelope<T> {
+  status: number;
+  result: T;
+}
+{{- range .Interfaces}}
+
+export interface {{.Name}} {
+{{- range .Properties}}
+  {{.Name}}: {{.Type}};
+{{- end}}
+}
+{{- end}}
+{{- if not .HasResponseError}}
+
+export interface ResponseError {
+  status: number;
+  result: { error: string };
+}
+{{- end}}
+
+/** ApiError is thrown when the API respond with status of error, the subclasses are the status 400, 404 and 500 */
+export class ApiError extends Error {
+  readonly status: number;
+  readonly response?: ResponseError;
+
+  constructor(status: number, response?: ResponseError) {
+    super(response?.result?.error || ` + "`" + `request failed with status ${status}` + "`" + `);
+    this.status = status;
+    this.response = response;
+    this.name = new.target.name;
+    Object.setPrototypeOf(this, new.target.prototype);
+  }
+}
+
+export class BadRequestError extends ApiError {}
+
+export class NotFoundError extends ApiError {}
+
+export class InternalServerError extends ApiError {}
+
+function newApiError(status: number, response?: ResponseError): ApiError {
+  switch (status) {
+    case 400:
+      return new BadRequestError(status, response);
+    case 404:
+      return new NotFoundError(status, response);
+    case 500:
+      return new InternalServerError(status, response);
+    default:
+      return new ApiError(status, response);
+  }
+}
+
+export interface ClientOptions {
+  fetch?: typeof fetch;
+  headers?: Record<string, string>;
+}
+
+export class Client {
+  private readonly host: string;
+  private readonly options: ClientOptions;
+
+  /** host is the address of the API running, like http://localhost:8080 */
+  constructor(host: string, options: ClientOptions = {}) {
+    this.host = host.replace(/\/+$/, "");
+    this.options = options;
+  }
+{{- range .Methods}}
+
+  /** {{if .Summary}}{{.Summary}}: {{end}}{{.Method}} {{.Route}} */
+  {{.Name}}({{.Params}}): Promise<{{.ReturnType}}> {
+    return this.request<{{.ReturnType}}>("{{.Method}}", ` + "`" + `{{.Path}}` + "`" + `, {{.Body}}, {{.Query}}, signal);
+  }
+{{- end}}
+
+  private async request<T>(
+    method: string,
+    path: string,
+    body?: unknown,
+    query?: Record<string, unknown>,
+    signal?: AbortSignal,
+  ): Promise<T> {
+    const search = new URLSearchParams();
+    Object.entries(query ?? {}).forEach(([key, value]) => {
+      if (value !== undefined && value !== null) {
+        search.append(key, String(value));
+      }
+    });
+    const url = ` + "`" + `${this.host}${BasePath}${path}${search.toString() ? "?" + search.toString() : ""}` + "`" + `;
+    const response = await (this.options.fetch ?? fetch)(url, {
+      method,
+      headers: { "Content-Type": "application/json", ...this.options.headers },
+      body: body === undefined ? undefined : JSON.stringify(body),
+      signal,
+    });
+    const text = await response.text();
+    const content = text ? JSON.parse(text) : undefined;
+    if (!response.ok) {
+      throw newApiError(response.status, content as ResponseError);
+    }
+    return content as T;
+  }
+}
+`
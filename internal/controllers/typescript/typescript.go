@@ -0,0 +1,356 @@
+package typescript
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/wilian746/go-generator/internal/entities/swagger"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"github.com/wilian746/go-generator/internal/enums/files"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// DefaultBasePath is the BasePath of the routes of the templates, used when the spec not have basePath
+const DefaultBasePath = "/api/v1"
+
+// ResponseErrorName is the interface of the errors of the API, declared by the client when not found in the spec
+const ResponseErrorName = "ResponseError"
+
+var (
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	wordsRegex      = regexp.MustCompile(`[^A-Za-z0-9]+`)
+	pathParamRegex  = regexp.MustCompile(`{([^}]+)}`)
+	methodsOrder    = []string{"get", "post", "put", "patch", "delete", "head", "options"}
+)
+
+var templates = template.Must(template.New("typescript").Parse(templateClient))
+
+type Interface interface {
+	Generate(pathProject, out, basePath string) error
+}
+
+type TypeScript struct {
+	spec  *swagger.Swagger
+	names map[string]string
+}
+
+func NewTypeScript() Interface {
+	return &TypeScript{}
+}
+
+// Generate write in the out the client in typescript of the routes of docs/swagger.json of the project, the basePath
+// is used when the spec not have it
+func (t *TypeScript) Generate(pathProject, out, basePath string) error {
+	content, err := ioutil.ReadFile(filepath.Join(pathProject, string(files.DocsSwaggerJSON)))
+	if err != nil {
+		return errors.ErrTypeScriptSwaggerNotFound
+	}
+	if t.spec, err = swagger.NewSwagger(content); err != nil {
+		return fmt.Errorf("%s: %w", files.DocsSwaggerJSON, err)
+	}
+	if t.spec.BasePath != "" {
+		basePath = t.spec.BasePath
+	}
+	client, err := t.render(basePath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(out, client, 0600)
+}
+
+func (t *TypeScript) render(basePath string) ([]byte, error) {
+	t.names = getInterfacesNames(t.spec.Definitions)
+	interfaces := t.getInterfaces()
+	_, hasResponseError := interfaces[ResponseErrorName]
+	var buffer bytes.Buffer
+	err := templates.Execute(&buffer, map[string]interface{}{
+		"BasePath":         basePath,
+		"Interfaces":       getSortedValues(interfaces),
+		"HasResponseError": hasResponseError,
+		"Methods":          t.getMethods(),
+	})
+	return buffer.Bytes(), err
+}
+
+type tsInterface struct {
+	Name       string
+	Properties []tsProperty
+}
+
+type tsProperty struct {
+	Name string
+	Type string
+}
+
+type tsMethod struct {
+	Name       string
+	Summary    string
+	Params     string
+	ReturnType string
+	Method     string
+	Route      string
+	Path       string
+	Body       string
+	Query      string
+}
+
+// getInterfacesNames return the name of the interface of each definition without the package, like ResponseError
+// to http.ResponseError, the package is kept only when two definitions have the same name
+func getInterfacesNames(definitions map[string]*swagger.Schema) map[string]string {
+	count := map[string]int{}
+	for definition := range definitions {
+		count[getDefinitionName(definition)]++
+	}
+	names := map[string]string{}
+	for definition := range definitions {
+		name := getDefinitionName(definition)
+		if count[name] > 1 {
+			name = toPascal(definition)
+		}
+		names[definition] = name
+	}
+	return names
+}
+
+func getDefinitionName(definition string) string {
+	return toPascal(definition[strings.LastIndex(definition, ".")+1:])
+}
+
+func (t *TypeScript) getInterfaces() map[string]tsInterface {
+	interfaces := map[string]tsInterface{}
+	for definition, schema := range t.spec.Definitions {
+		name := t.names[definition]
+		interfaces[name] = tsInterface{Name: name, Properties: t.getProperties(schema)}
+	}
+	return interfaces
+}
+
+func (t *TypeScript) getProperties(schema *swagger.Schema) (properties []tsProperty) {
+	for _, name := range getSortedKeys(schema.Properties) {
+		property := name
+		if !identifierRegex.MatchString(name) {
+			property = fmt.Sprintf("%q", name)
+		}
+		properties = append(properties, tsProperty{Name: property, Type: t.getType(schema.Properties[name])})
+	}
+	return properties
+}
+
+func (t *TypeScript) getType(schema *swagger.Schema) string {
+	switch {
+	case schema == nil:
+		return "unknown"
+	case schema.Ref != "":
+		return t.getTypeOfRef(schema.Ref)
+	case len(schema.Enum) > 0:
+		return getTypeOfEnum(schema.Enum)
+	}
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		return t.getTypeOfArray(schema.Items)
+	default:
+		return t.getTypeOfObject(schema)
+	}
+}
+
+func (t *TypeScript) getTypeOfRef(ref string) string {
+	if name, ok := t.names[strings.TrimPrefix(ref, swagger.RefPrefix)]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func getTypeOfEnum(enum []interface{}) string {
+	values := []string{}
+	for _, value := range enum {
+		if text, ok := value.(string); ok {
+			values = append(values, fmt.Sprintf("%q", text))
+		} else {
+			values = append(values, fmt.Sprint(value))
+		}
+	}
+	return strings.Join(values, " | ")
+}
+
+func (t *TypeScript) getTypeOfArray(items *swagger.Schema) string {
+	item := t.getType(items)
+	if strings.ContainsAny(item, " |") {
+		return "Array<" + item + ">"
+	}
+	return item + "[]"
+}
+
+func (t *TypeScript) getTypeOfObject(schema *swagger.Schema) string {
+	if len(schema.Properties) > 0 {
+		properties := []string{}
+		for _, property := range t.getProperties(schema) {
+			properties = append(properties, property.Name+": "+property.Type)
+		}
+		return "{ " + strings.Join(properties, "; ") + " }"
+	}
+	if schema.AdditionalProperties != nil {
+		return "Record<string, " + t.getType(schema.AdditionalProperties) + ">"
+	}
+	return "Record<string, unknown>"
+}
+
+func (t *TypeScript) getMethods() (methods []tsMethod) {
+	names := map[string]int{}
+	for _, path := range getSortedKeys(t.spec.Paths) {
+		for _, method := range methodsOrder {
+			operation, ok := t.spec.Paths[path][method]
+			if !ok {
+				continue
+			}
+			tsMethod := t.getMethod(path, method, &operation)
+			if names[tsMethod.Name]++; names[tsMethod.Name] > 1 {
+				tsMethod.Name += fmt.Sprint(names[tsMethod.Name])
+			}
+			methods = append(methods, tsMethod)
+		}
+	}
+	return methods
+}
+
+func (t *TypeScript) getMethod(path, method string, operation *swagger.Operation) tsMethod {
+	name := operation.OperationID
+	if name == "" {
+		name = method + " " + pathParamRegex.ReplaceAllString(path, "$1")
+	}
+	summary := operation.Summary
+	if summary == "" {
+		summary = operation.Description
+	}
+	params, body, query := t.getParams(operation.Parameters)
+	return tsMethod{
+		Name:       toCamel(name),
+		Summary:    strings.ReplaceAll(summary, "*/", "* /"),
+		Params:     params,
+		ReturnType: t.getReturnType(operation),
+		Method:     strings.ToUpper(method),
+		Route:      path,
+		Path:       pathParamRegex.ReplaceAllStringFunc(path, replacePathParam),
+		Body:       body,
+		Query:      query,
+	}
+}
+
+func replacePathParam(param string) string {
+	return "${encodeURIComponent(String(" + toIdentifier(strings.Trim(param, "{}")) + "))}"
+}
+
+// getParams return the params of the method in the order of the path, body and query, and the values of the body
+// and the query sent in the request
+func (t *TypeScript) getParams(parameters []swagger.Parameter) (params, body, query string) {
+	list, queries := []string{}, []string{}
+	body, query = "undefined", "undefined"
+	for index := range parameters {
+		parameter := &parameters[index]
+		switch parameter.In {
+		case "path":
+			list = append(list, toIdentifier(parameter.Name)+": "+t.getTypeOfParameter(parameter))
+		case "body":
+			body = toIdentifier(parameter.Name)
+			list = append(list, body+": "+t.getType(parameter.Schema))
+		case "query":
+			queries = append(queries, fmt.Sprintf("%q?: %s", parameter.Name, t.getTypeOfParameter(parameter)))
+		}
+	}
+	if len(queries) > 0 {
+		query = "query"
+		list = append(list, "query: { "+strings.Join(queries, "; ")+" } = {}")
+	}
+	return strings.Join(append(list, "signal?: AbortSignal"), ", "), body, query
+}
+
+func (t *TypeScript) getTypeOfParameter(parameter *swagger.Parameter) string {
+	return t.getType(&swagger.Schema{Type: parameter.Type, Items: parameter.Items})
+}
+
+// getReturnType return the type of the first response of success, the responses without status and result are the
+// result of the Envelope sent by the internal/utils/http of the project
+func (t *TypeScript) getReturnType(operation *swagger.Operation) string {
+	for _, status := range getSortedKeys(operation.Responses) {
+		response := operation.Responses[status]
+		if !strings.HasPrefix(status, "2") || status == "204" || response.Schema == nil {
+			continue
+		}
+		definition := t.spec.GetDefinition(response.Schema)
+		if definition != nil && definition.Properties["status"] != nil && definition.Properties["result"] != nil {
+			return t.getType(response.Schema)
+		}
+		return "Envelope<" + t.getType(response.Schema) + ">"
+	}
+	return "void"
+}
+
+func getSortedKeys(values interface{}) (keys []string) {
+	switch value := values.(type) {
+	case map[string]*swagger.Schema:
+		for key := range value {
+			keys = append(keys, key)
+		}
+	case map[string]map[string]swagger.Operation:
+		for key := range value {
+			keys = append(keys, key)
+		}
+	case map[string]swagger.Response:
+		for key := range value {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func getSortedValues(interfaces map[string]tsInterface) (values []tsInterface) {
+	for _, value := range interfaces {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Name < values[j].Name
+	})
+	return values
+}
+
+func toPascal(value string) string {
+	camel := toCamel(value)
+	if camel == "" {
+		return camel
+	}
+	return strings.ToUpper(camel[:1]) + camel[1:]
+}
+
+func toCamel(value string) string {
+	words := strings.Fields(wordsRegex.ReplaceAllString(value, " "))
+	for index, word := range words {
+		if index == 0 {
+			words[index] = strings.ToLower(word[:1]) + word[1:]
+		} else {
+			words[index] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// toIdentifier keep the names of the params that are valid in typescript, like the ID of the path
+func toIdentifier(value string) string {
+	if identifierRegex.MatchString(value) {
+		return value
+	}
+	return toCamel(value)
+}
@@ -0,0 +1,107 @@
+package typescript
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/internal/entities/swagger"
+	"github.com/wilian746/go-generator/internal/enums/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var pathTemplate = filepath.Join("..", "..", "..", "pkg", "standart-gorm")
+
+func generate(t *testing.T, pathProject, basePath string) string {
+	dir, err := ioutil.TempDir("", "typescript")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "api", "client.ts")
+	assert.NoError(t, NewTypeScript().Generate(pathProject, out, basePath))
+	content, err := ioutil.ReadFile(out)
+	assert.NoError(t, err)
+	return string(content)
+}
+
+func TestTypeScript_Generate(t *testing.T) {
+	t.Run("Should generate the client with the interfaces and the methods of the routes", func(t *testing.T) {
+		client := generate(t, pathTemplate, DefaultBasePath)
+		assert.Contains(t, client, `export const BasePath = "/api/v1";`)
+		assert.Contains(t, client, "export interface ResponseListOneProduct {")
+		assert.Contains(t, client, "export interface RequestBodyToCreateOrUpdateProduct {")
+		assert.Contains(t, client, "export interface ResponseError {")
+		assert.Contains(t, client, "getAllProducts(signal?: AbortSignal): Promise<ResponseListAllProduct>")
+		assert.Contains(t, client, "postProduct(product: RequestBodyToCreateOrUpdateProduct, signal?: AbortSignal): "+
+			"Promise<Envelope<ResponseCreateProduct>>")
+		assert.Contains(t, client, "deleteProduct(ID: string, signal?: AbortSignal): Promise<void>")
+		assert.Contains(t, client, "`/product/${encodeURIComponent(String(ID))}`")
+	})
+	t.Run("Should use the base path of the flag when the spec not have basePath", func(t *testing.T) {
+		client := generate(t, pathTemplate, "/v2")
+		assert.Contains(t, client, `export const BasePath = "/v2";`)
+	})
+	t.Run("Should use the base path of the spec when it have basePath", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "typescript")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, "docs"), os.ModePerm))
+		spec := `{"basePath": "/api/v3", "paths": {"/item/{item-id}": {"get": {"parameters": [` +
+			`{"name": "item-id", "in": "path", "type": "integer"}, {"name": "page", "in": "query", "type": "integer"}` +
+			`], "responses": {"204": {"description": "No Content"}}}}}}`
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "docs", "swagger.json"), []byte(spec), 0600))
+		client := generate(t, dir, DefaultBasePath)
+		assert.Contains(t, client, `export const BasePath = "/api/v3";`)
+		assert.Contains(t, client, `getItemItemId(itemId: number, query: { "page"?: number } = {}, `+
+			`signal?: AbortSignal): Promise<void>`)
+		assert.Contains(t, client, "export interface ResponseError {")
+	})
+	t.Run("Should return error when not found the swagger docs", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "typescript")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		err = NewTypeScript().Generate(dir, filepath.Join(dir, "client.ts"), DefaultBasePath)
+		assert.Equal(t, errors.ErrTypeScriptSwaggerNotFound, err)
+	})
+}
+
+func TestTypeScript_getType(t *testing.T) {
+	ts := &TypeScript{names: map[string]string{"entities.Product": "Product"}}
+	t.Run("Should return the types of the schemas in typescript", func(t *testing.T) {
+		assert.Equal(t, "Product", ts.getType(&swagger.Schema{Ref: "#/definitions/entities.Product"}))
+		assert.Equal(t, "unknown", ts.getType(&swagger.Schema{Ref: "#/definitions/entities.Other"}))
+		assert.Equal(t, `"a" | "b"`, ts.getType(&swagger.Schema{Type: "string", Enum: []interface{}{"a", "b"}}))
+		assert.Equal(t, "number[]", ts.getType(&swagger.Schema{Type: "array", Items: &swagger.Schema{Type: "integer"}}))
+		assert.Equal(t, "Array<1 | 2>", ts.getType(&swagger.Schema{
+			Type: "array", Items: &swagger.Schema{Enum: []interface{}{1, 2}},
+		}))
+		assert.Equal(t, "Record<string, boolean>", ts.getType(&swagger.Schema{
+			Type: "object", AdditionalProperties: &swagger.Schema{Type: "boolean"},
+		}))
+		assert.Equal(t, `{ "first-name": string; id: number }`, ts.getType(&swagger.Schema{
+			Type: "object", Properties: map[string]*swagger.Schema{
+				"id": {Type: "integer"}, "first-name": {Type: "string"},
+			},
+		}))
+		assert.Equal(t, "unknown", ts.getType(nil))
+	})
+}
+
+func TestTypeScript_getInterfacesNames(t *testing.T) {
+	t.Run("Should keep the package in the name only when the names conflict", func(t *testing.T) {
+		names := getInterfacesNames(map[string]*swagger.Schema{
+			"http.ResponseError": {}, "product.Entity": {}, "invoice.Entity": {},
+		})
+		assert.Equal(t, "ResponseError", names["http.ResponseError"])
+		assert.Equal(t, "ProductEntity", names["product.Entity"])
+		assert.Equal(t, "InvoiceEntity", names["invoice.Entity"])
+	})
+}
+
+func TestTypeScript_toCamel(t *testing.T) {
+	t.Run("Should return the value in camel case", func(t *testing.T) {
+		assert.Equal(t, "getOneProduct", toCamel("get-one_product"))
+		assert.Equal(t, "getProductID", toCamel("get /product/ID"))
+		assert.Equal(t, "ResponseError", toPascal("responseError"))
+		assert.Equal(t, "", toPascal("/"))
+	})
+}
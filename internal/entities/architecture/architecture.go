@@ -0,0 +1,128 @@
+package architecture
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Config is the dependency matrix of the layers of the project, the layers are folders of the project and each rule
+// has the layers that the layer can import and the packages out of the project that it can not import
+type Config struct {
+	Layers map[string]string `json:"layers"`
+	Rules  map[string]Rule   `json:"rules"`
+}
+
+type Rule struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// Violation is an import of a file that not respect the dependency matrix
+type Violation struct {
+	File   string
+	Line   int
+	Layer  string
+	Import string
+	Reason string
+}
+
+// NewConfig return the dependency matrix of the layering of the templates: handlers -> controllers -> adapter, with
+// the rules to validation and the entities used by all
+func NewConfig() *Config {
+	drivers := []string{"database/sql", "github.com/jinzhu/gorm", "gorm.io", "go.etcd.io/bbolt"}
+	return &Config{
+		Layers: map[string]string{
+			"handlers":    "internal/handlers",
+			"controllers": "internal/controllers",
+			"rules":       "internal/rules",
+			"entities":    "internal/entities",
+			"adapter":     "pkg/repository/adapter",
+			"repository":  "pkg/repository",
+		},
+		Rules: map[string]Rule{
+			"handlers":    {Allow: []string{"controllers", "rules", "entities", "adapter"}, Deny: drivers},
+			"controllers": {Allow: []string{"rules", "entities", "adapter"}, Deny: drivers},
+			"rules":       {Allow: []string{"entities", "repository"}, Deny: []string{}},
+			"entities":    {Allow: []string{}, Deny: drivers},
+			"adapter":     {Allow: []string{"repository"}, Deny: []string{}},
+			"repository":  {Allow: []string{"adapter"}, Deny: []string{}},
+		},
+	}
+}
+
+func NewViolation(file string, line int, layer, importPath, reason string) Violation {
+	return Violation{
+		File:   file,
+		Line:   line,
+		Layer:  layer,
+		Import: importPath,
+		Reason: reason,
+	}
+}
+
+// Validate return error when a rule or an allow is of a layer not declared
+func (c *Config) Validate() error {
+	for layer, rule := range c.Rules {
+		if _, ok := c.Layers[layer]; !ok {
+			return fmt.Errorf("rule of the layer %s not declared in the layers", layer)
+		}
+		for _, allow := range rule.Allow {
+			if _, ok := c.Layers[allow]; !ok {
+				return fmt.Errorf("layer %s allowed in the rule of the layer %s not declared in the layers", allow, layer)
+			}
+		}
+	}
+	return nil
+}
+
+// GetLayer return the layer of the folder of the project, the layer of the longest folder is used when the layers
+// are nested, like the adapter in the repository
+func (c *Config) GetLayer(folder string) string {
+	layer, length := "", 0
+	for name, layerFolder := range c.Layers {
+		layerFolder = strings.Trim(layerFolder, "/")
+		if (folder == layerFolder || strings.HasPrefix(folder, layerFolder+"/")) && len(layerFolder) > length {
+			layer, length = name, len(layerFolder)
+		}
+	}
+	return layer
+}
+
+// GetReasonOfProject return why the layer can not import the layer of the project, empty when it can, the imports of
+// the same layer and of the folders out of the layers, like the internal/utils, are allowed
+func (c *Config) GetReasonOfProject(layer, importLayer string) string {
+	rule, ok := c.Rules[layer]
+	if !ok || importLayer == "" || importLayer == layer {
+		return ""
+	}
+	for _, allow := range rule.Allow {
+		if allow == importLayer {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%s can not import %s, allowed: %s", layer, importLayer, c.getAllowed(rule))
+}
+
+// GetReasonOfExternal return why the layer can not import the package out of the project, empty when it can
+func (c *Config) GetReasonOfExternal(layer, importPath string) string {
+	for _, deny := range c.Rules[layer].Deny {
+		if importPath == deny || strings.HasPrefix(importPath, deny+"/") {
+			return fmt.Sprintf("%s can not import %s", layer, deny)
+		}
+	}
+	return ""
+}
+
+func (c *Config) getAllowed(rule Rule) string {
+	allowed := append([]string{}, rule.Allow...)
+	sort.Strings(allowed)
+	if len(allowed) == 0 {
+		return "none"
+	}
+	return strings.Join(allowed, ", ")
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s:%d: %s (%s)", v.File, v.Line, v.Reason, v.Import)
+}
@@ -0,0 +1,62 @@
+package architecture
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestConfig_GetLayer(t *testing.T) {
+	config := NewConfig()
+	t.Run("Should return the layer of the longest folder", func(t *testing.T) {
+		assert.Equal(t, "adapter", config.GetLayer("pkg/repository/adapter"))
+		assert.Equal(t, "repository", config.GetLayer("pkg/repository/database"))
+		assert.Equal(t, "handlers", config.GetLayer("internal/handlers/product"))
+	})
+	t.Run("Should return empty when the folder is out of the layers", func(t *testing.T) {
+		assert.Equal(t, "", config.GetLayer("internal/utils/http"))
+		assert.Equal(t, "", config.GetLayer("internal/handlersv2"))
+	})
+}
+
+func TestConfig_GetReason(t *testing.T) {
+	config := NewConfig()
+	t.Run("Should return empty when the layer can import", func(t *testing.T) {
+		assert.Equal(t, "", config.GetReasonOfProject("handlers", "controllers"))
+		assert.Equal(t, "", config.GetReasonOfProject("handlers", "handlers"))
+		assert.Equal(t, "", config.GetReasonOfProject("handlers", ""))
+		assert.Equal(t, "", config.GetReasonOfExternal("rules", "gorm.io/gorm"))
+		assert.Equal(t, "", config.GetReasonOfExternal("controllers", "github.com/google/uuid"))
+	})
+	t.Run("Should return the reason when the layer can not import", func(t *testing.T) {
+		assert.Equal(t, "entities can not import controllers, allowed: none",
+			config.GetReasonOfProject("entities", "controllers"))
+		assert.Equal(t, "controllers can not import gorm.io", config.GetReasonOfExternal("controllers", "gorm.io/gorm"))
+		assert.Equal(t, "handlers can not import database/sql", config.GetReasonOfExternal("handlers", "database/sql"))
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	t.Run("Should return nil to the default config", func(t *testing.T) {
+		assert.NoError(t, NewConfig().Validate())
+	})
+	t.Run("Should return error when the layer of the rule is not declared", func(t *testing.T) {
+		config := NewConfig()
+		config.Rules["services"] = Rule{}
+		assert.EqualError(t, config.Validate(), "rule of the layer services not declared in the layers")
+	})
+	t.Run("Should return error when the layer allowed is not declared", func(t *testing.T) {
+		config := NewConfig()
+		config.Rules["handlers"] = Rule{Allow: []string{"services"}}
+		assert.EqualError(t, config.Validate(),
+			"layer services allowed in the rule of the layer handlers not declared in the layers")
+	})
+}
+
+func TestViolation_String(t *testing.T) {
+	t.Run("Should return the violation with the file and the line", func(t *testing.T) {
+		violation := NewViolation("internal/handlers/product/product.go", 10, "handlers", "gorm.io/gorm",
+			"handlers can not import gorm.io")
+		assert.Equal(t, "internal/handlers/product/product.go:10: handlers can not import gorm.io (gorm.io/gorm)",
+			violation.String())
+	})
+}
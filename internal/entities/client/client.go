@@ -0,0 +1,53 @@
+package client
+
+// Resource is an entity of the project with the routes of CRUD registered in the router
+type Resource struct {
+	Name   string
+	Path   string
+	Fields []Field
+}
+
+type Field struct {
+	Name string
+	Type string
+	Tag  string
+	// Base is true to the fields of the structs embedded, like the entities.Base, not sent in the body
+	Base bool
+}
+
+func NewResource(name, path string, fields []Field) Resource {
+	return Resource{
+		Name:   name,
+		Path:   path,
+		Fields: fields,
+	}
+}
+
+func NewField(name, fieldType, tag string, base bool) Field {
+	return Field{
+		Name: name,
+		Type: fieldType,
+		Tag:  tag,
+		Base: base,
+	}
+}
+
+// GetIDType return the type of the field ID, used in the params of the routes with {ID}
+func (r Resource) GetIDType() string {
+	for _, field := range r.Fields {
+		if field.Name == "ID" {
+			return field.Type
+		}
+	}
+	return "string"
+}
+
+// GetBodyFields return the fields sent in the body to create or update the resource
+func (r Resource) GetBodyFields() (fields []Field) {
+	for _, field := range r.Fields {
+		if !field.Base {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResource_GetIDType(t *testing.T) {
+	t.Run("Should return type of the field ID", func(t *testing.T) {
+		resource := NewResource("Product", "/product", []Field{NewField("ID", "uuid.UUID", `json:"id"`, true)})
+		assert.Equal(t, "uuid.UUID", resource.GetIDType())
+	})
+	t.Run("Should return string when resource not have field ID", func(t *testing.T) {
+		assert.Equal(t, "string", NewResource("Product", "/product", nil).GetIDType())
+	})
+}
+
+func TestResource_GetBodyFields(t *testing.T) {
+	t.Run("Should return only fields that are not of the struct embedded", func(t *testing.T) {
+		name := NewField("Name", "string", `json:"name"`, false)
+		resource := NewResource("Product", "/product", []Field{NewField("ID", "uuid.UUID", `json:"id"`, true), name})
+		assert.Equal(t, []Field{name}, resource.GetBodyFields())
+	})
+}
@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	DefaultHost = "localhost"
+	DefaultPort = "8080"
+)
+
+// Variables are the values shared by all requests of the collection, used in the urls like {{host}}, the Params are
+// the variables of the params of the paths like {{productId}}, empty until they are set by the response of the create
+type Variables struct {
+	Host     string
+	Port     string
+	BasePath string
+	Params   []string
+}
+
+// Request is a route of the swagger docs of the project with the values of the mock of the rules of the resource,
+// the Variable is the param set with the id of the response of the request, like the productId by the create
+type Request struct {
+	Folder   string
+	Name     string
+	Method   string
+	Path     string
+	Body     json.RawMessage
+	Variable string
+}
+
+func NewVariables(host, port, basePath string, params []string) Variables {
+	return Variables{
+		Host:     host,
+		Port:     port,
+		BasePath: basePath,
+		Params:   params,
+	}
+}
+
+func NewRequest(folder, name, method, path string, body json.RawMessage) Request {
+	return Request{
+		Folder: folder,
+		Name:   name,
+		Method: method,
+		Path:   path,
+		Body:   body,
+	}
+}
+
+// GetValues return the variables in the order written in the files of variables
+func (v Variables) GetValues() [][2]string {
+	values := [][2]string{{"host", v.Host}, {"port", v.Port}, {"basePath", v.BasePath}}
+	for _, param := range v.Params {
+		values = append(values, [2]string{param, ""})
+	}
+	return values
+}
+
+// GetURL return the url of the request with the variables, like http://{{host}}:{{port}}{{basePath}}/product
+func (r Request) GetURL() string {
+	return "http://{{host}}:{{port}}{{basePath}}" + r.Path
+}
+
+// GetScriptOfHTTP return the response handler of the REST clients that set the Variable with the id of the response,
+// the id is in the result of the responses of the project or in the root of the body
+func (r Request) GetScriptOfHTTP() string {
+	return fmt.Sprintf(`client.global.set("%s", (response.body.result || response.body).id);`, r.Variable)
+}
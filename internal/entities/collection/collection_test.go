@@ -0,0 +1,47 @@
+package collection
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPostmanCollection(t *testing.T) {
+	variables := NewVariables(DefaultHost, DefaultPort, "/api/v1", []string{"productId"})
+	requests := []Request{
+		NewRequest("Product", "List all products", "GET", "/product", nil),
+		NewRequest("Health", "Health", "GET", "/health", nil),
+		NewRequest("Product", "Create an product", "POST", "/product", json.RawMessage(`{"name": "product"}`)),
+	}
+	requests[2].Variable = "productId"
+	t.Run("Should group the requests in folders in the order of the requests", func(t *testing.T) {
+		collection := NewPostmanCollection("API", variables, requests)
+		assert.Len(t, collection.Item, 2)
+		assert.Equal(t, "Product", collection.Item[0].Name)
+		assert.Len(t, collection.Item[0].Item, 2)
+		assert.Nil(t, collection.Item[0].Item[0].Request.Body)
+		assert.Equal(t, `{"name": "product"}`, collection.Item[0].Item[1].Request.Body.Raw)
+		assert.Equal(t, "http://{{host}}:{{port}}{{basePath}}/product", collection.Item[0].Item[1].Request.URL)
+		assert.Equal(t, "Health", collection.Item[1].Name)
+		assert.Len(t, collection.Variable, 4)
+	})
+	t.Run("Should set the variable with the id of the response in the test of the request", func(t *testing.T) {
+		collection := NewPostmanCollection("API", variables, requests)
+		assert.Nil(t, collection.Item[0].Item[0].Event)
+		assert.Equal(t, []PostmanEvent{{Listen: "test", Script: PostmanScript{Type: "text/javascript", Exec: []string{
+			"var body = pm.response.json();",
+			`pm.environment.set("productId", (body.result || body).id);`,
+		}}}}, collection.Item[0].Item[1].Event)
+		assert.Equal(t, `client.global.set("productId", (response.body.result || response.body).id);`,
+			requests[2].GetScriptOfHTTP())
+	})
+	t.Run("Should return the environment with the variables enabled", func(t *testing.T) {
+		environment := NewPostmanEnvironment("API", variables)
+		assert.Equal(t, []PostmanVariable{
+			{Key: "host", Value: "localhost", Enabled: true},
+			{Key: "port", Value: "8080", Enabled: true},
+			{Key: "basePath", Value: "/api/v1", Enabled: true},
+			{Key: "productId", Value: "", Enabled: true},
+		}, environment.Values)
+	})
+}
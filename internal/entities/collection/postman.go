@@ -0,0 +1,131 @@
+package collection
+
+import "fmt"
+
+// PostmanSchema is the version 2.1 of the format of the collections imported by postman
+const PostmanSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+type PostmanCollection struct {
+	Info     PostmanInfo       `json:"info"`
+	Item     []PostmanItem     `json:"item"`
+	Variable []PostmanVariable `json:"variable"`
+}
+
+type PostmanInfo struct {
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+}
+
+// PostmanItem is a folder with the items of a resource when the request is nil
+type PostmanItem struct {
+	Name    string          `json:"name"`
+	Item    []PostmanItem   `json:"item,omitempty"`
+	Event   []PostmanEvent  `json:"event,omitempty"`
+	Request *PostmanRequest `json:"request,omitempty"`
+}
+
+// PostmanEvent is the script run by postman, the test is run after the response
+type PostmanEvent struct {
+	Listen string        `json:"listen"`
+	Script PostmanScript `json:"script"`
+}
+
+type PostmanScript struct {
+	Type string   `json:"type"`
+	Exec []string `json:"exec"`
+}
+
+type PostmanRequest struct {
+	Method string          `json:"method"`
+	Header []PostmanHeader `json:"header"`
+	URL    string          `json:"url"`
+	Body   *PostmanBody    `json:"body,omitempty"`
+}
+
+type PostmanHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type PostmanBody struct {
+	Mode    string                 `json:"mode"`
+	Raw     string                 `json:"raw"`
+	Options map[string]interface{} `json:"options"`
+}
+
+type PostmanVariable struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Enabled bool   `json:"enabled,omitempty"`
+}
+
+// PostmanEnvironment is the file of variables imported in the environments of postman
+type PostmanEnvironment struct {
+	Name   string            `json:"name"`
+	Values []PostmanVariable `json:"values"`
+}
+
+func NewPostmanCollection(name string, variables Variables, requests []Request) PostmanCollection {
+	collection := PostmanCollection{
+		Info:     PostmanInfo{Name: name, Schema: PostmanSchema},
+		Item:     []PostmanItem{},
+		Variable: newPostmanVariables(variables, false),
+	}
+	folders := map[string]int{}
+	for _, request := range requests {
+		index, ok := folders[request.Folder]
+		if !ok {
+			index = len(collection.Item)
+			folders[request.Folder] = index
+			collection.Item = append(collection.Item, PostmanItem{Name: request.Folder})
+		}
+		collection.Item[index].Item = append(collection.Item[index].Item, newPostmanItem(request))
+	}
+	return collection
+}
+
+func NewPostmanEnvironment(name string, variables Variables) PostmanEnvironment {
+	return PostmanEnvironment{
+		Name:   name,
+		Values: newPostmanVariables(variables, true),
+	}
+}
+
+func newPostmanItem(request Request) PostmanItem {
+	postmanRequest := &PostmanRequest{Method: request.Method, Header: []PostmanHeader{}, URL: request.GetURL()}
+	if request.Body != nil {
+		postmanRequest.Header = append(postmanRequest.Header, PostmanHeader{Key: "Content-Type", Value: "application/json"})
+		postmanRequest.Body = &PostmanBody{
+			Mode:    "raw",
+			Raw:     string(request.Body),
+			Options: map[string]interface{}{"raw": map[string]string{"language": "json"}},
+		}
+	}
+	item := PostmanItem{Name: request.Name, Request: postmanRequest}
+	if request.Variable != "" {
+		item.Event = []PostmanEvent{newPostmanEventOfVariable(request.Variable)}
+	}
+	return item
+}
+
+// newPostmanEventOfVariable return the test that set the variable with the id of the response, the id is in the
+// result of the responses of the project or in the root of the body
+func newPostmanEventOfVariable(variable string) PostmanEvent {
+	return PostmanEvent{
+		Listen: "test",
+		Script: PostmanScript{
+			Type: "text/javascript",
+			Exec: []string{
+				"var body = pm.response.json();",
+				fmt.Sprintf(`pm.environment.set("%s", (body.result || body).id);`, variable),
+			},
+		},
+	}
+}
+
+func newPostmanVariables(variables Variables, enabled bool) (values []PostmanVariable) {
+	for _, value := range variables.GetValues() {
+		values = append(values, PostmanVariable{Key: value[0], Value: value[1], Enabled: enabled})
+	}
+	return values
+}
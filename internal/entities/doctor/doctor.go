@@ -0,0 +1,15 @@
+package doctor
+
+type Diagnostic struct {
+	Check   string
+	Problem string
+	Fix     string
+}
+
+func NewDiagnostic(check, problem, fix string) Diagnostic {
+	return Diagnostic{
+		Check:   check,
+		Problem: problem,
+		Fix:     fix,
+	}
+}
@@ -0,0 +1,115 @@
+package erd
+
+import "sort"
+
+type Cardinality string
+
+const (
+	OneToOne  Cardinality = "one-to-one"
+	OneToMany Cardinality = "one-to-many"
+)
+
+// Diagram are the tables of the entities of the project and the relationships between them
+type Diagram struct {
+	Tables        []Table
+	Relationships []Relationship
+}
+
+type Table struct {
+	Name    string
+	Columns []Column
+}
+
+type Column struct {
+	Name       string
+	Type       string
+	PrimaryKey bool
+	ForeignKey bool
+}
+
+// Relationship is the foreign key of the column of the child to the column of the parent
+type Relationship struct {
+	Parent       string
+	ParentColumn string
+	Child        string
+	ChildColumn  string
+	Cardinality  Cardinality
+}
+
+func NewDiagram(tables []Table, relationships []Relationship) *Diagram {
+	diagram := &Diagram{
+		Tables:        tables,
+		Relationships: relationships,
+	}
+	diagram.setForeignKeys()
+	diagram.sort()
+	return diagram
+}
+
+func NewTable(name string, columns []Column) Table {
+	return Table{
+		Name:    name,
+		Columns: columns,
+	}
+}
+
+func NewColumn(name, columnType string, primaryKey bool) Column {
+	return Column{
+		Name:       name,
+		Type:       columnType,
+		PrimaryKey: primaryKey,
+	}
+}
+
+func NewRelationship(parent, parentColumn, child, childColumn string, cardinality Cardinality) Relationship {
+	return Relationship{
+		Parent:       parent,
+		ParentColumn: parentColumn,
+		Child:        child,
+		ChildColumn:  childColumn,
+		Cardinality:  cardinality,
+	}
+}
+
+func (d *Diagram) setForeignKeys() {
+	for _, relationship := range d.Relationships {
+		for index := range d.Tables {
+			if d.Tables[index].Name != relationship.Child {
+				continue
+			}
+			for column := range d.Tables[index].Columns {
+				if d.Tables[index].Columns[column].Name == relationship.ChildColumn {
+					d.Tables[index].Columns[column].ForeignKey = true
+				}
+			}
+		}
+	}
+}
+
+func (d *Diagram) sort() {
+	sort.SliceStable(d.Tables, func(i, j int) bool {
+		return d.Tables[i].Name < d.Tables[j].Name
+	})
+	sort.SliceStable(d.Relationships, func(i, j int) bool {
+		first, second := d.Relationships[i], d.Relationships[j]
+		if first.Parent != second.Parent {
+			return first.Parent < second.Parent
+		}
+		if first.Child != second.Child {
+			return first.Child < second.Child
+		}
+		return first.ChildColumn < second.ChildColumn
+	})
+}
+
+// GetKey return the key of the column, PK to the primary key and FK to the foreign key
+func (c Column) GetKey() string {
+	switch {
+	case c.PrimaryKey:
+		return "PK"
+	case c.ForeignKey:
+		return "FK"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,22 @@
+package erd
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewDiagram(t *testing.T) {
+	t.Run("Should sort the tables and mark the columns of the foreign keys", func(t *testing.T) {
+		diagram := NewDiagram([]Table{
+			NewTable("orders", []Column{NewColumn("id", "uuid", true), NewColumn("restaurant_id", "uuid", false)}),
+			NewTable("restaurants", []Column{NewColumn("id", "uuid", true)}),
+		}, []Relationship{NewRelationship("restaurants", "id", "orders", "restaurant_id", OneToMany)})
+		assert.Equal(t, "orders", diagram.Tables[0].Name)
+		assert.Equal(t, "PK", diagram.Tables[0].Columns[0].GetKey())
+		assert.Equal(t, "FK", diagram.Tables[0].Columns[1].GetKey())
+		assert.Equal(t, "PK", diagram.Tables[1].Columns[0].GetKey())
+	})
+	t.Run("Should return empty key when the column is not a key", func(t *testing.T) {
+		assert.Equal(t, "", NewColumn("name", "string", false).GetKey())
+	})
+}
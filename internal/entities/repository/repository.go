@@ -1,6 +1,7 @@
 package repository
 
 import (
+	enumsDialects "github.com/wilian746/go-generator/internal/enums/dialects"
 	enumsRepository "github.com/wilian746/go-generator/internal/enums/repository"
 	enumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
 )
@@ -8,6 +9,7 @@ import (
 type Repository struct {
 	Name     enumsRepository.Repository
 	Commands []enumsCommands.Command
+	Dialects []enumsDialects.Dialect
 }
 
 func (r Repository) GetListCommands() (existingCommands []string) {
@@ -16,3 +18,10 @@ func (r Repository) GetListCommands() (existingCommands []string) {
 	}
 	return existingCommands
 }
+
+func (r Repository) GetListDialects() (existingDialects []string) {
+	for _, value := range r.Dialects {
+		existingDialects = append(existingDialects, value.String())
+	}
+	return existingDialects
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/stretchr/testify/assert"
+	enumsDialects "github.com/wilian746/go-generator/internal/enums/dialects"
 	enumsRepository "github.com/wilian746/go-generator/internal/enums/repository"
 	enumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
 	"testing"
@@ -18,3 +19,13 @@ func TestRepository_GetListCommands(t *testing.T) {
 		assert.Equal(t, r.GetListCommands(), []string{"app"})
 	})
 }
+
+func TestRepository_GetListDialects(t *testing.T) {
+	t.Run("Should return all dialects as string", func(t *testing.T) {
+		r := Repository{
+			Name:     enumsRepository.Gorm,
+			Dialects: []enumsDialects.Dialect{enumsDialects.Postgres, enumsDialects.SQLite3},
+		}
+		assert.Equal(t, r.GetListDialects(), []string{"postgres", "sqlite3"})
+	})
+}
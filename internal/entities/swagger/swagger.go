@@ -0,0 +1,70 @@
+package swagger
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// RefPrefix is the prefix of the references to the definitions of the spec
+const RefPrefix = "#/definitions/"
+
+// Swagger is the spec 2.0 generated by swag in docs/swagger.json, only with the fields used by the clients
+type Swagger struct {
+	Info        Info                            `json:"info"`
+	BasePath    string                          `json:"basePath"`
+	Paths       map[string]map[string]Operation `json:"paths"`
+	Definitions map[string]*Schema              `json:"definitions"`
+}
+
+type Info struct {
+	Title string `json:"title"`
+}
+
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary"`
+	Description string              `json:"description"`
+	Tags        []string            `json:"tags"`
+	Parameters  []Parameter         `json:"parameters"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Type     string  `json:"type"`
+	Required bool    `json:"required"`
+	Items    *Schema `json:"items"`
+	Schema   *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Items                *Schema            `json:"items"`
+	Properties           map[string]*Schema `json:"properties"`
+	AdditionalProperties *Schema            `json:"additionalProperties"`
+	Enum                 []interface{}      `json:"enum"`
+	Example              interface{}        `json:"example"`
+}
+
+func NewSwagger(content []byte) (*Swagger, error) {
+	spec := &Swagger{}
+	if err := json.Unmarshal(content, spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// GetDefinition return the definition of the reference of the schema, nil when the schema is not a reference
+func (s *Swagger) GetDefinition(schema *Schema) *Schema {
+	if schema == nil || !strings.HasPrefix(schema.Ref, RefPrefix) {
+		return nil
+	}
+	return s.Definitions[strings.TrimPrefix(schema.Ref, RefPrefix)]
+}
@@ -0,0 +1,24 @@
+package swagger
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSwagger_GetDefinition(t *testing.T) {
+	spec, err := NewSwagger([]byte(`{"definitions": {"entities.Product": {"type": "object"}}}`))
+	assert.NoError(t, err)
+	t.Run("Should return the definition of the reference", func(t *testing.T) {
+		definition := spec.GetDefinition(&Schema{Ref: "#/definitions/entities.Product"})
+		assert.NotNil(t, definition)
+		assert.Equal(t, "object", definition.Type)
+	})
+	t.Run("Should return nil when the schema is not a reference", func(t *testing.T) {
+		assert.Nil(t, spec.GetDefinition(&Schema{Type: "string"}))
+		assert.Nil(t, spec.GetDefinition(nil))
+	})
+	t.Run("Should return error when the content is invalid", func(t *testing.T) {
+		_, err := NewSwagger([]byte("{"))
+		assert.Error(t, err)
+	})
+}
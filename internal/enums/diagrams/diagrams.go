@@ -0,0 +1,43 @@
+package diagrams
+
+type Diagram string
+
+const (
+	Mermaid  Diagram = "mermaid"
+	DOT      Diagram = "dot"
+	PlantUML Diagram = "plantuml"
+	Unknown  Diagram = "unknown"
+)
+
+func (d Diagram) String() string {
+	return string(d)
+}
+
+func Values() []Diagram {
+	return []Diagram{
+		Mermaid,
+		DOT,
+		PlantUML,
+	}
+}
+
+func ValueOf(value string) Diagram {
+	for _, diagram := range Values() {
+		if string(diagram) == value {
+			return diagram
+		}
+	}
+	return Unknown
+}
+
+func Valid(value string) bool {
+	return ValueOf(value) != Unknown
+}
+
+func ValuesNames() []string {
+	names := []string{}
+	for _, diagram := range Values() {
+		names = append(names, diagram.String())
+	}
+	return names
+}
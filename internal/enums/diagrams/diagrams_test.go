@@ -0,0 +1,28 @@
+package diagrams
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	t.Run("Should return valid diagrams", func(t *testing.T) {
+		v := Values()
+		assert.Equal(t, v, []Diagram{Mermaid, DOT, PlantUML})
+	})
+	t.Run("Should return dot diagram", func(t *testing.T) {
+		assert.Equal(t, ValueOf("dot"), DOT)
+	})
+	t.Run("Should return unknown diagram", func(t *testing.T) {
+		assert.Equal(t, ValueOf("other"), Unknown)
+	})
+	t.Run("Should return invalid enum", func(t *testing.T) {
+		assert.False(t, Valid("other"))
+	})
+	t.Run("Should return valid enum", func(t *testing.T) {
+		assert.True(t, Valid("plantuml"))
+	})
+	t.Run("Should return names of diagrams", func(t *testing.T) {
+		assert.Equal(t, []string{"mermaid", "dot", "plantuml"}, ValuesNames())
+	})
+}
@@ -0,0 +1,37 @@
+package dialects
+
+type Dialect string
+
+const (
+	MySQL     Dialect = "mysql"
+	Postgres  Dialect = "postgres"
+	SQLite3   Dialect = "sqlite3"
+	SQLServer Dialect = "mssql"
+	Unknown   Dialect = "unknown"
+)
+
+func (d Dialect) String() string {
+	return string(d)
+}
+
+func Values() []Dialect {
+	return []Dialect{
+		MySQL,
+		Postgres,
+		SQLite3,
+		SQLServer,
+	}
+}
+
+func ValueOf(value string) Dialect {
+	for _, dialect := range Values() {
+		if string(dialect) == value {
+			return dialect
+		}
+	}
+	return Unknown
+}
+
+func Valid(value string) bool {
+	return ValueOf(value) != Unknown
+}
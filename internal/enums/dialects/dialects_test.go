@@ -0,0 +1,25 @@
+package dialects
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	t.Run("Should return valid dialects", func(t *testing.T) {
+		v := Values()
+		assert.Equal(t, v, []Dialect{MySQL, Postgres, SQLite3, SQLServer})
+	})
+	t.Run("Should return postgres dialect", func(t *testing.T) {
+		assert.Equal(t, ValueOf("postgres"), Postgres)
+	})
+	t.Run("Should return unknown dialect", func(t *testing.T) {
+		assert.Equal(t, ValueOf("other"), Unknown)
+	})
+	t.Run("Should return invalid enum", func(t *testing.T) {
+		assert.False(t, Valid("other"))
+	})
+	t.Run("Should return valid enum", func(t *testing.T) {
+		assert.True(t, Valid("mssql"))
+	})
+}
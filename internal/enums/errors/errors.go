@@ -7,4 +7,53 @@ var ErrInitArgsInvalid = errors.New("{ERROR_COMMAND} Type of args is invalid, is
 var ErrArgsRepositoryOrCommandInvalid = errors.New(
 	"{ERROR_COMMAND} Type of args of the [REPOSITORY] or [GENERATE_TYPE] is invalid")
 var ErrDirectoryPathInvalid = errors.New("{ERROR_COMMAND} Directory path is invalid")
-var ErrModuleNameInvalid = errors.New("{ERROR_COMMAND} Directory path is invalid")
+var ErrDirectoryNotWritable = errors.New("{ERROR_COMMAND} Directory path is not writable")
+var ErrDirectoryContainsOtherModule = errors.New(
+	"{ERROR_COMMAND} Directory path already contains a go.mod with a different module")
+var ErrModuleNameInvalid = errors.New("{ERROR_COMMAND} Module name is invalid")
+var ErrHelpCommandNotFound = errors.New("{ERROR_COMMAND} Command to show help not found")
+var ErrDocsFormatInvalid = errors.New("{ERROR_COMMAND} Format of docs is invalid, is expected markdown or man")
+var ErrDoctorFoundProblems = errors.New("{ERROR_COMMAND} Doctor found problems in the project, see the fixes above")
+var ErrRouterInvalid = errors.New("{ERROR_COMMAND} Router is invalid, is expected chi, stdlib, gin or echo")
+var ErrRouterNotSupported = errors.New("{ERROR_COMMAND} Router is not supported by the [GENERATE_TYPE] selected")
+var ErrDialectsInvalid = errors.New("{ERROR_COMMAND} Dialects are invalid, is expected mysql, postgres, sqlite3 or mssql")
+var ErrDialectsNotSupported = errors.New("{ERROR_COMMAND} Dialects are not supported by the [REPOSITORY] selected")
+var ErrFeaturesInvalid = errors.New(
+	"{ERROR_COMMAND} Features are invalid, is expected swagger, docker-compose, tests, health or cors")
+var ErrResourceInvalid = errors.New(
+	"{ERROR_COMMAND} Resource is invalid, is expected a name with letters, numbers, _ or - not used by the template")
+var ErrResourceNotSupported = errors.New("{ERROR_COMMAND} Resource is not supported by the [GENERATE_TYPE] selected")
+var ErrClientModuleNotFound = errors.New(
+	"{ERROR_COMMAND} go.mod not found or without module in the [PATH] of the project")
+var ErrClientResourcesNotFound = errors.New(
+	"{ERROR_COMMAND} Resources not found, is expected handlers registered in the routes of internal/routes")
+var ErrTypeScriptSwaggerNotFound = errors.New(
+	"{ERROR_COMMAND} docs/swagger.json not found in the [PATH] of the project, run `swag init -g ./cmd/main.go`")
+var ErrCollectionSwaggerNotFound = errors.New(
+	"{ERROR_COMMAND} docs/swagger.json not found in the [PATH] of the project, run `swag init -g ./cmd/main.go`")
+var ErrERDFormatInvalid = errors.New("{ERROR_COMMAND} Format of the diagram is invalid, use mermaid, dot or plantuml")
+var ErrERDTablesNotFound = errors.New(
+	"{ERROR_COMMAND} Tables not found, is expected entities with the method TableName in the [PATH] of the project")
+var ErrLintArchModuleNotFound = errors.New(
+	"{ERROR_COMMAND} go.mod not found or without module in the [PATH] of the project")
+var ErrLintArchViolations = errors.New(
+	"{ERROR_COMMAND} Imports of the layers not respect the dependency matrix, see the violations above")
+var ErrRemoveModuleNotFound = errors.New(
+	"{ERROR_COMMAND} go.mod not found or without module in the [PATH] of the project")
+var ErrRemoveResourceNotFound = errors.New(
+	"{ERROR_COMMAND} Resource not found, is expected the packages of the resource in internal/entities, " +
+		"internal/rules, internal/controllers or internal/handlers")
+var ErrRemoveResourceNotSupported = errors.New(
+	"{ERROR_COMMAND} Resource can not be removed of the projects generated with grpc or graphql")
+var ErrRemoveResourceInUse = errors.New(
+	"{ERROR_COMMAND} Resource is imported by other packages of the project, remove the imports first")
+var ErrAddModuleNotFound = errors.New(
+	"{ERROR_COMMAND} go.mod not found or without module in the [PATH] of the project")
+var ErrAddMiddlewareInvalid = errors.New(
+	"{ERROR_COMMAND} Middleware is invalid, is expected a name with letters, numbers, _ or -")
+var ErrAddMiddlewareTemplateInvalid = errors.New(
+	"{ERROR_COMMAND} Template of the middleware is invalid, use empty, auth, ratelimit, logging or bodylimit")
+var ErrAddMiddlewareAlreadyExists = errors.New(
+	"{ERROR_COMMAND} Middleware already exists in internal/middlewares or in the Router of internal/routes")
+var ErrAddRoutesNotFound = errors.New(
+	"{ERROR_COMMAND} Router not found, is expected the method setConfigsRouters in internal/routes")
@@ -0,0 +1,47 @@
+package features
+
+type Feature string
+
+const (
+	Swagger       Feature = "swagger"
+	DockerCompose Feature = "docker-compose"
+	Tests         Feature = "tests"
+	Health        Feature = "health"
+	CORS          Feature = "cors"
+	Unknown       Feature = "unknown"
+)
+
+func (f Feature) String() string {
+	return string(f)
+}
+
+func Values() []Feature {
+	return []Feature{
+		Swagger,
+		DockerCompose,
+		Tests,
+		Health,
+		CORS,
+	}
+}
+
+func ValueOf(value string) Feature {
+	for _, feature := range Values() {
+		if string(feature) == value {
+			return feature
+		}
+	}
+	return Unknown
+}
+
+func Valid(value string) bool {
+	return ValueOf(value) != Unknown
+}
+
+func ValuesNames() []string {
+	names := []string{}
+	for _, feature := range Values() {
+		names = append(names, feature.String())
+	}
+	return names
+}
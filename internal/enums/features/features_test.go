@@ -0,0 +1,28 @@
+package features
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	t.Run("Should return valid features", func(t *testing.T) {
+		v := Values()
+		assert.Equal(t, v, []Feature{Swagger, DockerCompose, Tests, Health, CORS})
+	})
+	t.Run("Should return docker-compose feature", func(t *testing.T) {
+		assert.Equal(t, ValueOf("docker-compose"), DockerCompose)
+	})
+	t.Run("Should return unknown feature", func(t *testing.T) {
+		assert.Equal(t, ValueOf("other"), Unknown)
+	})
+	t.Run("Should return invalid enum", func(t *testing.T) {
+		assert.False(t, Valid("other"))
+	})
+	t.Run("Should return valid enum", func(t *testing.T) {
+		assert.True(t, Valid("cors"))
+	})
+	t.Run("Should return names of features", func(t *testing.T) {
+		assert.Equal(t, []string{"swagger", "docker-compose", "tests", "health", "cors"}, ValuesNames())
+	})
+}
@@ -8,6 +8,7 @@ const (
 	ConfigsConfigs                          Files = "configs/configs.go"
 	ConfigsConfigsTest                      Files = "configs/configs_test.go"
 	DeploymentsDockerCompose                Files = "deployments/docker-compose.yaml"
+	DeploymentsDockerfile                   Files = "deployments/Dockerfile"
 	DocsDocs                                Files = "docs/docs.go"
 	DocsSwaggerJSON                         Files = "docs/swagger.json"
 	DocsSwaggerYAML                         Files = "docs/swagger.yaml"
@@ -33,6 +34,8 @@ const (
 	InternalUtilsHTTPResponse               Files = "internal/utils/http/response.go"
 	InternalUtilsLoggerLogger               Files = "internal/utils/logger/logger.go"
 	InternalUtilsLoggerLoggerTest           Files = "internal/utils/logger/logger_test.go"
+	InternalUtilsParamsParams               Files = "internal/utils/params/params.go"
+	InternalUtilsParamsParamsTest           Files = "internal/utils/params/params_test.go"
 	PkgRepositoryAdapterAdapter             Files = "pkg/repository/adapter/adapter.go"
 	PkgRepositoryAdapterAdapterTest         Files = "pkg/repository/adapter/adapter_test.go"
 	PkgRepositoryAdapterAdapterMock         Files = "pkg/repository/adapter/adapter_mock.go"
@@ -42,6 +45,12 @@ const (
 	PkgRepositoryResponseResponse           Files = "pkg/repository/response/response.go"
 	PkgRepositoryResponseResponseTest       Files = "pkg/repository/response/response_test.go"
 	Readme                                  Files = "README.md"
+	DeploymentsKubernetesConfigMap          Files = "deployments/kubernetes/base/configmap.yaml"
+	DeploymentsKubernetesDeployment         Files = "deployments/kubernetes/base/deployment.yaml"
+	DeploymentsKubernetesKustomization      Files = "deployments/kubernetes/base/kustomization.yaml"
+	DeploymentsKubernetesService            Files = "deployments/kubernetes/base/service.yaml"
+	DeploymentsKubernetesDevelopment        Files = "deployments/kubernetes/overlays/development/kustomization.yaml"
+	DeploymentsKubernetesProduction         Files = "deployments/kubernetes/overlays/production/kustomization.yaml"
 )
 
 const (
@@ -59,6 +68,13 @@ func Values() []Files {
 		ConfigsConfigs,
 		ConfigsConfigsTest,
 		DeploymentsDockerCompose,
+		DeploymentsDockerfile,
+		DeploymentsKubernetesConfigMap,
+		DeploymentsKubernetesDeployment,
+		DeploymentsKubernetesKustomization,
+		DeploymentsKubernetesService,
+		DeploymentsKubernetesDevelopment,
+		DeploymentsKubernetesProduction,
 		DocsDocs,
 		DocsSwaggerJSON,
 		DocsSwaggerYAML,
@@ -84,6 +100,8 @@ func Values() []Files {
 		InternalUtilsHTTPResponse,
 		InternalUtilsLoggerLogger,
 		InternalUtilsLoggerLoggerTest,
+		InternalUtilsParamsParams,
+		InternalUtilsParamsParamsTest,
 		PkgRepositoryAdapterAdapter,
 		PkgRepositoryAdapterAdapterTest,
 		PkgRepositoryAdapterAdapterMock,
@@ -7,6 +7,10 @@ const (
 	MigrationsPostgresCreateTableProductsDown  Files = "migrations/postgres/20200607175350_create_table_products.down.sql"
 	MigrationsSQLServerCreateTableProductsUp   Files = "migrations/sqlserver/20200607175350_create_table_products.up.sql"
 	MigrationsSQLServerCreateTableProductsDown Files = "migrations/sqlserver/20200607175350_create_table_products.down.sql"
+	// DeploymentsKubernetesSecret have the DATABASE_URI, only the repositories with database server have it
+	DeploymentsKubernetesSecret Files = "deployments/kubernetes/base/secret.yaml"
+	// DeploymentsKubernetesHPA scale the replicas, only the repositories with database server have it
+	DeploymentsKubernetesHPA Files = "deployments/kubernetes/base/hpa.yaml"
 )
 
 func ValuesGorm() []Files {
@@ -17,5 +21,7 @@ func ValuesGorm() []Files {
 		MigrationsPostgresCreateTableProductsDown,
 		MigrationsSQLServerCreateTableProductsUp,
 		MigrationsSQLServerCreateTableProductsDown,
+		DeploymentsKubernetesSecret,
+		DeploymentsKubernetesHPA,
 	}
 }
@@ -0,0 +1,169 @@
+package files
+
+const (
+	InternalControllersDoctorDoctor                   Files = "internal/controllers/doctor/doctor.go"
+	InternalControllersDoctorDoctorTest               Files = "internal/controllers/doctor/doctor_test.go"
+	InternalControllersDoctorPatientDoctorPatient     Files = "internal/controllers/doctorpatient/doctorpatient.go"
+	InternalControllersDoctorPatientDoctorPatientTest Files = "internal/controllers/doctorpatient/doctorpatient_test.go"
+	InternalControllersOrderOrder                     Files = "internal/controllers/order/order.go"
+	InternalControllersOrderOrderTest                 Files = "internal/controllers/order/order_test.go"
+	InternalControllersPatientPatient                 Files = "internal/controllers/patient/patient.go"
+	InternalControllersPatientPatientTest             Files = "internal/controllers/patient/patient_test.go"
+	InternalControllersRestaurantRestaurant           Files = "internal/controllers/restaurant/restaurant.go"
+	InternalControllersRestaurantRestaurantTest       Files = "internal/controllers/restaurant/restaurant_test.go"
+	InternalEntitiesDoctorDoctor                      Files = "internal/entities/doctor/doctor.go"
+	InternalEntitiesDoctorPatientDoctorPatient        Files = "internal/entities/doctorpatient/doctorpatient.go"
+	InternalEntitiesOrderOrder                        Files = "internal/entities/order/order.go"
+	InternalEntitiesPatientPatient                    Files = "internal/entities/patient/patient.go"
+	InternalEntitiesRestaurantRestaurant              Files = "internal/entities/restaurant/restaurant.go"
+	InternalGraphQLResolversDoctor                    Files = "internal/graphql/resolvers/doctor.go"
+	InternalGraphQLResolversErrors                    Files = "internal/graphql/resolvers/errors.go"
+	InternalGraphQLResolversLoaders                   Files = "internal/graphql/resolvers/loaders.go"
+	InternalGraphQLResolversOrder                     Files = "internal/graphql/resolvers/order.go"
+	InternalGraphQLResolversPatient                   Files = "internal/graphql/resolvers/patient.go"
+	InternalGraphQLResolversProduct                   Files = "internal/graphql/resolvers/product.go"
+	InternalGraphQLResolversResolver                  Files = "internal/graphql/resolvers/resolver.go"
+	InternalGraphQLResolversResolverTest              Files = "internal/graphql/resolvers/resolver_test.go"
+	InternalGraphQLResolversRestaurant                Files = "internal/graphql/resolvers/restaurant.go"
+	InternalGraphQLSchemaDoctor                       Files = "internal/graphql/schema/doctor.go"
+	InternalGraphQLSchemaOrder                        Files = "internal/graphql/schema/order.go"
+	InternalGraphQLSchemaPatient                      Files = "internal/graphql/schema/patient.go"
+	InternalGraphQLSchemaProduct                      Files = "internal/graphql/schema/product.go"
+	InternalGraphQLSchemaRestaurant                   Files = "internal/graphql/schema/restaurant.go"
+	InternalGraphQLSchemaSchema                       Files = "internal/graphql/schema/schema.go"
+	InternalGraphQLSchemaSchemaTest                   Files = "internal/graphql/schema/schema_test.go"
+	InternalHandlersGraphQLGraphQL                    Files = "internal/handlers/graphql/graphql.go"
+	InternalHandlersGraphQLGraphQLTest                Files = "internal/handlers/graphql/graphql_test.go"
+	InternalRulesDoctorDoctor                         Files = "internal/rules/doctor/doctor.go"
+	InternalRulesDoctorDoctorTest                     Files = "internal/rules/doctor/doctor_test.go"
+	InternalRulesDoctorPatientDoctorPatient           Files = "internal/rules/doctorpatient/doctorpatient.go"
+	InternalRulesDoctorPatientDoctorPatientTest       Files = "internal/rules/doctorpatient/doctorpatient_test.go"
+	InternalRulesOrderOrder                           Files = "internal/rules/order/order.go"
+	InternalRulesOrderOrderTest                       Files = "internal/rules/order/order_test.go"
+	InternalRulesPatientPatient                       Files = "internal/rules/patient/patient.go"
+	InternalRulesPatientPatientTest                   Files = "internal/rules/patient/patient_test.go"
+	InternalRulesRestaurantRestaurant                 Files = "internal/rules/restaurant/restaurant.go"
+	InternalRulesRestaurantRestaurantTest             Files = "internal/rules/restaurant/restaurant_test.go"
+	MigrationsMysqlCreateTableRestaurantsDown         Files = "migrations/mysql/20210301100000_create_table_restaurants.down.sql"
+	MigrationsMysqlCreateTableRestaurantsUp           Files = "migrations/mysql/20210301100000_create_table_restaurants.up.sql"
+	MigrationsMysqlCreateTableOrdersDown              Files = "migrations/mysql/20210301100100_create_table_orders.down.sql"
+	MigrationsMysqlCreateTableOrdersUp                Files = "migrations/mysql/20210301100100_create_table_orders.up.sql"
+	MigrationsMysqlCreateTableDoctorsDown             Files = "migrations/mysql/20210301100200_create_table_doctors.down.sql"
+	MigrationsMysqlCreateTableDoctorsUp               Files = "migrations/mysql/20210301100200_create_table_doctors.up.sql"
+	MigrationsMysqlCreateTablePatientsDown            Files = "migrations/mysql/20210301100300_create_table_patients.down.sql"
+	MigrationsMysqlCreateTablePatientsUp              Files = "migrations/mysql/20210301100300_create_table_patients.up.sql"
+	MigrationsMysqlCreateTableDoctorsPatientsDown     Files = "migrations/mysql/20210301100400_create_table_doctors_patients.down.sql"
+	MigrationsMysqlCreateTableDoctorsPatientsUp       Files = "migrations/mysql/20210301100400_create_table_doctors_patients.up.sql"
+	MigrationsPostgresCreateTableRestaurantsDown      Files = "migrations/postgres/20210301100000_create_table_restaurants.down.sql"
+	MigrationsPostgresCreateTableRestaurantsUp        Files = "migrations/postgres/20210301100000_create_table_restaurants.up.sql"
+	MigrationsPostgresCreateTableOrdersDown           Files = "migrations/postgres/20210301100100_create_table_orders.down.sql"
+	MigrationsPostgresCreateTableOrdersUp             Files = "migrations/postgres/20210301100100_create_table_orders.up.sql"
+	MigrationsPostgresCreateTableDoctorsDown          Files = "migrations/postgres/20210301100200_create_table_doctors.down.sql"
+	MigrationsPostgresCreateTableDoctorsUp            Files = "migrations/postgres/20210301100200_create_table_doctors.up.sql"
+	MigrationsPostgresCreateTablePatientsDown         Files = "migrations/postgres/20210301100300_create_table_patients.down.sql"
+	MigrationsPostgresCreateTablePatientsUp           Files = "migrations/postgres/20210301100300_create_table_patients.up.sql"
+	MigrationsPostgresCreateTableDoctorsPatientsDown  Files = "migrations/postgres/20210301100400_create_table_doctors_patients.down.sql"
+	MigrationsPostgresCreateTableDoctorsPatientsUp    Files = "migrations/postgres/20210301100400_create_table_doctors_patients.up.sql"
+	MigrationsSQLServerCreateTableRestaurantsDown     Files = "migrations/sqlserver/20210301100000_create_table_restaurants.down.sql"
+	MigrationsSQLServerCreateTableRestaurantsUp       Files = "migrations/sqlserver/20210301100000_create_table_restaurants.up.sql"
+	MigrationsSQLServerCreateTableOrdersDown          Files = "migrations/sqlserver/20210301100100_create_table_orders.down.sql"
+	MigrationsSQLServerCreateTableOrdersUp            Files = "migrations/sqlserver/20210301100100_create_table_orders.up.sql"
+	MigrationsSQLServerCreateTableDoctorsDown         Files = "migrations/sqlserver/20210301100200_create_table_doctors.down.sql"
+	MigrationsSQLServerCreateTableDoctorsUp           Files = "migrations/sqlserver/20210301100200_create_table_doctors.up.sql"
+	MigrationsSQLServerCreateTablePatientsDown        Files = "migrations/sqlserver/20210301100300_create_table_patients.down.sql"
+	MigrationsSQLServerCreateTablePatientsUp          Files = "migrations/sqlserver/20210301100300_create_table_patients.up.sql"
+	MigrationsSQLServerCreateTableDoctorsPatientsDown Files = "migrations/sqlserver/20210301100400_create_table_doctors_patients.down.sql"
+	MigrationsSQLServerCreateTableDoctorsPatientsUp   Files = "migrations/sqlserver/20210301100400_create_table_doctors_patients.up.sql"
+)
+
+func ValuesGraphQL() []Files {
+	return []Files{
+		InternalControllersDoctorDoctor,
+		InternalControllersDoctorDoctorTest,
+		InternalControllersDoctorPatientDoctorPatient,
+		InternalControllersDoctorPatientDoctorPatientTest,
+		InternalControllersOrderOrder,
+		InternalControllersOrderOrderTest,
+		InternalControllersPatientPatient,
+		InternalControllersPatientPatientTest,
+		InternalControllersRestaurantRestaurant,
+		InternalControllersRestaurantRestaurantTest,
+		InternalEntitiesDoctorDoctor,
+		InternalEntitiesDoctorPatientDoctorPatient,
+		InternalEntitiesOrderOrder,
+		InternalEntitiesPatientPatient,
+		InternalEntitiesRestaurantRestaurant,
+		InternalGraphQLResolversDoctor,
+		InternalGraphQLResolversErrors,
+		InternalGraphQLResolversLoaders,
+		InternalGraphQLResolversOrder,
+		InternalGraphQLResolversPatient,
+		InternalGraphQLResolversProduct,
+		InternalGraphQLResolversResolver,
+		InternalGraphQLResolversResolverTest,
+		InternalGraphQLResolversRestaurant,
+		InternalGraphQLSchemaDoctor,
+		InternalGraphQLSchemaOrder,
+		InternalGraphQLSchemaPatient,
+		InternalGraphQLSchemaProduct,
+		InternalGraphQLSchemaRestaurant,
+		InternalGraphQLSchemaSchema,
+		InternalGraphQLSchemaSchemaTest,
+		InternalHandlersGraphQLGraphQL,
+		InternalHandlersGraphQLGraphQLTest,
+		InternalRulesDoctorDoctor,
+		InternalRulesDoctorDoctorTest,
+		InternalRulesDoctorPatientDoctorPatient,
+		InternalRulesDoctorPatientDoctorPatientTest,
+		InternalRulesOrderOrder,
+		InternalRulesOrderOrderTest,
+		InternalRulesPatientPatient,
+		InternalRulesPatientPatientTest,
+		InternalRulesRestaurantRestaurant,
+		InternalRulesRestaurantRestaurantTest,
+		MigrationsMysqlCreateTableRestaurantsDown,
+		MigrationsMysqlCreateTableRestaurantsUp,
+		MigrationsMysqlCreateTableOrdersDown,
+		MigrationsMysqlCreateTableOrdersUp,
+		MigrationsMysqlCreateTableDoctorsDown,
+		MigrationsMysqlCreateTableDoctorsUp,
+		MigrationsMysqlCreateTablePatientsDown,
+		MigrationsMysqlCreateTablePatientsUp,
+		MigrationsMysqlCreateTableDoctorsPatientsDown,
+		MigrationsMysqlCreateTableDoctorsPatientsUp,
+		MigrationsPostgresCreateTableRestaurantsDown,
+		MigrationsPostgresCreateTableRestaurantsUp,
+		MigrationsPostgresCreateTableOrdersDown,
+		MigrationsPostgresCreateTableOrdersUp,
+		MigrationsPostgresCreateTableDoctorsDown,
+		MigrationsPostgresCreateTableDoctorsUp,
+		MigrationsPostgresCreateTablePatientsDown,
+		MigrationsPostgresCreateTablePatientsUp,
+		MigrationsPostgresCreateTableDoctorsPatientsDown,
+		MigrationsPostgresCreateTableDoctorsPatientsUp,
+		MigrationsSQLServerCreateTableRestaurantsDown,
+		MigrationsSQLServerCreateTableRestaurantsUp,
+		MigrationsSQLServerCreateTableOrdersDown,
+		MigrationsSQLServerCreateTableOrdersUp,
+		MigrationsSQLServerCreateTableDoctorsDown,
+		MigrationsSQLServerCreateTableDoctorsUp,
+		MigrationsSQLServerCreateTablePatientsDown,
+		MigrationsSQLServerCreateTablePatientsUp,
+		MigrationsSQLServerCreateTableDoctorsPatientsDown,
+		MigrationsSQLServerCreateTableDoctorsPatientsUp,
+	}
+}
+
+// ValuesChangedGraphQL are the files of the template of gorm changed by the graphql, the other files of the gorm are
+// not in the template of graphql and are layered by the generator
+func ValuesChangedGraphQL() []Files {
+	return []Files{
+		Readme,
+		CmdMain,
+		DocsDocs,
+		DocsSwaggerJSON,
+		DocsSwaggerYAML,
+		InternalRoutesRoutes,
+		PkgRepositoryDatabaseDatabase,
+	}
+}
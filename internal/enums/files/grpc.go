@@ -0,0 +1,41 @@
+package files
+
+const (
+	APIProtoProductProto          Files = "api/proto/product/product.proto"
+	APIProtoProductPb             Files = "api/proto/product/product.pb.go"
+	APIProtoProductGrpcPb         Files = "api/proto/product/product_grpc.pb.go"
+	InternalGrpcProductServer     Files = "internal/grpc/product/server.go"
+	InternalGrpcProductServerTest Files = "internal/grpc/product/server_test.go"
+	InternalGrpcServerServer      Files = "internal/grpc/server/server.go"
+	InternalGrpcServerServerTest  Files = "internal/grpc/server/server_test.go"
+)
+
+func ValuesGRPC() []Files {
+	return []Files{
+		APIProtoProductProto,
+		APIProtoProductPb,
+		APIProtoProductGrpcPb,
+		InternalGrpcProductServer,
+		InternalGrpcProductServerTest,
+		InternalGrpcServerServer,
+		InternalGrpcServerServerTest,
+	}
+}
+
+// ValuesChangedGRPC are the files of the template of gorm changed by the grpc, the other files of the gorm are not in
+// the template of grpc and are layered by the generator
+func ValuesChangedGRPC() []Files {
+	return []Files{
+		Readme,
+		CmdMain,
+		ConfigsConfigs,
+		ConfigsConfigsTest,
+		DeploymentsDockerfile,
+		DeploymentsKubernetesConfigMap,
+		DeploymentsKubernetesDeployment,
+		DeploymentsKubernetesService,
+		DocsDocs,
+		DocsSwaggerJSON,
+		DocsSwaggerYAML,
+	}
+}
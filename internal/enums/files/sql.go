@@ -0,0 +1,29 @@
+package files
+
+const (
+	MigrationsSQLite3CreateTableProductsUp   Files = "migrations/sqlite3/20200607175350_create_table_products.up.sql"
+	MigrationsSQLite3CreateTableProductsDown Files = "migrations/sqlite3/20200607175350_create_table_products.down.sql"
+	PkgRepositoryMigrationMigration          Files = "pkg/repository/migration/migration.go"
+	PkgRepositoryMigrationMigrationTest      Files = "pkg/repository/migration/migration_test.go"
+	PkgRepositoryQueryQuery                  Files = "pkg/repository/query/query.go"
+	PkgRepositoryQueryQueryTest              Files = "pkg/repository/query/query_test.go"
+)
+
+func ValuesSQL() []Files {
+	return []Files{
+		MigrationsMysqlCreateTableProductsUp,
+		MigrationsMysqlCreateTableProductsDown,
+		MigrationsPostgresCreateTableProductsUp,
+		MigrationsPostgresCreateTableProductsDown,
+		MigrationsSQLServerCreateTableProductsUp,
+		MigrationsSQLServerCreateTableProductsDown,
+		MigrationsSQLite3CreateTableProductsUp,
+		MigrationsSQLite3CreateTableProductsDown,
+		PkgRepositoryMigrationMigration,
+		PkgRepositoryMigrationMigrationTest,
+		PkgRepositoryQueryQuery,
+		PkgRepositoryQueryQueryTest,
+		DeploymentsKubernetesSecret,
+		DeploymentsKubernetesHPA,
+	}
+}
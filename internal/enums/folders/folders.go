@@ -23,6 +23,7 @@ const (
 	InternalUtilsEnvironment   Folders = "internal/utils/environment"
 	InternalUtilsHTTP          Folders = "internal/utils/http"
 	InternalUtilsLogger        Folders = "internal/utils/logger"
+	InternalUtilsParams        Folders = "internal/utils/params"
 	Migrations                 Folders = "migrations"
 	Pkg                        Folders = "pkg"
 	PkgRepository              Folders = "pkg/repository"
@@ -30,6 +31,16 @@ const (
 	PkgRepositoryDatabase      Folders = "pkg/repository/database"
 	PkgRepositoryEntities      Folders = "pkg/repository/entities"
 	PkgRepositoryResponse      Folders = "pkg/repository/response"
+	// PkgClient is generated by the command client, not by the templates
+	PkgClient Folders = "pkg/client"
+	// InternalMiddlewares is generated by the command add middleware, not by the templates
+	InternalMiddlewares Folders = "internal/middlewares"
+	// DeploymentsKubernetes are the folders of the manifests of the kubernetes with kustomize
+	DeploymentsKubernetes            Folders = "deployments/kubernetes"
+	DeploymentsKubernetesBase        Folders = "deployments/kubernetes/base"
+	DeploymentsKubernetesOverlays    Folders = "deployments/kubernetes/overlays"
+	DeploymentsKubernetesDevelopment Folders = "deployments/kubernetes/overlays/development"
+	DeploymentsKubernetesProduction  Folders = "deployments/kubernetes/overlays/production"
 )
 
 // nolint
@@ -39,6 +50,11 @@ func Values() []Folders {
 		Configs,
 		Docs,
 		Deployments,
+		DeploymentsKubernetes,
+		DeploymentsKubernetesBase,
+		DeploymentsKubernetesOverlays,
+		DeploymentsKubernetesDevelopment,
+		DeploymentsKubernetesProduction,
 		Internal,
 		InternalControllers,
 		InternalControllersProduct,
@@ -61,6 +77,7 @@ func Values() []Folders {
 		InternalUtilsEnvironment,
 		InternalUtilsHTTP,
 		InternalUtilsLogger,
+		InternalUtilsParams,
 		Migrations,
 	}
 }
@@ -0,0 +1,47 @@
+package folders
+
+const (
+	InternalControllersDoctor        Folders = "internal/controllers/doctor"
+	InternalControllersDoctorPatient Folders = "internal/controllers/doctorpatient"
+	InternalControllersOrder         Folders = "internal/controllers/order"
+	InternalControllersPatient       Folders = "internal/controllers/patient"
+	InternalControllersRestaurant    Folders = "internal/controllers/restaurant"
+	InternalEntitiesDoctor           Folders = "internal/entities/doctor"
+	InternalEntitiesDoctorPatient    Folders = "internal/entities/doctorpatient"
+	InternalEntitiesOrder            Folders = "internal/entities/order"
+	InternalEntitiesPatient          Folders = "internal/entities/patient"
+	InternalEntitiesRestaurant       Folders = "internal/entities/restaurant"
+	InternalGraphQL                  Folders = "internal/graphql"
+	InternalGraphQLResolvers         Folders = "internal/graphql/resolvers"
+	InternalGraphQLSchema            Folders = "internal/graphql/schema"
+	InternalHandlersGraphQL          Folders = "internal/handlers/graphql"
+	InternalRulesDoctor              Folders = "internal/rules/doctor"
+	InternalRulesDoctorPatient       Folders = "internal/rules/doctorpatient"
+	InternalRulesOrder               Folders = "internal/rules/order"
+	InternalRulesPatient             Folders = "internal/rules/patient"
+	InternalRulesRestaurant          Folders = "internal/rules/restaurant"
+)
+
+func ValuesGraphQL() []Folders {
+	return []Folders{
+		InternalControllersDoctor,
+		InternalControllersDoctorPatient,
+		InternalControllersOrder,
+		InternalControllersPatient,
+		InternalControllersRestaurant,
+		InternalEntitiesDoctor,
+		InternalEntitiesDoctorPatient,
+		InternalEntitiesOrder,
+		InternalEntitiesPatient,
+		InternalEntitiesRestaurant,
+		InternalGraphQL,
+		InternalGraphQLResolvers,
+		InternalGraphQLSchema,
+		InternalHandlersGraphQL,
+		InternalRulesDoctor,
+		InternalRulesDoctorPatient,
+		InternalRulesOrder,
+		InternalRulesPatient,
+		InternalRulesRestaurant,
+	}
+}
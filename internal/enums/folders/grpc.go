@@ -0,0 +1,21 @@
+package folders
+
+const (
+	API                 Folders = "api"
+	APIProto            Folders = "api/proto"
+	APIProtoProduct     Folders = "api/proto/product"
+	InternalGrpc        Folders = "internal/grpc"
+	InternalGrpcProduct Folders = "internal/grpc/product"
+	InternalGrpcServer  Folders = "internal/grpc/server"
+)
+
+func ValuesGRPC() []Folders {
+	return []Folders{
+		API,
+		APIProto,
+		APIProtoProduct,
+		InternalGrpc,
+		InternalGrpcProduct,
+		InternalGrpcServer,
+	}
+}
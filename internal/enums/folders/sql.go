@@ -0,0 +1,18 @@
+package folders
+
+const (
+	MigrationsSQLite3      Folders = "migrations/sqlite3"
+	PkgRepositoryMigration Folders = "pkg/repository/migration"
+	PkgRepositoryQuery     Folders = "pkg/repository/query"
+)
+
+func ValuesSQL() []Folders {
+	return []Folders{
+		MigrationsMysql,
+		MigrationsPostgres,
+		MigrationsSQLServer,
+		MigrationsSQLite3,
+		PkgRepositoryMigration,
+		PkgRepositoryQuery,
+	}
+}
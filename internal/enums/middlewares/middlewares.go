@@ -0,0 +1,48 @@
+package middlewares
+
+type Middleware string
+
+const (
+	// Empty is the middleware that only call the next handler, to be written from scratch
+	Empty     Middleware = "empty"
+	Auth      Middleware = "auth"
+	RateLimit Middleware = "ratelimit"
+	Logging   Middleware = "logging"
+	BodyLimit Middleware = "bodylimit"
+	Unknown   Middleware = "unknown"
+)
+
+func (m Middleware) String() string {
+	return string(m)
+}
+
+func Values() []Middleware {
+	return []Middleware{
+		Empty,
+		Auth,
+		RateLimit,
+		Logging,
+		BodyLimit,
+	}
+}
+
+func ValueOf(value string) Middleware {
+	for _, middleware := range Values() {
+		if string(middleware) == value {
+			return middleware
+		}
+	}
+	return Unknown
+}
+
+func Valid(value string) bool {
+	return ValueOf(value) != Unknown
+}
+
+func ValuesNames() []string {
+	names := []string{}
+	for _, middleware := range Values() {
+		names = append(names, middleware.String())
+	}
+	return names
+}
@@ -0,0 +1,28 @@
+package middlewares
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	t.Run("Should return valid middlewares", func(t *testing.T) {
+		v := Values()
+		assert.Equal(t, v, []Middleware{Empty, Auth, RateLimit, Logging, BodyLimit})
+	})
+	t.Run("Should return rate limit middleware", func(t *testing.T) {
+		assert.Equal(t, ValueOf("ratelimit"), RateLimit)
+	})
+	t.Run("Should return unknown middleware", func(t *testing.T) {
+		assert.Equal(t, ValueOf("other"), Unknown)
+	})
+	t.Run("Should return invalid enum", func(t *testing.T) {
+		assert.False(t, Valid("other"))
+	})
+	t.Run("Should return valid enum", func(t *testing.T) {
+		assert.True(t, Valid("auth"))
+	})
+	t.Run("Should return names of middlewares", func(t *testing.T) {
+		assert.Equal(t, []string{"empty", "auth", "ratelimit", "logging", "bodylimit"}, ValuesNames())
+	})
+}
@@ -4,6 +4,8 @@ type Command string
 
 const (
 	App     Command = "app"
+	GRPC    Command = "grpc"
+	GraphQL Command = "graphql"
 	Unknown Command = "unknown"
 )
 
@@ -14,6 +16,8 @@ func (c Command) String() string {
 func Values() []Command {
 	return []Command{
 		App,
+		GRPC,
+		GraphQL,
 	}
 }
 
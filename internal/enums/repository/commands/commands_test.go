@@ -8,11 +8,17 @@ import (
 func TestEnum(t *testing.T) {
 	t.Run("Should return valid repository", func(t *testing.T) {
 		v := Values()
-		assert.Equal(t, v, []Command{App})
+		assert.Equal(t, v, []Command{App, GRPC, GraphQL})
 	})
 	t.Run("Should return app repository", func(t *testing.T) {
 		assert.Equal(t, ValueOf("app"), App)
 	})
+	t.Run("Should return grpc repository", func(t *testing.T) {
+		assert.Equal(t, ValueOf("grpc"), GRPC)
+	})
+	t.Run("Should return graphql repository", func(t *testing.T) {
+		assert.Equal(t, ValueOf("graphql"), GraphQL)
+	})
 	t.Run("Should return unknown repository", func(t *testing.T) {
 		assert.Equal(t, ValueOf("other"), Unknown)
 	})
@@ -4,6 +4,10 @@ type Repository string
 
 const (
 	Gorm    Repository = "gorm"
+	SQL     Repository = "sql"
+	Gorm2   Repository = "gorm2"
+	Bolt    Repository = "bolt"
+	Memory  Repository = "memory"
 	Unknown Repository = "unknown"
 )
 
@@ -14,6 +18,10 @@ func (r Repository) String() string {
 func Values() []Repository {
 	return []Repository{
 		Gorm,
+		SQL,
+		Gorm2,
+		Bolt,
+		Memory,
 	}
 }
 
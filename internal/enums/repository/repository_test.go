@@ -8,11 +8,23 @@ import (
 func TestEnum(t *testing.T) {
 	t.Run("Should return valid repository", func(t *testing.T) {
 		v := Values()
-		assert.Equal(t, v, []Repository{Gorm})
+		assert.Equal(t, v, []Repository{Gorm, SQL, Gorm2, Bolt, Memory})
 	})
 	t.Run("Should return gorm repository", func(t *testing.T) {
 		assert.Equal(t, ValueOf("gorm"), Gorm)
 	})
+	t.Run("Should return sql repository", func(t *testing.T) {
+		assert.Equal(t, ValueOf("sql"), SQL)
+	})
+	t.Run("Should return gorm2 repository", func(t *testing.T) {
+		assert.Equal(t, ValueOf("gorm2"), Gorm2)
+	})
+	t.Run("Should return bolt repository", func(t *testing.T) {
+		assert.Equal(t, ValueOf("bolt"), Bolt)
+	})
+	t.Run("Should return memory repository", func(t *testing.T) {
+		assert.Equal(t, ValueOf("memory"), Memory)
+	})
 	t.Run("Should return unknown repository", func(t *testing.T) {
 		assert.Equal(t, ValueOf("other"), Unknown)
 	})
@@ -0,0 +1,45 @@
+package routers
+
+type Router string
+
+const (
+	Chi     Router = "chi"
+	Stdlib  Router = "stdlib"
+	Gin     Router = "gin"
+	Echo    Router = "echo"
+	Unknown Router = "unknown"
+)
+
+func (r Router) String() string {
+	return string(r)
+}
+
+func Values() []Router {
+	return []Router{
+		Chi,
+		Stdlib,
+		Gin,
+		Echo,
+	}
+}
+
+func ValueOf(value string) Router {
+	for _, router := range Values() {
+		if string(router) == value {
+			return router
+		}
+	}
+	return Unknown
+}
+
+func Valid(value string) bool {
+	return ValueOf(value) != Unknown
+}
+
+func ValuesNames() []string {
+	names := []string{}
+	for _, router := range Values() {
+		names = append(names, router.String())
+	}
+	return names
+}
@@ -0,0 +1,28 @@
+package routers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnum(t *testing.T) {
+	t.Run("Should return valid routers", func(t *testing.T) {
+		v := Values()
+		assert.Equal(t, v, []Router{Chi, Stdlib, Gin, Echo})
+	})
+	t.Run("Should return gin router", func(t *testing.T) {
+		assert.Equal(t, ValueOf("gin"), Gin)
+	})
+	t.Run("Should return unknown router", func(t *testing.T) {
+		assert.Equal(t, ValueOf("other"), Unknown)
+	})
+	t.Run("Should return invalid enum", func(t *testing.T) {
+		assert.False(t, Valid("other"))
+	})
+	t.Run("Should return valid enum", func(t *testing.T) {
+		assert.True(t, Valid("echo"))
+	})
+	t.Run("Should return names of routers", func(t *testing.T) {
+		assert.Equal(t, []string{"chi", "stdlib", "gin", "echo"}, ValuesNames())
+	})
+}
@@ -0,0 +1,63 @@
+package testutil
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/mod/modfile"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// TemplateModule is the module of the template copied by the CopyTemplate, the same of the imports of the template
+const TemplateModule = "github.com/wilian746/go-generator/pkg/standart-gorm"
+
+// CopyTemplate copy the template pkg/standart-gorm to a temporary folder with the go.mod of the TemplateModule, so
+// the tests can change the project without change the template, the folder must be removed by the test
+func CopyTemplate(t *testing.T) string {
+	_, current, _, _ := runtime.Caller(0)
+	dir := CopyFolder(t, filepath.Join(filepath.Dir(current), "..", "..", "pkg", "standart-gorm"))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module "+TemplateModule+"\n"), 0600))
+	return dir
+}
+
+// WriteGoModOfRoot write in the dir the go.mod and the go.sum of the go-generator with the TemplateModule, so the
+// template copied is built with the same dependencies of the templates in the go-generator
+func WriteGoModOfRoot(t *testing.T, dir string) {
+	_, current, _, _ := runtime.Caller(0)
+	root := filepath.Join(filepath.Dir(current), "..", "..")
+	content, err := ioutil.ReadFile(filepath.Join(root, "go.mod"))
+	assert.NoError(t, err)
+	file, err := modfile.Parse("go.mod", content, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, file.AddModuleStmt(TemplateModule))
+	content, err = file.Format()
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), content, 0600))
+	content, err = ioutil.ReadFile(filepath.Join(root, "go.sum"))
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.sum"), content, 0600))
+}
+
+// CopyFolder copy the folder to a temporary folder, the folder must be removed by the test
+func CopyFolder(t *testing.T, source string) string {
+	dir, err := ioutil.TempDir("", "go-generator")
+	assert.NoError(t, err)
+	err = filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relative, _ := filepath.Rel(source, path)
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, relative), os.ModePerm)
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dir, relative), content, 0600)
+	})
+	assert.NoError(t, err)
+	return dir
+}
@@ -3,14 +3,24 @@ package repository
 import (
 	"fmt"
 	entitiesRepository "github.com/wilian746/go-generator/internal/entities/repository"
+	enumsDialects "github.com/wilian746/go-generator/internal/enums/dialects"
 	enumsRepository "github.com/wilian746/go-generator/internal/enums/repository"
 	enumsCommands "github.com/wilian746/go-generator/internal/enums/repository/commands"
 )
 
 func setupRepositories() (repositories []entitiesRepository.Repository) {
 	for _, repo := range enumsRepository.Values() {
-		if repo == enumsRepository.Gorm {
+		switch repo {
+		case enumsRepository.Gorm:
 			repositories = append(repositories, setupGormRepository())
+		case enumsRepository.SQL:
+			repositories = append(repositories, setupSQLRepository())
+		case enumsRepository.Gorm2:
+			repositories = append(repositories, setupGorm2Repository())
+		case enumsRepository.Bolt:
+			repositories = append(repositories, setupBoltRepository())
+		case enumsRepository.Memory:
+			repositories = append(repositories, setupMemoryRepository())
 		}
 	}
 	return repositories
@@ -18,10 +28,41 @@ func setupRepositories() (repositories []entitiesRepository.Repository) {
 
 func setupGormRepository() entitiesRepository.Repository {
 	repository := entitiesRepository.Repository{Name: enumsRepository.Gorm}
+	repository.Commands = append(repository.Commands, enumsCommands.App, enumsCommands.GRPC, enumsCommands.GraphQL)
+	repository.Dialects = enumsDialects.Values()
+	return repository
+}
+
+func setupSQLRepository() entitiesRepository.Repository {
+	repository := entitiesRepository.Repository{Name: enumsRepository.SQL}
+	repository.Commands = append(repository.Commands, enumsCommands.App)
+	repository.Dialects = enumsDialects.Values()
+	return repository
+}
+
+func setupGorm2Repository() entitiesRepository.Repository {
+	repository := entitiesRepository.Repository{Name: enumsRepository.Gorm2}
+	repository.Commands = append(repository.Commands, enumsCommands.App)
+	repository.Dialects = enumsDialects.Values()
+	return repository
+}
+
+func setupBoltRepository() entitiesRepository.Repository {
+	repository := entitiesRepository.Repository{Name: enumsRepository.Bolt}
+	repository.Commands = append(repository.Commands, enumsCommands.App)
+	return repository
+}
+
+func setupMemoryRepository() entitiesRepository.Repository {
+	repository := entitiesRepository.Repository{Name: enumsRepository.Memory}
 	repository.Commands = append(repository.Commands, enumsCommands.App)
 	return repository
 }
 
+func GetRepositories() []entitiesRepository.Repository {
+	return setupRepositories()
+}
+
 func IsValidRepositoryAndCommand(repository, command string) bool {
 	repositories := setupRepositories()
 	for _, existingRepository := range repositories {
@@ -47,13 +88,39 @@ func GetCommandsValidByRepository(repository string) (validCommands []string) {
 }
 
 func GetAvailableCommands() (examples string) {
+	for _, example := range GetAvailableCommandsExamples() {
+		examples += example + "\n        "
+	}
+	return examples
+}
+
+func GetAvailableCommandsExamples() (examples []string) {
 	repositories := setupRepositories()
 	for _, existingRepository := range repositories {
 		for _, existingCommand := range existingRepository.Commands {
-			examples += fmt.Sprintf("go-generator init %s %s"+
-				"\n        ",
-				existingRepository.Name.String(), existingCommand.String())
+			examples = append(examples, fmt.Sprintf("go-generator init %s %s",
+				existingRepository.Name.String(), existingCommand.String()))
 		}
 	}
 	return examples
 }
+
+func GetRepositoriesNames() (names []string) {
+	for _, existingRepository := range setupRepositories() {
+		names = append(names, existingRepository.Name.String())
+	}
+	return names
+}
+
+func GetCommandsNames() (names []string) {
+	existingNames := map[string]bool{}
+	for _, existingRepository := range setupRepositories() {
+		for _, existingCommand := range existingRepository.GetListCommands() {
+			if !existingNames[existingCommand] {
+				existingNames[existingCommand] = true
+				names = append(names, existingCommand)
+			}
+		}
+	}
+	return names
+}
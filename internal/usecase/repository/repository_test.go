@@ -11,9 +11,30 @@ func TestIsValidRepositoryAndCommand(t *testing.T) {
 	t.Run("Should pass gorm and app to validate and return true", func(t *testing.T) {
 		assert.True(t, IsValidRepositoryAndCommand("gorm", "app"))
 	})
+	t.Run("Should pass sql and app to validate and return true", func(t *testing.T) {
+		assert.True(t, IsValidRepositoryAndCommand("sql", "app"))
+	})
+	t.Run("Should pass gorm2 and app to validate and return true", func(t *testing.T) {
+		assert.True(t, IsValidRepositoryAndCommand("gorm2", "app"))
+	})
+	t.Run("Should pass bolt and app to validate and return true", func(t *testing.T) {
+		assert.True(t, IsValidRepositoryAndCommand("bolt", "app"))
+	})
+	t.Run("Should pass memory and app to validate and return true", func(t *testing.T) {
+		assert.True(t, IsValidRepositoryAndCommand("memory", "app"))
+	})
 	t.Run("Should pass mongo and app to validate and return false", func(t *testing.T) {
 		assert.False(t, IsValidRepositoryAndCommand("mongo", "app"))
 	})
+	t.Run("Should pass gorm and grpc to validate and return true", func(t *testing.T) {
+		assert.True(t, IsValidRepositoryAndCommand("gorm", "grpc"))
+	})
+	t.Run("Should pass gorm and graphql to validate and return true", func(t *testing.T) {
+		assert.True(t, IsValidRepositoryAndCommand("gorm", "graphql"))
+	})
+	t.Run("Should pass sql and grpc to validate and return false", func(t *testing.T) {
+		assert.False(t, IsValidRepositoryAndCommand("sql", "grpc"))
+	})
 	t.Run("Should pass gorm and controller to validate and return false", func(t *testing.T) {
 		assert.False(t, IsValidRepositoryAndCommand("gorm", "controller"))
 	})
@@ -23,10 +44,12 @@ func TestIsValidRepositoryAndCommand(t *testing.T) {
 }
 
 func TestGetCommandsValidByRepository(t *testing.T) {
-	t.Run("Should return one command whith app, when get all commands from repository gorm", func(t *testing.T) {
+	t.Run("Should return commands app, grpc and graphql, when get all commands from repository gorm", func(t *testing.T) {
 		commands := GetCommandsValidByRepository(enumsRepository.Gorm.String())
-		assert.Len(t, commands, 1)
+		assert.Len(t, commands, 3)
 		assert.Equal(t, commands[0], enumsCommands.App.String())
+		assert.Equal(t, commands[1], enumsCommands.GRPC.String())
+		assert.Equal(t, commands[2], enumsCommands.GraphQL.String())
 	})
 	t.Run("Should return commands empty, when get all commands from repository unknown", func(t *testing.T) {
 		commands := GetCommandsValidByRepository(enumsRepository.Unknown.String())
@@ -38,5 +61,42 @@ func TestGetAvailableCommands(t *testing.T) {
 	t.Run("Check if exists all commands available", func(t *testing.T) {
 		examples := GetAvailableCommands()
 		assert.Contains(t, examples, "go-generator init gorm app")
+		assert.Contains(t, examples, "go-generator init sql app")
+		assert.Contains(t, examples, "go-generator init gorm2 app")
+		assert.Contains(t, examples, "go-generator init bolt app")
+		assert.Contains(t, examples, "go-generator init memory app")
+		assert.Contains(t, examples, "go-generator init gorm grpc")
+		assert.Contains(t, examples, "go-generator init gorm graphql")
+	})
+}
+
+func TestGetRepositories(t *testing.T) {
+	t.Run("Should return all repositories with all dialects", func(t *testing.T) {
+		repositories := GetRepositories()
+		assert.Len(t, repositories, 5)
+		assert.Equal(t, enumsRepository.Gorm, repositories[0].Name)
+		assert.Equal(t, enumsRepository.SQL, repositories[1].Name)
+		assert.Equal(t, enumsRepository.Gorm2, repositories[2].Name)
+		assert.Equal(t, enumsRepository.Bolt, repositories[3].Name)
+		assert.Equal(t, enumsRepository.Memory, repositories[4].Name)
+		for _, repository := range repositories[:3] {
+			assert.Equal(t, []string{"mysql", "postgres", "sqlite3", "mssql"}, repository.GetListDialects())
+		}
+	})
+	t.Run("Should return bolt and memory without dialects because are embedded", func(t *testing.T) {
+		assert.Empty(t, GetRepositories()[3].GetListDialects())
+		assert.Empty(t, GetRepositories()[4].GetListDialects())
+	})
+}
+
+func TestGetRepositoriesNames(t *testing.T) {
+	t.Run("Should return name of all repositories", func(t *testing.T) {
+		assert.Equal(t, []string{"gorm", "sql", "gorm2", "bolt", "memory"}, GetRepositoriesNames())
+	})
+}
+
+func TestGetCommandsNames(t *testing.T) {
+	t.Run("Should return name of all commands without duplicates", func(t *testing.T) {
+		assert.Equal(t, []string{"app", "grpc", "graphql"}, GetCommandsNames())
 	})
 }
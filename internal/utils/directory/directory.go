@@ -0,0 +1,49 @@
+package directory
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// CheckWritable check if the files can be created in the path without create it, when the path not exists is checked
+// the nearest parent that exists
+func CheckWritable(path string) error {
+	existing, err := getNearestExisting(path)
+	if err != nil {
+		return err
+	}
+	file, err := ioutil.TempFile(existing, ".go-generator-")
+	if err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(file.Name())
+}
+
+func getNearestExisting(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	for {
+		info, err := os.Stat(path)
+		if err == nil {
+			if !info.IsDir() {
+				return "", fmt.Errorf("%s is not a directory", path)
+			}
+			return path, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return "", err
+		}
+		path = parent
+	}
+}
@@ -0,0 +1,40 @@
+package directory
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckWritable(t *testing.T) {
+	t.Run("Should return nil when directory is writable and not keep files", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "directory")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		assert.NoError(t, CheckWritable(dir))
+		content, _ := ioutil.ReadDir(dir)
+		assert.Empty(t, content)
+	})
+	t.Run("Should check the parent and not create directory when it not exists", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "directory")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "new", "folder")
+
+		assert.NoError(t, CheckWritable(path))
+		assert.NoDirExists(t, filepath.Join(dir, "new"))
+		content, _ := ioutil.ReadDir(dir)
+		assert.Empty(t, content)
+	})
+	t.Run("Should return error when path is a file", func(t *testing.T) {
+		file, err := ioutil.TempFile("", "directory")
+		assert.NoError(t, err)
+		defer os.Remove(file.Name())
+
+		assert.Error(t, CheckWritable(file.Name()))
+		assert.Error(t, CheckWritable(filepath.Join(file.Name(), "folder")))
+	})
+}
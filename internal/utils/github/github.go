@@ -2,13 +2,17 @@ package github
 
 import (
 	"fmt"
+	"github.com/wilian746/go-generator/internal/utils/logger"
 	"io/ioutil"
 	"net/http"
 )
 
+const URLBase = "https://raw.githubusercontent.com/wilian746/go-generator"
+
 func GetFileFromGithub(routerGithub string) ([]byte, error) {
-	urlBase := "https://raw.githubusercontent.com/wilian746/go-generator/"
-	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", urlBase, routerGithub), nil)
+	url := fmt.Sprintf("%s/%s", URLBase, routerGithub)
+	logger.DEBUG("Fetching template file", url)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return []byte{}, err
 	}
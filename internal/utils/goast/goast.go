@@ -0,0 +1,318 @@
+package goast
+
+import (
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Editor change go files finding the nodes by the AST and removing the lines of the nodes from the source or
+// inserting the code added in the source, so the comments and the format of the rest of the file are preserved. The
+// first error stop the next changes
+type Editor struct {
+	content []byte
+	err     error
+}
+
+type lines struct {
+	start int
+	end   int
+}
+
+func NewEditor(content []byte) *Editor {
+	return &Editor{content: content}
+}
+
+func (e *Editor) Bytes() ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return format.Source(e.content)
+}
+
+func (e *Editor) RemoveImport(path string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		for _, spec := range file.Imports {
+			if value, _ := strconv.Unquote(spec.Path.Value); value == path {
+				found = append(found, getLines(fset, spec.Doc, spec))
+			}
+		}
+		return found
+	})
+}
+
+// RemoveImportIfUnused remove the import when the name of the package is not used in the file
+func (e *Editor) RemoveImportIfUnused(path, name string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) []lines {
+		if isUsed(file, name) {
+			return nil
+		}
+		for _, spec := range file.Imports {
+			if value, _ := strconv.Unquote(spec.Path.Value); value == path {
+				return []lines{getLines(fset, spec.Doc, spec)}
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveFunc remove the functions and methods with the name with your doc
+func (e *Editor) RemoveFunc(name string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		for _, decl := range file.Decls {
+			if function, ok := decl.(*ast.FuncDecl); ok && function.Name.Name == name {
+				found = append(found, getLines(fset, function.Doc, function))
+			}
+		}
+		return found
+	})
+}
+
+// RemoveCalls remove the statements of the function funcName that call callName, like r.EnableCORS() or
+// t.Run("title", ...), when args are informed the call is removed only if the first args are these strings
+func (e *Editor) RemoveCalls(funcName, callName string, args ...string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Name.Name != funcName || function.Body == nil {
+				continue
+			}
+			ast.Inspect(function.Body, func(node ast.Node) bool {
+				if stmt, ok := node.(*ast.ExprStmt); ok && isCall(stmt.X, callName, args) {
+					found = append(found, getLines(fset, nil, stmt))
+					return false
+				}
+				return true
+			})
+		}
+		return found
+	})
+}
+
+// RemoveStmtsUsing remove the statements of the function funcName that use pkg.name, like entity := &product.Product{},
+// and the next statements that use the variables declared by them, like the AutoMigrate(entity)
+func (e *Editor) RemoveStmtsUsing(funcName, pkg, name string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Name.Name != funcName || function.Body == nil {
+				continue
+			}
+			declared := map[string]bool{}
+			for _, stmt := range function.Body.List {
+				if !isSelectorUsed(stmt, pkg, name) && !isIdentsUsed(stmt, declared) {
+					continue
+				}
+				if assign, ok := stmt.(*ast.AssignStmt); ok && assign.Tok == token.DEFINE {
+					for _, expr := range assign.Lhs {
+						if ident, ok := expr.(*ast.Ident); ok {
+							declared[ident.Name] = true
+						}
+					}
+				}
+				found = append(found, getLines(fset, nil, stmt))
+			}
+		}
+		return found
+	})
+}
+
+// RemoveTests remove the t.Run of the functions of tests with the title matching the pattern
+func (e *Editor) RemoveTests(pattern *regexp.Regexp) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || !strings.HasPrefix(function.Name.Name, "Test") || function.Body == nil {
+				continue
+			}
+			ast.Inspect(function.Body, func(node ast.Node) bool {
+				stmt, ok := node.(*ast.ExprStmt)
+				if !ok || !isCall(stmt.X, "Run", nil) {
+					return true
+				}
+				call := stmt.X.(*ast.CallExpr)
+				if len(call.Args) > 0 && pattern.MatchString(GetString(call.Args[0])) {
+					found = append(found, getLines(fset, nil, stmt))
+				}
+				return false
+			})
+		}
+		return found
+	})
+}
+
+func (e *Editor) RemoveStructField(structName, fieldName string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok || spec.Name.Name != structName {
+				return true
+			}
+			if structType, ok := spec.Type.(*ast.StructType); ok {
+				found = append(found, getFieldsLines(fset, structType.Fields, fieldName)...)
+			}
+			return false
+		})
+		return found
+	})
+}
+
+// RemoveKeyValue remove the key of the composite literals of the type, like SwaggerHost in Config{SwaggerHost: ""}
+func (e *Editor) RemoveKeyValue(typeName, key string) *Editor {
+	return e.remove(func(fset *token.FileSet, file *ast.File) (found []lines) {
+		ast.Inspect(file, func(node ast.Node) bool {
+			literal, ok := node.(*ast.CompositeLit)
+			if !ok || !isIdent(literal.Type, typeName) {
+				return true
+			}
+			for _, element := range literal.Elts {
+				if keyValue, ok := element.(*ast.KeyValueExpr); ok && isIdent(keyValue.Key, key) {
+					found = append(found, getLines(fset, nil, keyValue))
+				}
+			}
+			return true
+		})
+		return found
+	})
+}
+
+// RemoveCommentLines remove the lines of comments starting with the prefix, like the annotations of swagger "// @"
+func (e *Editor) RemoveCommentLines(prefix string) *Editor {
+	if e.err != nil {
+		return e
+	}
+	kept := []string{}
+	for _, line := range strings.Split(string(e.content), "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), prefix) {
+			kept = append(kept, line)
+		}
+	}
+	e.content = []byte(strings.Join(kept, "\n"))
+	return e
+}
+
+func (e *Editor) remove(find func(fset *token.FileSet, file *ast.File) []lines) *Editor {
+	if e.err != nil {
+		return e
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", e.content, parser.ParseComments)
+	if err != nil {
+		e.err = err
+		return e
+	}
+	e.content = removeLines(e.content, find(fset, file))
+	return e
+}
+
+func removeLines(content []byte, found []lines) []byte {
+	if len(found) == 0 {
+		return content
+	}
+	kept := []string{}
+	for index, line := range strings.Split(string(content), "\n") {
+		if !isLineFound(index+1, found) {
+			kept = append(kept, line)
+		}
+	}
+	return []byte(strings.Join(kept, "\n"))
+}
+
+func isLineFound(line int, found []lines) bool {
+	for _, value := range found {
+		if line >= value.start && line <= value.end {
+			return true
+		}
+	}
+	return false
+}
+
+func getLines(fset *token.FileSet, doc *ast.CommentGroup, node ast.Node) lines {
+	start := node.Pos()
+	if doc != nil {
+		start = doc.Pos()
+	}
+	return lines{start: fset.Position(start).Line, end: fset.Position(node.End()).Line}
+}
+
+func getFieldsLines(fset *token.FileSet, fields *ast.FieldList, fieldName string) (found []lines) {
+	for _, field := range fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				found = append(found, getLines(fset, field.Doc, field))
+			}
+		}
+	}
+	return found
+}
+
+func isCall(expr ast.Expr, callName string, args []string) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) < len(args) {
+		return false
+	}
+	switch function := call.Fun.(type) {
+	case *ast.Ident:
+		ok = function.Name == callName
+	case *ast.SelectorExpr:
+		ok = function.Sel.Name == callName
+	default:
+		ok = false
+	}
+	for index, arg := range args {
+		ok = ok && isString(call.Args[index], arg)
+	}
+	return ok
+}
+
+func isString(expr ast.Expr, value string) bool {
+	literal, ok := expr.(*ast.BasicLit)
+	return ok && literal.Kind == token.STRING && GetString(expr) == value
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+func isUsed(file *ast.File, name string) bool {
+	used := false
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok && isIdent(selector.X, name) {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
+
+func isSelectorUsed(node ast.Node, pkg, name string) bool {
+	used := false
+	ast.Inspect(node, func(child ast.Node) bool {
+		if selector, ok := child.(*ast.SelectorExpr); ok && isIdent(selector.X, pkg) && selector.Sel.Name == name {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
+
+// isIdentsUsed return if the node use one of the variables, the fields of the selectors with the same name are ignored
+func isIdentsUsed(node ast.Node, names map[string]bool) bool {
+	used := false
+	ast.Inspect(node, func(child ast.Node) bool {
+		if selector, ok := child.(*ast.SelectorExpr); ok {
+			used = used || isIdentsUsed(selector.X, names)
+			return false
+		}
+		if ident, ok := child.(*ast.Ident); ok && names[ident.Name] {
+			used = true
+		}
+		return !used
+	})
+	return used
+}
@@ -0,0 +1,182 @@
+package goast
+
+import (
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+const source = `package example
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/cors"
+)
+
+// Config is the config of example
+type Config struct {
+	Port int
+	// Host of swagger
+	SwaggerHost string
+}
+
+func GetConfig() Config {
+	return Config{
+		Port:        8080,
+		SwaggerHost: "localhost",
+	}
+}
+
+// @title Example
+func main() {
+	// setup of the example
+	setup()
+	http.Handle("/", nil)
+	if true {
+		http.Header{}.Set("Origin", "*")
+		http.Header{}.Set("Vary", "Origin")
+	}
+}
+
+// setup show the host
+func setup() {
+	fmt.Println(cors.Options{})
+}
+`
+
+func TestEditor_Bytes(t *testing.T) {
+	t.Run("Should return content formatted when not have changes", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, source, string(content))
+	})
+	t.Run("Should return error when content is not go", func(t *testing.T) {
+		_, err := NewEditor([]byte("wrong")).RemoveFunc("main").RemoveImport("fmt").Bytes()
+		assert.Error(t, err)
+	})
+}
+
+func TestEditor_RemoveFunc(t *testing.T) {
+	t.Run("Should remove function and your doc", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveFunc("setup").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "func setup()")
+		assert.NotContains(t, string(content), "// setup show the host")
+		assert.Contains(t, string(content), "// setup of the example")
+	})
+}
+
+func TestEditor_RemoveCalls(t *testing.T) {
+	t.Run("Should remove call of function and keep comments", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveCalls("main", "setup").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "\tsetup()")
+		assert.Contains(t, string(content), "// setup of the example")
+		assert.Contains(t, string(content), `http.Handle("/", nil)`)
+	})
+	t.Run("Should remove only calls with args in nested blocks", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveCalls("main", "Set", "Origin").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), `Set("Origin", "*")`)
+		assert.Contains(t, string(content), `Set("Vary", "Origin")`)
+	})
+	t.Run("Should not remove calls of other functions", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveCalls("setup", "Handle").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, source, string(content))
+	})
+}
+
+func TestEditor_RemoveImport(t *testing.T) {
+	t.Run("Should remove import by path", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveFunc("setup").RemoveCalls("main", "setup").
+			RemoveImport("github.com/go-chi/cors").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "go-chi/cors")
+	})
+	t.Run("Should remove import only when package is not used", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveImportIfUnused("fmt", "fmt").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), `"fmt"`)
+		content, err = NewEditor([]byte(source)).RemoveFunc("setup").RemoveImportIfUnused("fmt", "fmt").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), `"fmt"`)
+	})
+}
+
+func TestEditor_RemoveStmtsUsing(t *testing.T) {
+	const main = `package main
+
+func main() {
+	configs := config.GetConfig()
+	entity := &product.Product{}
+	connection := database.GetConnection(configs.Dialect)
+	if err := connection.Table(entity.TableName()).AutoMigrate(entity); err != nil {
+		log.Fatal(err)
+	}
+	log.Println(connection.entity)
+}
+`
+	t.Run("Should remove statements of the selector and of the variables declared by them", func(t *testing.T) {
+		content, err := NewEditor([]byte(main)).RemoveStmtsUsing("main", "product", "Product").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "product.Product")
+		assert.NotContains(t, string(content), "AutoMigrate")
+		assert.NotContains(t, string(content), "log.Fatal(err)")
+		assert.Contains(t, string(content), "connection := database.GetConnection(configs.Dialect)")
+		assert.Contains(t, string(content), "log.Println(connection.entity)")
+	})
+	t.Run("Should not change when selector is not used", func(t *testing.T) {
+		content, err := NewEditor([]byte(main)).RemoveStmtsUsing("main", "invoice", "Invoice").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, main, string(content))
+	})
+}
+
+func TestEditor_RemoveTests(t *testing.T) {
+	const test = `package routes
+
+func TestRouter(t *testing.T) {
+	t.Run("Should return ok when call product", func(t *testing.T) {
+		t.Run("Should be nested", func(t *testing.T) {})
+	})
+	t.Run("Should return ok when call products", func(t *testing.T) {})
+	t.Run("Should return not found", func(t *testing.T) {})
+}
+
+func helper(t *testing.T) {
+	t.Run("Should call product", func(t *testing.T) {})
+}
+`
+	t.Run("Should remove tests with title matching in functions of tests", func(t *testing.T) {
+		content, err := NewEditor([]byte(test)).RemoveTests(regexp.MustCompile(`\bproduct\b`)).Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "when call product\"")
+		assert.NotContains(t, string(content), "Should be nested")
+		assert.Contains(t, string(content), "when call products")
+		assert.Contains(t, string(content), "Should return not found")
+		assert.Contains(t, string(content), "Should call product")
+	})
+}
+
+func TestEditor_RemoveStructField(t *testing.T) {
+	t.Run("Should remove field of struct with your doc and key of composite literals", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveStructField("Config", "SwaggerHost").
+			RemoveKeyValue("Config", "SwaggerHost").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "SwaggerHost")
+		assert.NotContains(t, string(content), "// Host of swagger")
+		assert.Contains(t, string(content), "Port: 8080,")
+	})
+}
+
+func TestEditor_RemoveCommentLines(t *testing.T) {
+	t.Run("Should remove comments with prefix", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).RemoveCommentLines("// @").Bytes()
+		assert.NoError(t, err)
+		assert.NotContains(t, string(content), "// @title")
+		assert.Contains(t, string(content), "// setup show the host")
+	})
+}
@@ -0,0 +1,215 @@
+package goast
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNodeNotFound is returned when the function changed not exists in the file
+var ErrNodeNotFound = errors.New("node not found")
+
+// ErrNodeInvalid is returned when the code added is not of the node expected, like a call that is not a call
+var ErrNodeInvalid = errors.New("node invalid")
+
+// insertion is a text added in the offset of the source, the changes of the Add are insertions so the comments and the
+// format of the rest of the file are preserved. All the Add are idempotent, the nodes that already exist are not added
+type insertion struct {
+	offset int
+	text   string
+}
+
+// AddImport add the import of the path with the name, the name can be empty to the import without alias
+func (e *Editor) AddImport(path, name string) *Editor {
+	return e.insert(func(fset *token.FileSet, file *ast.File) ([]insertion, error) {
+		for _, spec := range file.Imports {
+			if value, _ := strconv.Unquote(spec.Path.Value); value == path {
+				return nil, nil
+			}
+		}
+		spec := strings.TrimSpace(name + " " + strconv.Quote(path))
+		for _, decl := range file.Decls {
+			if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT && genDecl.Rparen.IsValid() {
+				return []insertion{e.insertBefore(fset, genDecl.Rparen, spec)}, nil
+			}
+		}
+		if len(file.Imports) > 0 {
+			return []insertion{e.insertAfter(fset, file.Imports[len(file.Imports)-1].End(), "import "+spec)}, nil
+		}
+		return []insertion{e.insertAfter(fset, file.Name.End(), "\nimport "+spec)}, nil
+	})
+}
+
+// AddCall add the call in the end of the function funcName, before the last return, like r.EnableAuth() in the
+// setConfigsRouters
+func (e *Editor) AddCall(funcName, call string) *Editor {
+	return e.addCall(funcName, call, e.insertCall)
+}
+
+// AddCallFirst add the call in the begin of the function funcName, like the t.Setenv in the tests
+func (e *Editor) AddCallFirst(funcName, call string) *Editor {
+	return e.addCall(funcName, call, func(fset *token.FileSet, body *ast.BlockStmt, call string) insertion {
+		if len(body.List) > 0 {
+			return e.insertBefore(fset, body.List[0].Pos(), call)
+		}
+		return e.insertBefore(fset, body.Rbrace, call)
+	})
+}
+
+// AddFunc add the function in the end of the file, the methods are added after the last method of the same receiver,
+// like the EnableAuth after the EnableRealIP of the Router
+func (e *Editor) AddFunc(code string) *Editor {
+	return e.insert(func(fset *token.FileSet, file *ast.File) ([]insertion, error) {
+		parsed, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+code, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(parsed.Decls) != 1 {
+			return nil, fmt.Errorf("%w: %s is not one func", ErrNodeInvalid, code)
+		}
+		added, ok := parsed.Decls[0].(*ast.FuncDecl)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not a func", ErrNodeInvalid, code)
+		}
+		end := token.NoPos
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || getReceiver(function) != getReceiver(added) {
+				continue
+			}
+			if function.Name.Name == added.Name.Name {
+				return nil, nil
+			}
+			end = function.End()
+		}
+		if end == token.NoPos || getReceiver(added) == "" {
+			return []insertion{{offset: len(bytes.TrimRight(e.content, "\n")), text: "\n\n" + code + "\n"}}, nil
+		}
+		offset := fset.Position(end).Offset
+		return []insertion{{offset: offset, text: "\n\n" + code}}, nil
+	})
+}
+
+func (e *Editor) addCall(funcName, call string,
+	find func(fset *token.FileSet, body *ast.BlockStmt, call string) insertion) *Editor {
+	return e.insert(func(fset *token.FileSet, file *ast.File) ([]insertion, error) {
+		expr, err := parser.ParseExpr(call)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := expr.(*ast.CallExpr); !ok {
+			return nil, fmt.Errorf("%w: %s is not a call", ErrNodeInvalid, call)
+		}
+		function := getFunc(file, funcName)
+		if function == nil || function.Body == nil {
+			return nil, fmt.Errorf("%w: func %s", ErrNodeNotFound, funcName)
+		}
+		if hasExprStmt(function.Body, types.ExprString(expr)) {
+			return nil, nil
+		}
+		return []insertion{find(fset, function.Body, call)}, nil
+	})
+}
+
+// insertCall return the insertion of the call after the last statement of the body that is not the return
+func (e *Editor) insertCall(fset *token.FileSet, body *ast.BlockStmt, call string) insertion {
+	list := body.List
+	if len(list) > 0 {
+		if _, ok := list[len(list)-1].(*ast.ReturnStmt); ok {
+			list = list[:len(list)-1]
+		}
+	}
+	if len(list) > 0 {
+		return e.insertAfter(fset, list[len(list)-1].End(), call)
+	}
+	if len(body.List) > 0 {
+		return e.insertBefore(fset, body.List[0].Pos(), call)
+	}
+	return e.insertBefore(fset, body.Rbrace, call)
+}
+
+func (e *Editor) insert(find func(fset *token.FileSet, file *ast.File) ([]insertion, error)) *Editor {
+	if e.err != nil {
+		return e
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", e.content, parser.ParseComments)
+	if err != nil {
+		e.err = err
+		return e
+	}
+	found, err := find(fset, file)
+	if err != nil {
+		e.err = err
+		return e
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].offset > found[j].offset
+	})
+	for _, value := range found {
+		content := append([]byte{}, e.content[:value.offset]...)
+		content = append(content, value.text...)
+		e.content = append(content, e.content[value.offset:]...)
+	}
+	return e
+}
+
+// insertBefore return the insertion of the line before the line of the pos, when the pos not is the first of the
+// line the line is added before the pos, like the } of the struct{}
+func (e *Editor) insertBefore(fset *token.FileSet, pos token.Pos, line string) insertion {
+	offset := fset.Position(pos).Offset
+	start := bytes.LastIndexByte(e.content[:offset], '\n') + 1
+	if len(bytes.TrimSpace(e.content[start:offset])) == 0 {
+		return insertion{offset: start, text: "\t" + line + "\n"}
+	}
+	return insertion{offset: offset, text: "\n\t" + line + "\n"}
+}
+
+// insertAfter return the insertion of the line after the line of the pos, so the comments in the end of the line of
+// the pos are kept in the line
+func (e *Editor) insertAfter(fset *token.FileSet, pos token.Pos, line string) insertion {
+	offset := fset.Position(pos).Offset
+	if end := bytes.IndexByte(e.content[offset:], '\n'); end >= 0 {
+		return insertion{offset: offset + end, text: "\n\t" + line}
+	}
+	return insertion{offset: len(e.content), text: "\n" + line + "\n"}
+}
+
+func getFunc(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if function, ok := decl.(*ast.FuncDecl); ok && function.Name.Name == name {
+			return function
+		}
+	}
+	return nil
+}
+
+// getReceiver return the type of the receiver of the method without the pointer, or empty to the functions
+func getReceiver(function *ast.FuncDecl) string {
+	if function.Recv == nil || len(function.Recv.List) == 0 {
+		return ""
+	}
+	expr := function.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	return types.ExprString(expr)
+}
+
+func hasExprStmt(body *ast.BlockStmt, expr string) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if stmt, ok := node.(*ast.ExprStmt); ok && types.ExprString(stmt.X) == expr {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,135 @@
+package goast
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const routes = `package routes
+
+import "net/http"
+
+type Router struct {
+	router *http.ServeMux // mux of the routes
+}
+
+func (r *Router) SetRouters() http.Handler {
+	r.RouterHealth() // health of the service
+
+	return r.router
+}
+
+func (r *Router) setConfigsRouters() {}
+
+func main() {
+	entity := &product.Product{}
+	if err := connection.AutoMigrate(entity); err != nil {
+		log.Fatal(err)
+	}
+	log.Println("running")
+}
+`
+
+func TestEditor_AddImport(t *testing.T) {
+	t.Run("Should add import in the block and keep the comments", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).AddImport("github.com/go-chi/chi", "").
+			AddImport("example.com/internal/handlers/invoice", "InvoiceHandler").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "\t\"github.com/go-chi/chi\"\n\t\"github.com/go-chi/cors\"\n")
+		assert.Contains(t, string(content), "\tInvoiceHandler \"example.com/internal/handlers/invoice\"\n")
+		assert.Contains(t, string(content), "// setup show the host")
+	})
+	t.Run("Should add import when file have only one import or not have imports", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddImport("fmt", "").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "import \"net/http\"\nimport \"fmt\"\n")
+		content, err = NewEditor([]byte("package empty\n")).AddImport("fmt", "").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, "package empty\n\nimport \"fmt\"\n", string(content))
+	})
+	t.Run("Should not add import when path is already imported", func(t *testing.T) {
+		content, err := NewEditor([]byte(source)).AddImport("net/http", "nethttp").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, source, string(content))
+	})
+}
+
+func TestEditor_AddCall(t *testing.T) {
+	t.Run("Should add call before the return and keep the comments", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddCall("SetRouters", "r.RouterInvoice()").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content),
+			"\tr.RouterHealth() // health of the service\n\tr.RouterInvoice()\n\n\treturn r.router\n")
+	})
+	t.Run("Should add call in function empty and in the end of function without return", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddCall("setConfigsRouters", "r.EnableAuth()").
+			AddCall("main", "setupSwagger()").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "func (r *Router) setConfigsRouters() {\n\tr.EnableAuth()\n}")
+		assert.Contains(t, string(content), "\tlog.Println(\"running\")\n\tsetupSwagger()\n}")
+	})
+	t.Run("Should be idempotent", func(t *testing.T) {
+		once, err := NewEditor([]byte(routes)).AddCall("SetRouters", "r.RouterInvoice()").Bytes()
+		assert.NoError(t, err)
+		twice, err := NewEditor(once).AddCall("SetRouters", "r.RouterInvoice( )").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, string(once), string(twice))
+		assert.Equal(t, 1, strings.Count(string(twice), "RouterInvoice"))
+	})
+	t.Run("Should return error when function not exists", func(t *testing.T) {
+		_, err := NewEditor([]byte(routes)).AddCall("NotExists", "r.RouterInvoice()").Bytes()
+		assert.True(t, errors.Is(err, ErrNodeNotFound))
+	})
+	t.Run("Should return error when code is not a call", func(t *testing.T) {
+		_, err := NewEditor([]byte(routes)).AddCall("main", "r.router").Bytes()
+		assert.True(t, errors.Is(err, ErrNodeInvalid))
+	})
+}
+
+func TestEditor_AddCallFirst(t *testing.T) {
+	t.Run("Should add call before the first statement and keep the comments", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddCallFirst("SetRouters", "r.RouterInvoice()").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content),
+			"\tr.RouterInvoice()\n\tr.RouterHealth() // health of the service\n")
+	})
+	t.Run("Should add call in function empty", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddCallFirst("setConfigsRouters", "r.EnableAuth()").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "func (r *Router) setConfigsRouters() {\n\tr.EnableAuth()\n}")
+	})
+	t.Run("Should be idempotent", func(t *testing.T) {
+		once, err := NewEditor([]byte(routes)).AddCallFirst("main", "setup()").Bytes()
+		assert.NoError(t, err)
+		twice, err := NewEditor(once).AddCallFirst("main", "setup()").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, string(once), string(twice))
+	})
+}
+
+func TestEditor_AddFunc(t *testing.T) {
+	t.Run("Should add method after the last method of the receiver", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).
+			AddFunc("func (r *Router) EnableAuth() *Router {\n\treturn r\n}").Bytes()
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "func (r *Router) setConfigsRouters() {}\n\n"+
+			"func (r *Router) EnableAuth() *Router {\n\treturn r\n}\n\nfunc main() {")
+	})
+	t.Run("Should add function in the end of the file", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddFunc("// setup of the example\nfunc setup() {}").Bytes()
+		assert.NoError(t, err)
+		assert.True(t, strings.HasSuffix(string(content), "}\n\n// setup of the example\nfunc setup() {}\n"))
+	})
+	t.Run("Should not add function when already exists", func(t *testing.T) {
+		content, err := NewEditor([]byte(routes)).AddFunc("func (r Router) SetRouters() {}").
+			AddFunc("func main() {}").Bytes()
+		assert.NoError(t, err)
+		assert.Equal(t, routes, string(content))
+	})
+	t.Run("Should return error when code is not a func", func(t *testing.T) {
+		_, err := NewEditor([]byte(routes)).AddFunc("var name = 1").Bytes()
+		assert.True(t, errors.Is(err, ErrNodeInvalid))
+	})
+}
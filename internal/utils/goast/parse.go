@@ -0,0 +1,86 @@
+package goast
+
+import (
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseDir return the files of the source of the folder without the tests, sorted by the name of the file so the
+// result is the same in each run
+func ParseDir(dir string, mode parser.Mode) ([]*ast.File, error) {
+	packages, err := parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+		return IsSourceFile(info.Name())
+	}, mode)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	filesByName := map[string]*ast.File{}
+	for _, pkg := range packages {
+		for name, file := range pkg.Files {
+			names = append(names, name)
+			filesByName[name] = file
+		}
+	}
+	sort.Strings(names)
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		files = append(files, filesByName[name])
+	}
+	return files, nil
+}
+
+// IsSourceFile return if the path is a go file that is not a test
+func IsSourceFile(path string) bool {
+	return filepath.Ext(path) == ".go" && !strings.HasSuffix(path, "_test.go")
+}
+
+// MatchBuildContext ignore the files excluded by build constraints, like the routes of the routers not selected
+func MatchBuildContext(path string) bool {
+	match, err := build.Default.MatchFile(filepath.Dir(path), filepath.Base(path))
+	return err == nil && match
+}
+
+// GetImportsByName return the path of the imports of the file by the name used in the file, the alias or the last
+// element of the path
+func GetImportsByName(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, importSpec := range file.Imports {
+		importPath, _ := strconv.Unquote(importSpec.Path.Value)
+		name := path.Base(importPath)
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// GetReceiverName return the name of the type of the receiver of a method, like Product to the (p *Product)
+func GetReceiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// GetString return the value of the literal string, and empty when the expr is not a literal string
+func GetString(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+	value, _ := strconv.Unquote(literal.Value)
+	return value
+}
@@ -0,0 +1,67 @@
+package goast
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseDir(t *testing.T) {
+	t.Run("Should return the files of the source sorted by name without the tests", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "goast")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		for _, name := range []string{"b.go", "a.go", "a_test.go", "README.md"} {
+			content := "package example\n\nconst Name = \"" + name + "\"\n"
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+		}
+		files, err := ParseDir(dir, 0)
+		assert.NoError(t, err)
+		assert.Len(t, files, 2)
+		assert.Equal(t, "a.go", GetString(files[0].Scope.Lookup("Name").Decl.(*ast.ValueSpec).Values[0]))
+		assert.Equal(t, "b.go", GetString(files[1].Scope.Lookup("Name").Decl.(*ast.ValueSpec).Values[0]))
+	})
+	t.Run("Should return error when the folder not exists", func(t *testing.T) {
+		_, err := ParseDir(filepath.Join(os.TempDir(), "goast-not-exists"), 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestIsSourceFile(t *testing.T) {
+	t.Run("Should return true only to the go files that are not tests", func(t *testing.T) {
+		assert.True(t, IsSourceFile("internal/routes/routes.go"))
+		assert.False(t, IsSourceFile("internal/routes/routes_test.go"))
+		assert.False(t, IsSourceFile("README.md"))
+	})
+}
+
+func TestGetImportsByName(t *testing.T) {
+	t.Run("Should return the imports by the alias or by the last element of the path", func(t *testing.T) {
+		file, err := parser.ParseFile(token.NewFileSet(), "", source, 0)
+		assert.NoError(t, err)
+		imports := GetImportsByName(file)
+		assert.Equal(t, "net/http", imports["http"])
+		assert.Equal(t, "github.com/go-chi/cors", imports["cors"])
+	})
+}
+
+func TestGetReceiverName(t *testing.T) {
+	t.Run("Should return the name of the type of the receiver", func(t *testing.T) {
+		assert.Equal(t, "Product", GetReceiverName(&ast.StarExpr{X: ast.NewIdent("Product")}))
+		assert.Equal(t, "Product", GetReceiverName(ast.NewIdent("Product")))
+		assert.Equal(t, "", GetReceiverName(&ast.ArrayType{Elt: ast.NewIdent("Product")}))
+	})
+}
+
+func TestGetString(t *testing.T) {
+	t.Run("Should return the value of the literal string", func(t *testing.T) {
+		assert.Equal(t, "product", GetString(&ast.BasicLit{Kind: token.STRING, Value: `"product"`}))
+		assert.Equal(t, "", GetString(&ast.BasicLit{Kind: token.INT, Value: "1"}))
+		assert.Equal(t, "", GetString(ast.NewIdent("product")))
+	})
+}
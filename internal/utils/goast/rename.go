@@ -0,0 +1,94 @@
+package goast
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+)
+
+// Kind is the kind of the value of the file renamed by Rename
+type Kind int
+
+const (
+	// KindPackage is the name of the package and the references to the packages imported
+	KindPackage Kind = iota
+	KindIdent
+	// KindImport is the path of the import with quotes
+	KindImport
+	// KindString is the string literal with quotes
+	KindString
+	// KindComment is the comment with the slashes
+	KindComment
+)
+
+// Rename change the identifiers, imports, strings and comments of the file with the value returned by rename
+func Rename(content []byte, rename func(kind Kind, value string) string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	packages := getPackagesReferences(file)
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch value := node.(type) {
+		case *ast.ImportSpec:
+			if value.Name != nil {
+				value.Name.Name = rename(KindPackage, value.Name.Name)
+			}
+			value.Path.Value = rename(KindImport, value.Path.Value)
+			return false
+		case *ast.BasicLit:
+			if value.Kind == token.STRING {
+				value.Value = rename(KindString, value.Value)
+			}
+		case *ast.Ident:
+			value.Name = rename(getIdentKind(value, packages), value.Name)
+		}
+		return true
+	})
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			comment.Text = rename(KindComment, comment.Text)
+		}
+	}
+	var buffer bytes.Buffer
+	if err := format.Node(&buffer, fset, file); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func getIdentKind(ident *ast.Ident, packages map[*ast.Ident]bool) Kind {
+	if packages[ident] {
+		return KindPackage
+	}
+	return KindIdent
+}
+
+// getPackagesReferences return the name of the package and the identifiers not declared in the file used to select
+// the names of the packages imported
+func getPackagesReferences(file *ast.File) map[*ast.Ident]bool {
+	imported := map[string]bool{}
+	for _, spec := range file.Imports {
+		value, _ := strconv.Unquote(spec.Path.Value)
+		if spec.Name != nil {
+			imported[spec.Name.Name] = true
+		} else {
+			imported[path.Base(value)] = true
+		}
+	}
+	packages := map[*ast.Ident]bool{file.Name: true}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil && imported[ident.Name] {
+				packages[ident] = true
+			}
+		}
+		return true
+	})
+	return packages
+}
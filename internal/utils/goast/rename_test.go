@@ -0,0 +1,40 @@
+package goast
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestRename(t *testing.T) {
+	t.Run("Should rename each kind of value of file", func(t *testing.T) {
+		kinds := map[Kind][]string{}
+		content, err := Rename([]byte(source), func(kind Kind, value string) string {
+			kinds[kind] = append(kinds[kind], value)
+			return strings.ReplaceAll(value, "Config", "Settings")
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "// Settings is the config of example")
+		assert.Contains(t, string(content), "type Settings struct {")
+		assert.Contains(t, kinds[KindPackage], "example")
+		assert.Contains(t, kinds[KindPackage], "cors")
+		assert.Contains(t, kinds[KindImport], `"github.com/go-chi/cors"`)
+		assert.Contains(t, kinds[KindString], `"Origin"`)
+		assert.Contains(t, kinds[KindIdent], "Port")
+		assert.NotContains(t, kinds[KindString], `"fmt"`)
+	})
+	t.Run("Should not return package to variables with name of package", func(t *testing.T) {
+		kinds := map[string]Kind{}
+		_, err := Rename([]byte("package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt := struct{ Println int }{}"+
+			"\n\t_ = fmt.Println\n}\n"), func(kind Kind, value string) string {
+			kinds[value] = kind
+			return value
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, KindIdent, kinds["fmt"])
+	})
+	t.Run("Should return error when content is not go", func(t *testing.T) {
+		_, err := Rename([]byte("wrong"), func(kind Kind, value string) string { return value })
+		assert.Error(t, err)
+	})
+}
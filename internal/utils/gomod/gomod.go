@@ -0,0 +1,44 @@
+package gomod
+
+import (
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const FileName = "go.mod"
+
+func CheckModulePath(modulePath string) error {
+	return module.CheckImportPath(modulePath)
+}
+
+func GetModulePath(dir string) (string, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, FileName))
+	if err != nil {
+		return "", err
+	}
+	return modfile.ModulePath(content), nil
+}
+
+func FindModuleRoot(dir string) (root, modulePath string) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", ""
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, FileName)); err == nil {
+			modulePath, err = GetModulePath(dir)
+			if err != nil {
+				return "", ""
+			}
+			return dir, modulePath
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,48 @@
+package gomod
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckModulePath(t *testing.T) {
+	t.Run("Should return nil when module path is valid", func(t *testing.T) {
+		assert.NoError(t, CheckModulePath("github.com/wilian746/tmp"))
+	})
+	t.Run("Should return nil when module path not have dot in first element like go mod init", func(t *testing.T) {
+		assert.NoError(t, CheckModulePath("myapp"))
+		assert.NoError(t, CheckModulePath("myapp/service"))
+	})
+	t.Run("Should return error when module path is empty", func(t *testing.T) {
+		assert.Error(t, CheckModulePath(""))
+	})
+	t.Run("Should return error when module path is relative", func(t *testing.T) {
+		assert.Error(t, CheckModulePath("./tmp"))
+	})
+	t.Run("Should return error when module path contains spaces", func(t *testing.T) {
+		assert.Error(t, CheckModulePath("github.com/wilian746/my app"))
+	})
+}
+
+func TestFindModuleRoot(t *testing.T) {
+	t.Run("Should find module root in parent directory", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "gomod")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, FileName), []byte("module example.com/root\n"), 0600))
+		child := filepath.Join(dir, "internal", "child")
+		assert.NoError(t, os.MkdirAll(child, os.ModePerm))
+
+		root, modulePath := FindModuleRoot(child)
+		assert.Equal(t, dir, root)
+		assert.Equal(t, "example.com/root", modulePath)
+	})
+	t.Run("Should return empty when not exists go.mod", func(t *testing.T) {
+		root, modulePath := FindModuleRoot(string(filepath.Separator))
+		assert.Empty(t, root)
+		assert.Empty(t, modulePath)
+	})
+}
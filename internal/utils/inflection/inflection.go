@@ -0,0 +1,173 @@
+package inflection
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var irregulars = map[string]string{
+	"person": "people",
+	"child":  "children",
+	"man":    "men",
+	"woman":  "women",
+	"mouse":  "mice",
+	"tooth":  "teeth",
+	"foot":   "feet",
+	"goose":  "geese",
+	"ox":     "oxen",
+	"leaf":   "leaves",
+	"half":   "halves",
+	"shelf":  "shelves",
+	"wolf":   "wolves",
+	"knife":  "knives",
+	"life":   "lives",
+	"wife":   "wives",
+	"hero":   "heroes",
+	"potato": "potatoes",
+	"tomato": "tomatoes",
+}
+
+var uncountables = map[string]bool{
+	"equipment":   true,
+	"information": true,
+	"money":       true,
+	"news":        true,
+	"series":      true,
+	"species":     true,
+	"sheep":       true,
+	"fish":        true,
+	"data":        true,
+	"metadata":    true,
+}
+
+var wordsRegex = regexp.MustCompile(`[A-Z]+[a-z0-9]*|[a-z0-9]+`)
+
+// Name is a name in all forms used in the code, like ShipmentItem, shipment_item and shipment-item
+type Name struct {
+	words []string
+}
+
+// NewName split the value in snake, kebab, camel or with spaces in words and keep the last word in singular
+func NewName(value string) Name {
+	words := []string{}
+	for _, word := range wordsRegex.FindAllString(value, -1) {
+		words = append(words, strings.ToLower(word))
+	}
+	if len(words) > 0 {
+		words[len(words)-1] = Singularize(words[len(words)-1])
+	}
+	return Name{words: words}
+}
+
+func (n Name) IsEmpty() bool {
+	return len(n.words) == 0
+}
+
+func (n Name) Plural() Name {
+	if n.IsEmpty() {
+		return n
+	}
+	words := append([]string{}, n.words...)
+	words[len(words)-1] = Pluralize(words[len(words)-1])
+	return Name{words: words}
+}
+
+func (n Name) Pascal() string {
+	pascal := ""
+	for _, word := range n.words {
+		pascal += capitalize(word)
+	}
+	return pascal
+}
+
+func (n Name) Camel() string {
+	if n.IsEmpty() {
+		return ""
+	}
+	return n.words[0] + strings.TrimPrefix(n.Pascal(), capitalize(n.words[0]))
+}
+
+func (n Name) Snake() string {
+	return strings.Join(n.words, "_")
+}
+
+func (n Name) Kebab() string {
+	return strings.Join(n.words, "-")
+}
+
+// Package return the name of the package, all words in lower case together like the package doctorpatient
+func (n Name) Package() string {
+	return strings.Join(n.words, "")
+}
+
+func (n Name) Words() string {
+	return strings.Join(n.words, " ")
+}
+
+func Pluralize(word string) string {
+	if plural, ok := irregulars[word]; ok || uncountables[word] {
+		return getValueOrWord(plural, word)
+	}
+	switch {
+	case hasConsonantBefore(word, "y"):
+		return strings.TrimSuffix(word, "y") + "ies"
+	case hasAnySuffix(word, "s", "x", "z", "ch", "sh"):
+		return word + "es"
+	default:
+		return word + "s"
+	}
+}
+
+func Singularize(word string) string {
+	for singular, plural := range irregulars {
+		if word == plural {
+			return singular
+		}
+	}
+	if _, ok := irregulars[word]; ok || uncountables[word] {
+		return word
+	}
+	switch {
+	case hasConsonantBefore(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case hasAnySuffix(word, "sses", "xes", "zes", "ches", "shes", "uses"):
+		return strings.TrimSuffix(word, "es")
+	case hasAnySuffix(word, "ss", "us", "is"):
+		return word
+	default:
+		return strings.TrimSuffix(word, "s")
+	}
+}
+
+func getValueOrWord(value, word string) string {
+	if value == "" {
+		return word
+	}
+	return value
+}
+
+func hasAnySuffix(word string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasConsonantBefore(word, suffix string) bool {
+	if !strings.HasSuffix(word, suffix) || len(word) <= len(suffix) {
+		return false
+	}
+	return !strings.ContainsRune("aeiou", rune(word[len(word)-len(suffix)-1]))
+}
+
+func capitalize(word string) string {
+	if word == "" {
+		return word
+	}
+	runes := []rune(word)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,53 @@
+package inflection
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewName(t *testing.T) {
+	t.Run("Should return all forms of name with one word", func(t *testing.T) {
+		name := NewName("invoice")
+		assert.Equal(t, "Invoice", name.Pascal())
+		assert.Equal(t, "invoice", name.Camel())
+		assert.Equal(t, "invoice", name.Snake())
+		assert.Equal(t, "invoice", name.Kebab())
+		assert.Equal(t, "invoice", name.Package())
+		assert.Equal(t, "invoices", name.Plural().Snake())
+	})
+	t.Run("Should return all forms of name with many words", func(t *testing.T) {
+		for _, value := range []string{"shipment_item", "shipment-item", "ShipmentItem", "shipmentItem", "shipment items"} {
+			name := NewName(value)
+			assert.Equal(t, "ShipmentItem", name.Pascal(), value)
+			assert.Equal(t, "shipmentItem", name.Camel(), value)
+			assert.Equal(t, "shipment_item", name.Snake(), value)
+			assert.Equal(t, "shipment-item", name.Kebab(), value)
+			assert.Equal(t, "shipmentitem", name.Package(), value)
+			assert.Equal(t, "shipment item", name.Words(), value)
+			assert.Equal(t, "ShipmentItems", name.Plural().Pascal(), value)
+			assert.Equal(t, "shipment-items", name.Plural().Kebab(), value)
+		}
+	})
+	t.Run("Should return empty name when value not have words", func(t *testing.T) {
+		assert.True(t, NewName("--").IsEmpty())
+		assert.Equal(t, "", NewName("").Plural().Camel())
+	})
+}
+
+func TestPluralize(t *testing.T) {
+	t.Run("Should return plural of words", func(t *testing.T) {
+		for singular, plural := range map[string]string{
+			"product": "products", "category": "categories", "day": "days", "status": "statuses",
+			"address": "addresses", "box": "boxes", "batch": "batches", "person": "people", "knife": "knives",
+			"news": "news", "hero": "heroes", "photo": "photos",
+		} {
+			assert.Equal(t, plural, Pluralize(singular))
+			assert.Equal(t, singular, Singularize(plural))
+		}
+	})
+	t.Run("Should keep singular words", func(t *testing.T) {
+		for _, word := range []string{"status", "address", "analysis", "invoice", "sheep"} {
+			assert.Equal(t, word, Singularize(word))
+		}
+	})
+}
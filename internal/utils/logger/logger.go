@@ -1,16 +1,216 @@
 package logger
 
-import "log"
+import (
+	"fmt"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/mattn/go-isatty"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
 
-func INFO(message string, data interface{}) {
-	if data == nil {
-		log.Print(message)
+type Level int
+
+const (
+	LevelQuiet Level = iota
+	LevelInfo
+	LevelVerbose
+	LevelDebug
+)
+
+var levelsNames = map[Level]string{
+	LevelQuiet:   "error",
+	LevelInfo:    "info",
+	LevelVerbose: "verbose",
+	LevelDebug:   "debug",
+}
+
+var levelsColors = map[Level]text.Colors{
+	LevelQuiet:   {text.FgRed},
+	LevelVerbose: {text.FgHiBlack},
+	LevelDebug:   {text.FgHiBlack},
+}
+
+type Logger struct {
+	mutex   sync.Mutex
+	level   Level
+	output  io.Writer
+	printer io.Writer
+	colored bool
+	phases  []string
+	timings map[string]time.Duration
+}
+
+var std = NewLogger(os.Stderr, os.Stdout)
+
+func NewLogger(output, printer io.Writer) *Logger {
+	return &Logger{
+		level:   LevelInfo,
+		output:  output,
+		printer: printer,
+		colored: isTerminal(output),
+		timings: map[string]time.Duration{},
+	}
+}
+
+func isTerminal(output io.Writer) bool {
+	file, ok := output.(*os.File)
+	if !ok || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return isatty.IsTerminal(file.Fd()) || isatty.IsCygwinTerminal(file.Fd())
+}
+
+func GetLevelByFlags(quiet bool, verbosity int) Level {
+	if quiet {
+		return LevelQuiet
+	}
+	if verbosity >= 2 {
+		return LevelDebug
+	}
+	if verbosity == 1 {
+		return LevelVerbose
+	}
+	return LevelInfo
+}
+
+func SetLevel(level Level) {
+	std.mutex.Lock()
+	defer std.mutex.Unlock()
+	std.level = level
+}
+
+func GetLevel() Level {
+	std.mutex.Lock()
+	defer std.mutex.Unlock()
+	return std.level
+}
+
+func SetOutput(output, printer io.Writer) {
+	std.mutex.Lock()
+	defer std.mutex.Unlock()
+	std.output = output
+	std.printer = printer
+	std.colored = isTerminal(output)
+}
+
+func ERROR(message string, err error) {
+	std.log(LevelQuiet, "error", message, "error", err)
+}
+
+func WARN(message string, data ...interface{}) {
+	std.log(LevelInfo, "warn", message, dataToFields(data)...)
+}
+
+func INFO(message string, data ...interface{}) {
+	std.log(LevelInfo, levelsNames[LevelInfo], message, dataToFields(data)...)
+}
+
+func VERBOSE(message string, data ...interface{}) {
+	std.log(LevelVerbose, levelsNames[LevelVerbose], message, dataToFields(data)...)
+}
+
+func DEBUG(message string, data ...interface{}) {
+	std.log(LevelDebug, levelsNames[LevelDebug], message, dataToFields(data)...)
+}
+
+func PRINT(message string) {
+	std.mutex.Lock()
+	defer std.mutex.Unlock()
+	_, _ = fmt.Fprintln(std.printer, message)
+}
+
+func StartPhase(phase string) func() {
+	start := time.Now()
+	return func() {
+		duration := time.Since(start)
+		std.addTiming(phase, duration)
+		std.log(LevelDebug, levelsNames[LevelDebug], "Phase finished", "phase", phase, "duration", duration)
+	}
+}
+
+func PrintTimings() {
+	std.mutex.Lock()
+	fields := []interface{}{}
+	for _, phase := range std.phases {
+		fields = append(fields, phase, std.timings[phase])
+	}
+	std.mutex.Unlock()
+	if len(fields) > 0 {
+		std.log(LevelVerbose, levelsNames[LevelVerbose], "Time spent by phase", fields...)
+	}
+}
+
+func ResetTimings() {
+	std.mutex.Lock()
+	defer std.mutex.Unlock()
+	std.phases = []string{}
+	std.timings = map[string]time.Duration{}
+}
+
+func (l *Logger) addTiming(phase string, duration time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, ok := l.timings[phase]; !ok {
+		l.phases = append(l.phases, phase)
+	}
+	l.timings[phase] += duration
+}
+
+func (l *Logger) log(level Level, levelName, message string, fields ...interface{}) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if level > l.level {
+		return
+	}
+	if l.colored {
+		_, _ = fmt.Fprintln(l.output, l.formatHuman(level, levelName, message, fields))
 		return
 	}
-	log.Print(message, data)
+	_, _ = fmt.Fprintln(l.output, l.formatPlain(levelName, message, fields))
+}
+
+func (l *Logger) formatHuman(level Level, levelName, message string, fields []interface{}) string {
+	line := message
+	for index := 0; index+1 < len(fields); index += 2 {
+		if fields[index] == "data" {
+			line += fmt.Sprintf(" %v", fields[index+1])
+		} else {
+			line += fmt.Sprintf(" %v=%v", fields[index], fields[index+1])
+		}
+	}
+	if levelName == "warn" {
+		return text.Colors{text.FgYellow}.Sprint("WARNING: " + line)
+	}
+	if colors, ok := levelsColors[level]; ok {
+		return colors.Sprint(line)
+	}
+	return line
+}
+
+func (l *Logger) formatPlain(levelName, message string, fields []interface{}) string {
+	line := fmt.Sprintf("time=%s level=%s msg=%q", time.Now().Format(time.RFC3339), levelName, message)
+	for index := 0; index+1 < len(fields); index += 2 {
+		line += fmt.Sprintf(" %v=%s", fields[index], quoteValue(fields[index+1]))
+	}
+	return line
 }
 
-func PRINT(messages string) {
-	log.SetFlags(0)
-	log.Println(messages)
+func quoteValue(value interface{}) string {
+	valueString := fmt.Sprintf("%v", value)
+	if strings.ContainsAny(valueString, " \"=") || valueString == "" {
+		return fmt.Sprintf("%q", valueString)
+	}
+	return valueString
+}
+
+func dataToFields(data []interface{}) (fields []interface{}) {
+	for _, value := range data {
+		if value != nil {
+			fields = append(fields, "data", value)
+		}
+	}
+	return fields
 }
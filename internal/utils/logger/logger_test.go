@@ -1,11 +1,23 @@
 package logger
 
 import (
+	"bytes"
+	"errors"
 	"github.com/stretchr/testify/assert"
+	"os"
 	"testing"
 )
 
+func setupOutput(level Level) (output, printer *bytes.Buffer) {
+	output, printer = &bytes.Buffer{}, &bytes.Buffer{}
+	SetOutput(output, printer)
+	SetLevel(level)
+	return output, printer
+}
+
 func TestPANIC(t *testing.T) {
+	defer SetOutput(os.Stderr, os.Stdout)
+	setupOutput(LevelInfo)
 	t.Run("should not return panic without data", func(t *testing.T) {
 		assert.NotPanics(t, func() { INFO("Example error", nil) })
 	})
@@ -13,3 +25,83 @@ func TestPANIC(t *testing.T) {
 		assert.NotPanics(t, func() { INFO("Example error", "some text") })
 	})
 }
+
+func TestGetLevelByFlags(t *testing.T) {
+	t.Run("Should return quiet level when quiet is enabled", func(t *testing.T) {
+		assert.Equal(t, LevelQuiet, GetLevelByFlags(true, 2))
+	})
+	t.Run("Should return info level by default", func(t *testing.T) {
+		assert.Equal(t, LevelInfo, GetLevelByFlags(false, 0))
+	})
+	t.Run("Should return verbose level with -v", func(t *testing.T) {
+		assert.Equal(t, LevelVerbose, GetLevelByFlags(false, 1))
+	})
+	t.Run("Should return debug level with -vv", func(t *testing.T) {
+		assert.Equal(t, LevelDebug, GetLevelByFlags(false, 2))
+	})
+}
+
+func TestLevels(t *testing.T) {
+	defer SetOutput(os.Stderr, os.Stdout)
+	defer SetLevel(LevelInfo)
+	t.Run("Should show only errors when level is quiet", func(t *testing.T) {
+		output, _ := setupOutput(LevelQuiet)
+		INFO("some info")
+		WARN("some warning")
+		ERROR("some error", errors.New("error details"))
+		assert.NotContains(t, output.String(), "some info")
+		assert.NotContains(t, output.String(), "some warning")
+		assert.Contains(t, output.String(), `level=error msg="some error" error="error details"`)
+	})
+	t.Run("Should show info and not show debug when level is info", func(t *testing.T) {
+		output, _ := setupOutput(LevelInfo)
+		INFO("some info", "data")
+		VERBOSE("some verbose")
+		DEBUG("some debug")
+		assert.Contains(t, output.String(), `level=info msg="some info" data=data`)
+		assert.NotContains(t, output.String(), "some verbose")
+		assert.NotContains(t, output.String(), "some debug")
+	})
+	t.Run("Should show debug when level is debug", func(t *testing.T) {
+		output, _ := setupOutput(LevelDebug)
+		VERBOSE("some verbose")
+		DEBUG("some debug")
+		assert.Contains(t, output.String(), "level=verbose")
+		assert.Contains(t, output.String(), "level=debug")
+	})
+	t.Run("Should print messages in printer even when level is quiet", func(t *testing.T) {
+		output, printer := setupOutput(LevelQuiet)
+		PRINT("some output")
+		assert.Empty(t, output.String())
+		assert.Equal(t, "some output\n", printer.String())
+	})
+}
+
+func TestTimings(t *testing.T) {
+	defer SetOutput(os.Stderr, os.Stdout)
+	defer SetLevel(LevelInfo)
+	t.Run("Should print time spent by phase when level is verbose", func(t *testing.T) {
+		output, _ := setupOutput(LevelVerbose)
+		ResetTimings()
+		StartPhase("fetch")()
+		StartPhase("write")()
+		StartPhase("fetch")()
+		PrintTimings()
+		assert.Contains(t, output.String(), `msg="Time spent by phase" fetch=`)
+		assert.Contains(t, output.String(), " write=")
+	})
+	t.Run("Should not print time spent by phase when level is info", func(t *testing.T) {
+		output, _ := setupOutput(LevelInfo)
+		StartPhase("fetch")()
+		PrintTimings()
+		assert.Empty(t, output.String())
+	})
+}
+
+func TestLogger_formatHuman(t *testing.T) {
+	t.Run("Should format message with fields to human", func(t *testing.T) {
+		l := NewLogger(&bytes.Buffer{}, &bytes.Buffer{})
+		line := l.formatHuman(LevelInfo, "info", "message", []interface{}{"data", "value", "phase", "fetch"})
+		assert.Equal(t, "message value phase=fetch", line)
+	})
+}
@@ -0,0 +1,72 @@
+package product
+
+import (
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/pkg/standart-bolt/internal/entities/product"
+	"github.com/wilian746/go-generator/pkg/standart-bolt/pkg/repository/adapter"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	ListOne(ID uuid.UUID) (entity product.Product, err error)
+	ListAll() (entities []product.Product, err error)
+	Create(entity *product.Product) (uuid.UUID, error)
+	Update(ID uuid.UUID, entity *product.Product) error
+	Remove(ID uuid.UUID) error
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) ListOne(id uuid.UUID) (entity product.Product, err error) {
+	response := c.repository.Find(entity.TableName(), []byte(id.String()), &entity)
+	if err := response.Error(); err != nil {
+		return product.Product{}, err
+	}
+
+	return entity, nil
+}
+
+func (c *Controller) ListAll() (entities []product.Product, err error) {
+	entity := &product.Product{}
+	response := c.repository.FindByPrefix(entity.TableName(), nil, &entities)
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
+
+func (c *Controller) Create(entity *product.Product) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity)
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Update(id uuid.UUID, entity *product.Product) error {
+	founded, err := c.ListOne(id)
+	if err != nil {
+		return err
+	}
+	founded.Name = entity.Name
+	founded.SetUpdatedAt()
+	response := c.repository.Update(founded.Key(), &founded)
+	return response.Error()
+}
+
+func (c *Controller) Remove(id uuid.UUID) error {
+	var entity product.Product
+
+	response := c.repository.Delete([]byte(id.String()), entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
@@ -0,0 +1,20 @@
+package params
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	t.Run("Should return empty when param not exists", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/product", nil)
+		assert.Empty(t, Get(r, "ID"))
+	})
+	t.Run("Should return value of param set", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "/product/123", nil)
+		r = Set(r, "ID", "123")
+		assert.Equal(t, "123", Get(r, "ID"))
+		assert.Empty(t, Get(r, "other"))
+	})
+}
@@ -0,0 +1,26 @@
+package database
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetConnection(t *testing.T) {
+	t.Run("should return panic when path is empty", func(t *testing.T) {
+		assert.Panics(t, func() { GetConnection("") }, "The code contains panic")
+	})
+	t.Run("should return panic when folder of path not exists", func(t *testing.T) {
+		assert.Panics(t, func() { GetConnection("/not/exists/folder/bolt.db") }, "The code contains panic")
+	})
+	t.Run("should not return panic when open database", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "standartbolt")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NotPanics(t, func() {
+			assert.NoError(t, GetConnection(filepath.Join(dir, "bolt.db")).Close())
+		}, "The code contains panic")
+	})
+}
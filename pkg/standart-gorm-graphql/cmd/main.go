@@ -0,0 +1,74 @@
+//go:build graphql
+// +build graphql
+
+package main
+
+import (
+	"fmt"
+	"github.com/jinzhu/gorm"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/configs"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/docs"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctor"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctorpatient"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/order"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/patient"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/product"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/restaurant"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/routes"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/database"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/entities"
+	"log"
+	"net/http"
+)
+
+// @title Standart Gorm GraphQL
+// @version 1.0
+// @description This is a sample server using standart gorm graphql server.
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name Standart Gorm GraphQL Support
+// @contact.url https://github.com/wilian746/go-generator/issues
+// @contact.email support@swagger.io
+
+// @license.name MIT
+// @license.url https://github.com/wilian746/go-generator/blob/master/LICENSE
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+func main() {
+	configs := config.GetConfig()
+	connection := database.GetConnection(configs.Dialect, configs.DatabaseURI)
+	migrate(connection)
+	port := fmt.Sprintf(":%v", configs.Port)
+	router := routes.NewRouter().SetRouters(adapter.NewAdapter(connection))
+	log.Println("service running on port ", port)
+	setupSwagger()
+	server := http.ListenAndServe(port, router)
+	log.Fatal(server)
+}
+
+func migrate(connection *gorm.DB) {
+	for _, entity := range []entities.Interface{
+		&product.Product{},
+		&restaurant.Restaurant{},
+		&order.Order{},
+		&doctor.Doctor{},
+		&patient.Patient{},
+		&doctorpatient.DoctorPatient{},
+	} {
+		connection.Table(entity.TableName()).AutoMigrate(entity)
+	}
+}
+
+func setupSwagger() {
+	configs := config.GetConfig()
+	// If your change host to Ex.: 192.168.1.0 is necessary change manually you field of search
+	// to your host too in your browser
+	docs.SwaggerInfo.Host = configs.SwaggerHost
+	docs.SwaggerInfo.BasePath = routes.BasePath
+	log.Println("swagger running on url: ", fmt.Sprintf("http://%s/swagger/index.html", docs.SwaggerInfo.Host))
+	log.Println("graphql playground running on url: ",
+		fmt.Sprintf("http://%s%s/graphql", docs.SwaggerInfo.Host, routes.BasePath))
+}
@@ -0,0 +1,67 @@
+//go:build graphql
+// +build graphql
+
+package doctorpatient
+
+import (
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctorpatient"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	Create(entity *doctorpatient.DoctorPatient) (uuid.UUID, error)
+	Remove(doctorID, patientID uuid.UUID) error
+	ListByDoctorIDs(doctorIDs []uuid.UUID) (entities []doctorpatient.DoctorPatient, err error)
+	ListByPatientIDs(patientIDs []uuid.UUID) (entities []doctorpatient.DoctorPatient, err error)
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) Create(entity *doctorpatient.DoctorPatient) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Remove(doctorID, patientID uuid.UUID) error {
+	var entity doctorpatient.DoctorPatient
+
+	condition := map[string]interface{}{"doctor_id": doctorID, "patient_id": patientID}
+	response := c.repository.Delete(condition, entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
+
+func (c *Controller) ListByDoctorIDs(doctorIDs []uuid.UUID) (entities []doctorpatient.DoctorPatient, err error) {
+	return c.listBy("doctor_id", doctorIDs)
+}
+
+func (c *Controller) ListByPatientIDs(patientIDs []uuid.UUID) (entities []doctorpatient.DoctorPatient, err error) {
+	return c.listBy("patient_id", patientIDs)
+}
+
+func (c *Controller) listBy(column string, IDs []uuid.UUID) (entities []doctorpatient.DoctorPatient, err error) {
+	entity := &doctorpatient.DoctorPatient{}
+	if len(IDs) == 0 {
+		return []doctorpatient.DoctorPatient{}, nil
+	}
+	query := c.repository.Connection(entity.TableName()).Where(column+" IN (?)", IDs)
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
@@ -0,0 +1,109 @@
+//go:build graphql
+// +build graphql
+
+package doctorpatient
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	EntitiesDoctorPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctorpatient"
+	RulesDoctorPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/doctorpatient"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/database"
+	"testing"
+)
+
+func TestNewController(t *testing.T) {
+	t.Run("Should create instance controller", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewController(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestController_Create(t *testing.T) {
+	t.Run("Should create relation between doctor and patient on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctorPatient.NewRules()
+		mock := rules.GetMock()
+		rules.Migrate(conn, mock)
+		id, err := controller.Create(mock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+	})
+	t.Run("Should not create relation on database because not migrate table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		id, err := controller.Create(RulesDoctorPatient.NewRules().GetMock())
+		assert.Error(t, err)
+		assert.Equal(t, id, uuid.Nil)
+	})
+}
+
+func TestController_Remove(t *testing.T) {
+	t.Run("Should return error record not found when relation not exists", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctorPatient.NewRules()
+		rules.Migrate(conn, rules.GetMock())
+		assert.Equal(t, adapter.ErrRecordNotFound, controller.Remove(uuid.New(), uuid.New()))
+	})
+	t.Run("Should return error because not migrate when remove", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		err := controller.Remove(uuid.New(), uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, adapter.ErrRecordNotFound, err)
+	})
+	t.Run("Should remove relation without error", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctorPatient.NewRules()
+		mock := rules.GetMock()
+		rules.Migrate(conn, mock)
+		_, err := controller.Create(mock)
+		assert.NoError(t, err)
+		assert.NoError(t, controller.Remove(mock.DoctorID, mock.PatientID))
+		list, err := controller.ListByDoctorIDs([]uuid.UUID{mock.DoctorID})
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
+
+func TestController_ListByDoctorIDs_ListByPatientIDs(t *testing.T) {
+	t.Run("Should return empty list without query when IDs is empty", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListByDoctorIDs(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []EntitiesDoctorPatient.DoctorPatient{}, list)
+		list, err = controller.ListByPatientIDs(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []EntitiesDoctorPatient.DoctorPatient{}, list)
+	})
+	t.Run("Should return only relations of doctors and patients informed", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesDoctorPatient.NewRules()
+		first := rules.GetMock()
+		second := rules.GetMock()
+		rules.Migrate(conn, first)
+		_, err := controller.Create(first)
+		assert.NoError(t, err)
+		_, err = controller.Create(second)
+		assert.NoError(t, err)
+		list, err := controller.ListByDoctorIDs([]uuid.UUID{first.DoctorID})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, first.PatientID, list[0].PatientID)
+		list, err = controller.ListByPatientIDs([]uuid.UUID{first.PatientID, second.PatientID})
+		assert.NoError(t, err)
+		assert.Len(t, list, 2)
+	})
+	t.Run("Should return error on list because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListByDoctorIDs([]uuid.UUID{uuid.New()})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,90 @@
+//go:build graphql
+// +build graphql
+
+package order
+
+import (
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/order"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	ListOne(ID uuid.UUID) (entity order.Order, err error)
+	ListAll() (entities []order.Order, err error)
+	Create(entity *order.Order) (uuid.UUID, error)
+	Update(ID uuid.UUID, entity *order.Order) error
+	Remove(ID uuid.UUID) error
+	ListByRestaurantIDs(restaurantIDs []uuid.UUID) (entities []order.Order, err error)
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) ListOne(id uuid.UUID) (entity order.Order, err error) {
+	query := c.repository.Connection(entity.TableName()).Where(map[string]interface{}{"id": id})
+	response := c.repository.Find(query, &entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return order.Order{}, err
+	}
+
+	return entity, nil
+}
+
+func (c *Controller) ListAll() (entities []order.Order, err error) {
+	entity := &order.Order{}
+	query := c.repository.Connection(entity.TableName())
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
+
+func (c *Controller) Create(entity *order.Order) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Update(id uuid.UUID, entity *order.Order) error {
+	entity.SetUpdatedAt()
+	_, err := c.ListOne(id)
+	if err != nil {
+		return err
+	}
+	response := c.repository.Update(map[string]interface{}{"id": id}, &entity, entity.TableName())
+	return response.Error()
+}
+
+func (c *Controller) Remove(id uuid.UUID) error {
+	var entity order.Order
+
+	response := c.repository.Delete(map[string]interface{}{"id": id}, entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
+
+func (c *Controller) ListByRestaurantIDs(restaurantIDs []uuid.UUID) (entities []order.Order, err error) {
+	entity := &order.Order{}
+	if len(restaurantIDs) == 0 {
+		return []order.Order{}, nil
+	}
+	query := c.repository.Connection(entity.TableName()).Where("restaurant_id IN (?)", restaurantIDs)
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
@@ -0,0 +1,227 @@
+//go:build graphql
+// +build graphql
+
+package order
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	EntitiesOrder "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/order"
+	RulesOrder "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/order"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/database"
+	"testing"
+)
+
+func TestNewController(t *testing.T) {
+	t.Run("Should create instance controller", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		assert.NotEmpty(t, NewController(adapter.NewAdapter(conn)))
+	})
+}
+
+func TestController_Create(t *testing.T) {
+	t.Run("Should create order on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		id, err := controller.Create(orderMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+	})
+	t.Run("Should not create order on database because not migrate table", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		id, err := controller.Create(orderMock)
+		assert.Error(t, err)
+		assert.Equal(t, id, uuid.Nil)
+	})
+}
+
+func TestController_ListAll(t *testing.T) {
+	t.Run("Should return empty list of order on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.Equal(t, list, []EntitiesOrder.Order{})
+	})
+	t.Run("Should not return empty list of order on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		id, err := controller.Create(orderMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		list, err := controller.ListAll()
+		assert.NoError(t, err)
+		assert.NotEqual(t, list, []EntitiesOrder.Order{})
+	})
+	t.Run("Should return error on list of order on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListAll()
+		assert.Error(t, err)
+		assert.Equal(t, list, []EntitiesOrder.Order{})
+	})
+}
+
+func TestController_ListOne(t *testing.T) {
+	t.Run("Should return error record not found order on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error order on database because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListOne(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should not return empty order on database", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		id, err := controller.Create(orderMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(orderMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, orderMock.ID)
+	})
+}
+
+func TestController_Update(t *testing.T) {
+	t.Run("Should return error record not found order on database when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Update(ID, orderMock)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error order on database because not migrate when update", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		err := controller.Update(uuid.New(), orderMock)
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should udpdate order without error and check if descriptions is different", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		id, err := controller.Create(orderMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(orderMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, orderMock.ID)
+		orderMockUpdate := rules.GetMock()
+		orderMockUpdate.ID = orderMock.ID
+		err = controller.Update(orderMockUpdate.ID, orderMockUpdate)
+		assert.NoError(t, err)
+		foundedUpdated, err := controller.ListOne(orderMockUpdate.ID)
+		assert.NoError(t, err)
+		assert.NotEqual(t, foundedUpdated.Description, orderMock.Description)
+	})
+}
+
+func TestController_Delete(t *testing.T) {
+	t.Run("Should return error record not found order on database when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		ID := uuid.New()
+		_, err := controller.ListOne(ID)
+		assert.Error(t, err)
+		err = controller.Remove(ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should return error order on database because not migrate when delete", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		err := controller.Remove(uuid.New())
+		assert.Error(t, err)
+		assert.NotEqual(t, err, adapter.ErrRecordNotFound)
+	})
+	t.Run("Should delete order without error and check if order not exists", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		orderMock := rules.GetMock()
+		rules.Migrate(conn, orderMock)
+		id, err := controller.Create(orderMock)
+		assert.NoError(t, err)
+		assert.NotEqual(t, id, uuid.Nil)
+		founded, err := controller.ListOne(orderMock.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, founded.ID, orderMock.ID)
+		err = controller.Remove(founded.ID)
+		assert.NoError(t, err)
+		_, err = controller.ListOne(founded.ID)
+		assert.Error(t, err)
+		assert.Equal(t, err, adapter.ErrRecordNotFound)
+	})
+}
+
+func TestController_ListByRestaurantIDs(t *testing.T) {
+	t.Run("Should return empty list without query when restaurants is empty", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		list, err := controller.ListByRestaurantIDs(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []EntitiesOrder.Order{}, list)
+	})
+	t.Run("Should return only orders of restaurants informed", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		rules := RulesOrder.NewRules()
+		first := rules.GetMock()
+		second := rules.GetMock()
+		rules.Migrate(conn, first)
+		_, err := controller.Create(first)
+		assert.NoError(t, err)
+		_, err = controller.Create(second)
+		assert.NoError(t, err)
+		list, err := controller.ListByRestaurantIDs([]uuid.UUID{first.RestaurantID, uuid.New()})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, first.ID, list[0].ID)
+	})
+	t.Run("Should return error on list by restaurants because not migrate", func(t *testing.T) {
+		conn := database.GetConnection("sqlite3", ":memory:")
+		controller := NewController(adapter.NewAdapter(conn))
+		_, err := controller.ListByRestaurantIDs([]uuid.UUID{uuid.New()})
+		assert.Error(t, err)
+	})
+}
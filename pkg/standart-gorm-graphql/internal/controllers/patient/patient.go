@@ -0,0 +1,96 @@
+//go:build graphql
+// +build graphql
+
+package patient
+
+import (
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctorpatient"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/patient"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+)
+
+type Controller struct {
+	repository adapter.Interface
+}
+
+type Interface interface {
+	ListOne(ID uuid.UUID) (entity patient.Patient, err error)
+	ListAll() (entities []patient.Patient, err error)
+	Create(entity *patient.Patient) (uuid.UUID, error)
+	Update(ID uuid.UUID, entity *patient.Patient) error
+	Remove(ID uuid.UUID) error
+	ListByIDs(IDs []uuid.UUID) (entities []patient.Patient, err error)
+}
+
+func NewController(repository adapter.Interface) Interface {
+	return &Controller{repository: repository}
+}
+
+func (c *Controller) ListOne(id uuid.UUID) (entity patient.Patient, err error) {
+	query := c.repository.Connection(entity.TableName()).Where(map[string]interface{}{"id": id})
+	response := c.repository.Find(query, &entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return patient.Patient{}, err
+	}
+
+	return entity, nil
+}
+
+func (c *Controller) ListAll() (entities []patient.Patient, err error) {
+	entity := &patient.Patient{}
+	query := c.repository.Connection(entity.TableName())
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
+
+func (c *Controller) Create(entity *patient.Patient) (uuid.UUID, error) {
+	entity.SetCreatedAt()
+	response := c.repository.Create(entity, entity.TableName())
+	if err := response.Error(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return entity.ID, nil
+}
+
+func (c *Controller) Update(id uuid.UUID, entity *patient.Patient) error {
+	entity.SetUpdatedAt()
+	_, err := c.ListOne(id)
+	if err != nil {
+		return err
+	}
+	response := c.repository.Update(map[string]interface{}{"id": id}, &entity, entity.TableName())
+	return response.Error()
+}
+
+func (c *Controller) Remove(id uuid.UUID) error {
+	var entity patient.Patient
+	var relation doctorpatient.DoctorPatient
+
+	// the doctors_patients are removed here because AutoMigrate does not create the foreign keys with cascade
+	if err := c.repository.Delete(map[string]interface{}{"patient_id": id}, relation.TableName()).Error(); err != nil {
+		return err
+	}
+	response := c.repository.Delete(map[string]interface{}{"id": id}, entity.TableName())
+	if response.Error() == nil && response.RowsAffected() == 0 {
+		return adapter.ErrRecordNotFound
+	}
+	return response.Error()
+}
+
+func (c *Controller) ListByIDs(IDs []uuid.UUID) (entities []patient.Patient, err error) {
+	entity := &patient.Patient{}
+	if len(IDs) == 0 {
+		return []patient.Patient{}, nil
+	}
+	query := c.repository.Connection(entity.TableName()).Where("id IN (?)", IDs)
+	response := c.repository.Find(query, &entities, entity.TableName())
+	if err := response.Error(); err != nil {
+		return entities, err
+	}
+	return entities, nil
+}
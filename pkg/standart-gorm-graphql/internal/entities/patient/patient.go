@@ -0,0 +1,37 @@
+//go:build graphql
+// +build graphql
+
+package patient
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities"
+	"time"
+)
+
+type Patient struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (p *Patient) TableName() string {
+	return "patients"
+}
+
+func (p *Patient) Bytes() []byte {
+	bytes, _ := json.Marshal(p)
+	return bytes
+}
+
+func (p *Patient) GenerateID() {
+	p.ID = uuid.New()
+}
+
+func (p *Patient) SetCreatedAt() {
+	p.CreatedAt = time.Now()
+}
+
+func (p *Patient) SetUpdatedAt() {
+	p.UpdatedAt = time.Now()
+}
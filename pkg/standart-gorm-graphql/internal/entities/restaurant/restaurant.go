@@ -0,0 +1,37 @@
+//go:build graphql
+// +build graphql
+
+package restaurant
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities"
+	"time"
+)
+
+type Restaurant struct {
+	entities.Base
+	Name string `json:"name"`
+}
+
+func (r *Restaurant) TableName() string {
+	return "restaurants"
+}
+
+func (r *Restaurant) Bytes() []byte {
+	bytes, _ := json.Marshal(r)
+	return bytes
+}
+
+func (r *Restaurant) GenerateID() {
+	r.ID = uuid.New()
+}
+
+func (r *Restaurant) SetCreatedAt() {
+	r.CreatedAt = time.Now()
+}
+
+func (r *Restaurant) SetUpdatedAt() {
+	r.UpdatedAt = time.Now()
+}
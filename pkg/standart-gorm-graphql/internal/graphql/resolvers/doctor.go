@@ -0,0 +1,165 @@
+//go:build graphql
+// +build graphql
+
+package resolvers
+
+import (
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctor"
+	EntitiesDoctorPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/doctorpatient"
+)
+
+type doctorResolver struct {
+	entity   doctor.Doctor
+	patients *patientsLoader
+}
+
+type doctorInput struct {
+	Name string
+}
+
+func (d *doctorResolver) ID() graphql.ID {
+	return graphql.ID(d.entity.ID.String())
+}
+
+func (d *doctorResolver) Name() string {
+	return d.entity.Name
+}
+
+func (d *doctorResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: d.entity.CreatedAt}
+}
+
+func (d *doctorResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: d.entity.UpdatedAt}
+}
+
+func (d *doctorResolver) Patients() ([]*patientResolver, error) {
+	return d.patients.Load(d.entity.ID)
+}
+
+func (r *Resolver) Doctor(args struct{ ID graphql.ID }) (*doctorResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.DoctorController.ListOne(ID)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newDoctorResolvers(r, []doctor.Doctor{entity})[0], nil
+}
+
+func (r *Resolver) Doctors() ([]*doctorResolver, error) {
+	entities, err := r.DoctorController.ListAll()
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newDoctorResolvers(r, entities), nil
+}
+
+func (r *Resolver) CreateDoctor(args struct{ Input doctorInput }) (*doctorResolver, error) {
+	entity := &doctor.Doctor{Name: args.Input.Name}
+	entity.GenerateID()
+	if err := r.DoctorRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if _, err := r.DoctorController.Create(entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newDoctorResolvers(r, []doctor.Doctor{*entity})[0], nil
+}
+
+func (r *Resolver) UpdateDoctor(args struct {
+	ID    graphql.ID
+	Input doctorInput
+}) (*doctorResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity := &doctor.Doctor{Name: args.Input.Name}
+	entity.ID = ID
+	if err := r.DoctorRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if err := r.DoctorController.Update(ID, entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Doctor(struct{ ID graphql.ID }{ID: args.ID})
+}
+
+func (r *Resolver) DeleteDoctor(args struct{ ID graphql.ID }) (graphql.ID, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := r.DoctorController.Remove(ID); err != nil {
+		return "", validateErrorController(err)
+	}
+	return args.ID, nil
+}
+
+func newDoctorResolvers(root *Resolver, entities []doctor.Doctor) []*doctorResolver {
+	IDs := []uuid.UUID{}
+	for index := range entities {
+		IDs = append(IDs, entities[index].ID)
+	}
+	loader := newPatientsLoader(root, IDs)
+	resolvers := []*doctorResolver{}
+	for index := range entities {
+		resolvers = append(resolvers, &doctorResolver{entity: entities[index], patients: loader})
+	}
+	return resolvers
+}
+
+type doctorPatientArgs struct {
+	DoctorID  graphql.ID
+	PatientID graphql.ID
+}
+
+func (r *Resolver) AddPatientToDoctor(args doctorPatientArgs) (*doctorResolver, error) {
+	entity, err := r.getDoctorPatientOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	entity.GenerateID()
+	if err := r.DoctorPatientRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if _, err := r.PatientController.ListOne(entity.PatientID); err != nil {
+		return nil, validateErrorController(err)
+	}
+	if _, err := r.DoctorPatientController.Create(entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Doctor(struct{ ID graphql.ID }{ID: args.DoctorID})
+}
+
+func (r *Resolver) RemovePatientFromDoctor(args doctorPatientArgs) (*doctorResolver, error) {
+	entity, err := r.getDoctorPatientOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.DoctorPatientController.Remove(entity.DoctorID, entity.PatientID); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Doctor(struct{ ID graphql.ID }{ID: args.DoctorID})
+}
+
+// getDoctorPatientOfArgs returns the relation only if the doctor exists, the patient is checked when is added
+func (r *Resolver) getDoctorPatientOfArgs(args doctorPatientArgs) (*EntitiesDoctorPatient.DoctorPatient, error) {
+	doctorID, err := parseID(args.DoctorID)
+	if err != nil {
+		return nil, err
+	}
+	patientID, err := parseID(args.PatientID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.DoctorController.ListOne(doctorID); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return &EntitiesDoctorPatient.DoctorPatient{DoctorID: doctorID, PatientID: patientID}, nil
+}
@@ -0,0 +1,76 @@
+//go:build graphql
+// +build graphql
+
+package resolvers
+
+import (
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	ControllersDoctor "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/controllers/doctor"
+	ControllersDoctorPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/controllers/doctorpatient"
+	ControllersOrder "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/controllers/order"
+	ControllersPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/controllers/patient"
+	ControllersProduct "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/controllers/product"
+	ControllersRestaurant "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/controllers/restaurant"
+	RulesDoctor "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/doctor"
+	RulesDoctorPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/doctorpatient"
+	RulesOrder "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/order"
+	RulesPatient "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/patient"
+	RulesProduct "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/product"
+	RulesRestaurant "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/rules/restaurant"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+)
+
+// Resolver is the root of the schema, the queries and mutations of each entity are in the file of the entity
+type Resolver struct {
+	Repository adapter.Interface
+
+	ProductController       ControllersProduct.Interface
+	RestaurantController    ControllersRestaurant.Interface
+	OrderController         ControllersOrder.Interface
+	DoctorController        ControllersDoctor.Interface
+	PatientController       ControllersPatient.Interface
+	DoctorPatientController ControllersDoctorPatient.Interface
+
+	ProductRules       *RulesProduct.Rules
+	RestaurantRules    *RulesRestaurant.Rules
+	OrderRules         *RulesOrder.Rules
+	DoctorRules        *RulesDoctor.Rules
+	PatientRules       *RulesPatient.Rules
+	DoctorPatientRules *RulesDoctorPatient.Rules
+}
+
+func NewResolver(repository adapter.Interface) *Resolver {
+	return &Resolver{
+		Repository: repository,
+
+		ProductController:       ControllersProduct.NewController(repository),
+		RestaurantController:    ControllersRestaurant.NewController(repository),
+		OrderController:         ControllersOrder.NewController(repository),
+		DoctorController:        ControllersDoctor.NewController(repository),
+		PatientController:       ControllersPatient.NewController(repository),
+		DoctorPatientController: ControllersDoctorPatient.NewController(repository),
+
+		ProductRules:       RulesProduct.NewRules(),
+		RestaurantRules:    RulesRestaurant.NewRules(),
+		OrderRules:         RulesOrder.NewRules(),
+		DoctorRules:        RulesDoctor.NewRules(),
+		PatientRules:       RulesPatient.NewRules(),
+		DoctorPatientRules: RulesDoctorPatient.NewRules(),
+	}
+}
+
+func (r *Resolver) Health() (bool, error) {
+	if !r.Repository.Health() {
+		return false, NewInternalServerError(ErrRelationalNotOK)
+	}
+	return true, nil
+}
+
+func parseID(ID graphql.ID) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(string(ID))
+	if err != nil || parsed == uuid.Nil {
+		return uuid.Nil, NewBadRequest(ErrIDInvalid)
+	}
+	return parsed, nil
+}
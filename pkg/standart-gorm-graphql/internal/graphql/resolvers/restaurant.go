@@ -0,0 +1,114 @@
+//go:build graphql
+// +build graphql
+
+package resolvers
+
+import (
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/restaurant"
+)
+
+type restaurantResolver struct {
+	entity restaurant.Restaurant
+	orders *ordersLoader
+}
+
+type restaurantInput struct {
+	Name string
+}
+
+func (r *restaurantResolver) ID() graphql.ID {
+	return graphql.ID(r.entity.ID.String())
+}
+
+func (r *restaurantResolver) Name() string {
+	return r.entity.Name
+}
+
+func (r *restaurantResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.entity.CreatedAt}
+}
+
+func (r *restaurantResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.entity.UpdatedAt}
+}
+
+func (r *restaurantResolver) Orders() ([]*orderResolver, error) {
+	return r.orders.Load(r.entity.ID)
+}
+
+func (r *Resolver) Restaurant(args struct{ ID graphql.ID }) (*restaurantResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity, err := r.RestaurantController.ListOne(ID)
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newRestaurantResolvers(r, []restaurant.Restaurant{entity})[0], nil
+}
+
+func (r *Resolver) Restaurants() ([]*restaurantResolver, error) {
+	entities, err := r.RestaurantController.ListAll()
+	if err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newRestaurantResolvers(r, entities), nil
+}
+
+func (r *Resolver) CreateRestaurant(args struct{ Input restaurantInput }) (*restaurantResolver, error) {
+	entity := &restaurant.Restaurant{Name: args.Input.Name}
+	entity.GenerateID()
+	if err := r.RestaurantRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if _, err := r.RestaurantController.Create(entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return newRestaurantResolvers(r, []restaurant.Restaurant{*entity})[0], nil
+}
+
+func (r *Resolver) UpdateRestaurant(args struct {
+	ID    graphql.ID
+	Input restaurantInput
+}) (*restaurantResolver, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	entity := &restaurant.Restaurant{Name: args.Input.Name}
+	entity.ID = ID
+	if err := r.RestaurantRules.Validate(entity); err != nil {
+		return nil, NewBadRequest(err)
+	}
+	if err := r.RestaurantController.Update(ID, entity); err != nil {
+		return nil, validateErrorController(err)
+	}
+	return r.Restaurant(struct{ ID graphql.ID }{ID: args.ID})
+}
+
+func (r *Resolver) DeleteRestaurant(args struct{ ID graphql.ID }) (graphql.ID, error) {
+	ID, err := parseID(args.ID)
+	if err != nil {
+		return "", err
+	}
+	if err := r.RestaurantController.Remove(ID); err != nil {
+		return "", validateErrorController(err)
+	}
+	return args.ID, nil
+}
+
+func newRestaurantResolvers(root *Resolver, entities []restaurant.Restaurant) []*restaurantResolver {
+	IDs := []uuid.UUID{}
+	for index := range entities {
+		IDs = append(IDs, entities[index].ID)
+	}
+	loader := newOrdersLoader(root, IDs)
+	resolvers := []*restaurantResolver{}
+	for index := range entities {
+		resolvers = append(resolvers, &restaurantResolver{entity: entities[index], orders: loader})
+	}
+	return resolvers
+}
@@ -0,0 +1,30 @@
+//go:build graphql
+// +build graphql
+
+package schema
+
+const order = `
+type Order {
+	id: ID!
+	description: String!
+	restaurantId: ID!
+	createdAt: Time!
+	updatedAt: Time!
+}
+
+input OrderInput {
+	description: String!
+	restaurantId: ID!
+}
+
+extend type Query {
+	order(id: ID!): Order!
+	orders: [Order!]!
+}
+
+extend type Mutation {
+	createOrder(input: OrderInput!): Order!
+	updateOrder(id: ID!, input: OrderInput!): Order!
+	deleteOrder(id: ID!): ID!
+}
+`
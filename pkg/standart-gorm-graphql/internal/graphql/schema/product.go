@@ -0,0 +1,28 @@
+//go:build graphql
+// +build graphql
+
+package schema
+
+const product = `
+type Product {
+	id: ID!
+	name: String!
+	createdAt: Time!
+	updatedAt: Time!
+}
+
+input ProductInput {
+	name: String!
+}
+
+extend type Query {
+	product(id: ID!): Product!
+	products: [Product!]!
+}
+
+extend type Mutation {
+	createProduct(input: ProductInput!): Product!
+	updateProduct(id: ID!, input: ProductInput!): Product!
+	deleteProduct(id: ID!): ID!
+}
+`
@@ -0,0 +1,29 @@
+//go:build graphql
+// +build graphql
+
+package schema
+
+const restaurant = `
+type Restaurant {
+	id: ID!
+	name: String!
+	createdAt: Time!
+	updatedAt: Time!
+	orders: [Order!]!
+}
+
+input RestaurantInput {
+	name: String!
+}
+
+extend type Query {
+	restaurant(id: ID!): Restaurant!
+	restaurants: [Restaurant!]!
+}
+
+extend type Mutation {
+	createRestaurant(input: RestaurantInput!): Restaurant!
+	updateRestaurant(id: ID!, input: RestaurantInput!): Restaurant!
+	deleteRestaurant(id: ID!): ID!
+}
+`
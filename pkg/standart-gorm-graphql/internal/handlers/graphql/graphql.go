@@ -0,0 +1,93 @@
+//go:build graphql
+// +build graphql
+
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	GraphQL "github.com/graph-gophers/graphql-go"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/graphql/resolvers"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/graphql/schema"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/handlers"
+	HttpStatus "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/utils/http"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/adapter"
+	"html/template"
+	"net/http"
+)
+
+var ErrBodyInvalid = errors.New("body is invalid")
+
+type Handler struct {
+	handlers.Interface
+	Schema *GraphQL.Schema
+}
+
+type params struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func NewHandler(repository adapter.Interface) handlers.Interface {
+	return &Handler{
+		Schema: GraphQL.MustParseSchema(schema.String(), resolvers.NewResolver(repository)),
+	}
+}
+
+// Get render the playground to run queries and mutations in the same route of the handler
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(fmt.Sprintf(playground, template.JSEscapeString(r.URL.Path))))
+}
+
+// Post execute the query and return the response following the specification of GraphQL, with data and errors
+func (h *Handler) Post(w http.ResponseWriter, r *http.Request) {
+	var body params
+	if r.Body == nil || json.NewDecoder(r.Body).Decode(&body) != nil {
+		HttpStatus.StatusBadRequest(w, r, ErrBodyInvalid)
+		return
+	}
+	response := h.Schema.Exec(r.Context(), body.Query, body.OperationName, body.Variables)
+	content, err := json.Marshal(response)
+	if err != nil {
+		HttpStatus.StatusInternalServerError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
+func (h *Handler) Put(w http.ResponseWriter, r *http.Request) {
+	HttpStatus.StatusMethodNotAllowed(w, r)
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	HttpStatus.StatusMethodNotAllowed(w, r)
+}
+
+func (h *Handler) Options(w http.ResponseWriter, r *http.Request) {
+	HttpStatus.StatusNoContent(w, r)
+}
+
+const playground = `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8"/>
+	<title>GraphQL Playground</title>
+	<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/graphql-playground-react/build/static/css/index.css"/>
+	<script src="https://cdn.jsdelivr.net/npm/graphql-playground-react/build/static/js/middleware.js"></script>
+</head>
+<body>
+	<div id="root"></div>
+	<script>
+		window.addEventListener('load', function () {
+			GraphQLPlayground.init(document.getElementById('root'), {endpoint: '%s'})
+		})
+	</script>
+</body>
+</html>
+`
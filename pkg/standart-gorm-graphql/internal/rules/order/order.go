@@ -0,0 +1,47 @@
+//go:build graphql
+// +build graphql
+
+package order
+
+import (
+	Validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/order"
+	RepositoryEntity "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/entities"
+	"time"
+)
+
+type Rules struct{}
+
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+func (p *Rules) GetMock() *order.Order {
+	return &order.Order{
+		Base: entities.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		Description:  uuid.New().String(),
+		RestaurantID: uuid.New(),
+	}
+}
+
+func (p *Rules) Migrate(connection *gorm.DB, model RepositoryEntity.Interface) *gorm.DB {
+	connection = connection.Table(model.TableName())
+	connection.AutoMigrate(model)
+	return connection
+}
+
+func (p *Rules) Validate(orderEntity *order.Order) error {
+	return Validation.ValidateStruct(orderEntity,
+		Validation.Field(&orderEntity.ID, Validation.Required, is.UUIDv4),
+		Validation.Field(&orderEntity.Description, Validation.Required, Validation.Length(3, 255)),
+		Validation.Field(&orderEntity.RestaurantID, Validation.Required, is.UUIDv4),
+	)
+}
@@ -0,0 +1,45 @@
+//go:build graphql
+// +build graphql
+
+package restaurant
+
+import (
+	Validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-graphql/internal/entities/restaurant"
+	RepositoryEntity "github.com/wilian746/go-generator/pkg/standart-gorm-graphql/pkg/repository/entities"
+	"time"
+)
+
+type Rules struct{}
+
+func NewRules() *Rules {
+	return &Rules{}
+}
+
+func (p *Rules) GetMock() *restaurant.Restaurant {
+	return &restaurant.Restaurant{
+		Base: entities.Base{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		Name: uuid.New().String(),
+	}
+}
+
+func (p *Rules) Migrate(connection *gorm.DB, model RepositoryEntity.Interface) *gorm.DB {
+	connection = connection.Table(model.TableName())
+	connection.AutoMigrate(model)
+	return connection
+}
+
+func (p *Rules) Validate(restaurantEntity *restaurant.Restaurant) error {
+	return Validation.ValidateStruct(restaurantEntity,
+		Validation.Field(&restaurantEntity.ID, Validation.Required, is.UUIDv4),
+		Validation.Field(&restaurantEntity.Name, Validation.Required, Validation.Length(3, 50)),
+	)
+}
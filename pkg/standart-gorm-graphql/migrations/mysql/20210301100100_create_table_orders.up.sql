@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS `orders`
+(
+    `id` varchar(255) NOT NULL,
+    `description` varchar(255) NOT NULL,
+    `restaurant_id` varchar(255) NOT NULL,
+    `created_at` DATETIME NOT NULL,
+    `updated_at` DATETIME NOT NULL,
+    PRIMARY KEY (id),
+    FOREIGN KEY (`restaurant_id`) REFERENCES `restaurants`(`id`) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "restaurants"
+(
+    "id" uuid NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "created_at" varchar(50) NOT NULL,
+    "updated_at" varchar(50) NOT NULL,
+    PRIMARY KEY (id)
+);
+
+COMMIT;
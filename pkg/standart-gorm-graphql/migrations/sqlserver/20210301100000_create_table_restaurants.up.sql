@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE [restaurants]
+(
+    [id] varchar(255) NOT NULL,
+    [name] varchar(50) NOT NULL,
+    [created_at] varchar(50) NOT NULL,
+    [updated_at] varchar(50) NOT NULL,
+    PRIMARY KEY ([id])
+);
+
+END;
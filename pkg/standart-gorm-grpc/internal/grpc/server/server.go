@@ -0,0 +1,48 @@
+//go:build grpc
+// +build grpc
+
+package server
+
+import (
+	"context"
+	ProtoProduct "github.com/wilian746/go-generator/pkg/standart-gorm-grpc/api/proto/product"
+	GrpcProduct "github.com/wilian746/go-generator/pkg/standart-gorm-grpc/internal/grpc/product"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-grpc/pkg/repository/adapter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const ProductServiceName = "product.v1.ProductService"
+
+type Health struct {
+	*health.Server
+	repository adapter.Interface
+}
+
+func NewServer(repository adapter.Interface) *grpc.Server {
+	server := grpc.NewServer()
+	ProtoProduct.RegisterProductServiceServer(server, GrpcProduct.NewServer(repository))
+	grpc_health_v1.RegisterHealthServer(server, NewHealth(repository))
+	reflection.Register(server)
+	return server
+}
+
+func NewHealth(repository adapter.Interface) grpc_health_v1.HealthServer {
+	server := health.NewServer()
+	server.SetServingStatus(ProductServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	return &Health{
+		Server:     server,
+		repository: repository,
+	}
+}
+
+// Check returns not serving when the connection with database is not alive, like the route /health
+func (h *Health) Check(ctx context.Context,
+	request *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if !h.repository.Health() {
+		return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return h.Server.Check(ctx, request)
+}
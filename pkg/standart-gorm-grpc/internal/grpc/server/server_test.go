@@ -0,0 +1,92 @@
+//go:build grpc
+// +build grpc
+
+package server
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/pkg/standart-gorm-grpc/pkg/repository/adapter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"testing"
+)
+
+func getClientConnection(t *testing.T, repository adapter.Interface) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(repository)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	connection, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = connection.Close()
+		server.Stop()
+	})
+	return connection
+}
+
+func TestNewServer(t *testing.T) {
+	t.Run("Should return instance of server with all services registered", func(t *testing.T) {
+		services := NewServer(&adapter.Mock{}).GetServiceInfo()
+		assert.Contains(t, services, ProductServiceName)
+		assert.Contains(t, services, "grpc.health.v1.Health")
+		assert.Contains(t, services, "grpc.reflection.v1alpha.ServerReflection")
+	})
+}
+
+func TestHealth_Check(t *testing.T) {
+	t.Run("Should return serving when database is alive", func(t *testing.T) {
+		mock := &adapter.Mock{}
+		mock.On("Health").Return(true)
+		client := grpc_health_v1.NewHealthClient(getClientConnection(t, mock))
+		for _, service := range []string{"", ProductServiceName} {
+			response, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+			assert.NoError(t, err)
+			assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, response.GetStatus())
+		}
+	})
+	t.Run("Should return not serving when database is not alive", func(t *testing.T) {
+		mock := &adapter.Mock{}
+		mock.On("Health").Return(false)
+		client := grpc_health_v1.NewHealthClient(getClientConnection(t, mock))
+		response, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, response.GetStatus())
+	})
+	t.Run("Should return not found when service is unknown", func(t *testing.T) {
+		mock := &adapter.Mock{}
+		mock.On("Health").Return(true)
+		client := grpc_health_v1.NewHealthClient(getClientConnection(t, mock))
+		_, err := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestReflection(t *testing.T) {
+	t.Run("Should list services using server reflection", func(t *testing.T) {
+		client := grpc_reflection_v1alpha.NewServerReflectionClient(getClientConnection(t, &adapter.Mock{}))
+		stream, err := client.ServerReflectionInfo(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+			MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+		}))
+		response, err := stream.Recv()
+		assert.NoError(t, err)
+		var services []string
+		for _, service := range response.GetListServicesResponse().GetService() {
+			services = append(services, service.GetName())
+		}
+		assert.Contains(t, services, ProductServiceName)
+		assert.Contains(t, services, "grpc.health.v1.Health")
+	})
+}
@@ -2,7 +2,6 @@ package product
 
 import (
 	"errors"
-	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	ControllersProduct "github.com/wilian746/go-generator/pkg/standart-gorm/internal/controllers/product"
 	_ "github.com/wilian746/go-generator/pkg/standart-gorm/internal/entities" // import used in swagger
@@ -10,6 +9,7 @@ import (
 	"github.com/wilian746/go-generator/pkg/standart-gorm/internal/handlers"
 	RulesProduct "github.com/wilian746/go-generator/pkg/standart-gorm/internal/rules/product"
 	HttpStatus "github.com/wilian746/go-generator/pkg/standart-gorm/internal/utils/http"
+	"github.com/wilian746/go-generator/pkg/standart-gorm/internal/utils/params"
 	"github.com/wilian746/go-generator/pkg/standart-gorm/pkg/repository/adapter"
 	"net/http"
 )
@@ -28,7 +28,7 @@ func NewHandler(repository adapter.Interface) handlers.Interface {
 }
 
 func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
-	if chi.URLParam(r, "ID") != "" {
+	if params.Get(r, "ID") != "" {
 		h.getOne(w, r)
 	} else {
 		h.getAll(w, r)
@@ -47,7 +47,7 @@ func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
 // @Failure 500 {object} http.ResponseError
 // @Router /product/{ID} [get]
 func (h *Handler) getOne(w http.ResponseWriter, r *http.Request) {
-	ID, err := uuid.Parse(chi.URLParam(r, "ID"))
+	ID, err := uuid.Parse(params.Get(r, "ID"))
 	if err != nil || ID == uuid.Nil {
 		HttpStatus.StatusBadRequest(w, r, errors.New("ID is not uuid valid"))
 		return
@@ -131,7 +131,7 @@ func (h *Handler) Put(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) getBodyAndIDParam(r *http.Request) (uuid.UUID, *entitiesProduct.Product, error) {
-	ID, err := uuid.Parse(chi.URLParam(r, "ID"))
+	ID, err := uuid.Parse(params.Get(r, "ID"))
 	if err != nil || ID == uuid.Nil {
 		return uuid.Nil, &entitiesProduct.Product{}, errors.New("ID is not uuid valid")
 	}
@@ -154,7 +154,7 @@ func (h *Handler) getBodyAndIDParam(r *http.Request) (uuid.UUID, *entitiesProduc
 // @Failure 500 {object} http.ResponseError
 // @Router /product/{ID} [delete]
 func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
-	ID, err := uuid.Parse(chi.URLParam(r, "ID"))
+	ID, err := uuid.Parse(params.Get(r, "ID"))
 	if err != nil || ID == uuid.Nil {
 		HttpStatus.StatusBadRequest(w, r, errors.New("ID is not uuid valid"))
 		return
@@ -2,11 +2,10 @@ package product
 
 import (
 	"bytes"
-	"context"
-	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/wilian746/go-generator/pkg/standart-gorm/internal/rules/product"
+	"github.com/wilian746/go-generator/pkg/standart-gorm/internal/utils/params"
 	"github.com/wilian746/go-generator/pkg/standart-gorm/pkg/repository/adapter"
 	"github.com/wilian746/go-generator/pkg/standart-gorm/pkg/repository/database"
 	"net/http"
@@ -59,9 +58,7 @@ func TestHandler_Get(t *testing.T) {
 		rules.Migrate(conn, productMock)
 		h := NewHandler(adapter.NewAdapter(conn))
 		r, _ := http.NewRequest(http.MethodGet, "/product/123", nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", "123")
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", "123")
 		w := httptest.NewRecorder()
 		h.Get(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -75,9 +72,7 @@ func TestHandler_Get(t *testing.T) {
 		rules.Migrate(conn, productMock)
 		h := NewHandler(adapter.NewAdapter(conn))
 		r, _ := http.NewRequest(http.MethodGet, "/product/"+ID.String(), nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", ID.String())
 		w := httptest.NewRecorder()
 		h.Get(w, r)
 		assert.Equal(t, http.StatusNotFound, w.Code)
@@ -87,9 +82,7 @@ func TestHandler_Get(t *testing.T) {
 		h := NewHandler(adapter.NewAdapter(conn))
 		ID := uuid.New().String()
 		r, _ := http.NewRequest(http.MethodGet, "/product/"+ID, nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", ID)
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", ID)
 		w := httptest.NewRecorder()
 		h.Get(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -105,9 +98,7 @@ func TestHandler_Get(t *testing.T) {
 		_ = databaseAdapter.Create(productMock, productMock.TableName())
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodGet, "/product/"+ID.String(), nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", ID.String())
 		w := httptest.NewRecorder()
 		h.Get(w, r)
 		assert.Equal(t, http.StatusOK, w.Code)
@@ -122,8 +113,6 @@ func TestHandler_Post(t *testing.T) {
 		productMock := rules.GetMock()
 		rules.Migrate(conn, productMock)
 		r, _ := http.NewRequest(http.MethodPost, "/product/", bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 		w := httptest.NewRecorder()
 		h.Post(w, r)
 		assert.Equal(t, http.StatusOK, w.Code)
@@ -136,8 +125,6 @@ func TestHandler_Post(t *testing.T) {
 		productMock.Name = ""
 		rules.Migrate(conn, productMock)
 		r, _ := http.NewRequest(http.MethodPost, "/product/", bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 		w := httptest.NewRecorder()
 		h.Post(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -148,8 +135,6 @@ func TestHandler_Post(t *testing.T) {
 		rules := product.NewRules()
 		productMock := rules.GetMock()
 		r, _ := http.NewRequest(http.MethodPost, "/product/", bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 		w := httptest.NewRecorder()
 		h.Post(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -164,8 +149,6 @@ func TestHandler_Delete(t *testing.T) {
 		productMock := rules.GetMock()
 		rules.Migrate(conn, productMock)
 		r, _ := http.NewRequest(http.MethodDelete, "/product/", nil)
-		ctx := chi.NewRouteContext()
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 		w := httptest.NewRecorder()
 		h.Delete(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -177,9 +160,7 @@ func TestHandler_Delete(t *testing.T) {
 		productMock := rules.GetMock()
 		rules.Migrate(conn, productMock)
 		r, _ := http.NewRequest(http.MethodDelete, "/product/123", nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", "123")
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", "123")
 		w := httptest.NewRecorder()
 		h.Delete(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -192,9 +173,7 @@ func TestHandler_Delete(t *testing.T) {
 		rules.Migrate(conn, productMock)
 		ID := uuid.New()
 		r, _ := http.NewRequest(http.MethodDelete, "/product/"+ID.String(), nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", ID.String())
 		w := httptest.NewRecorder()
 		h.Delete(w, r)
 		assert.Equal(t, http.StatusNotFound, w.Code)
@@ -204,9 +183,7 @@ func TestHandler_Delete(t *testing.T) {
 		h := NewHandler(adapter.NewAdapter(conn))
 		ID := uuid.New()
 		r, _ := http.NewRequest(http.MethodDelete, "/product/"+ID.String(), nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", ID.String())
 		w := httptest.NewRecorder()
 		h.Delete(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -220,9 +197,7 @@ func TestHandler_Delete(t *testing.T) {
 		_ = databaseAdapter.Create(productMock, productMock.TableName())
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodDelete, "/product/"+productMock.ID.String(), nil)
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", productMock.ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", productMock.ID.String())
 		w := httptest.NewRecorder()
 		h.Delete(w, r)
 		assert.Equal(t, http.StatusNoContent, w.Code)
@@ -239,9 +214,7 @@ func TestHandler_Put(t *testing.T) {
 		_ = databaseAdapter.Create(productMock, productMock.TableName())
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", productMock.ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", productMock.ID.String())
 		w := httptest.NewRecorder()
 		h.Put(w, r)
 		assert.Equal(t, http.StatusNoContent, w.Code)
@@ -255,9 +228,7 @@ func TestHandler_Put(t *testing.T) {
 		_ = databaseAdapter.Create(productMock, productMock.TableName())
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodPut, "/product/"+"123", bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", "123")
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", "123")
 		w := httptest.NewRecorder()
 		h.Put(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -271,8 +242,6 @@ func TestHandler_Put(t *testing.T) {
 		_ = databaseAdapter.Create(productMock, productMock.TableName())
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodPut, "/product/", bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 		w := httptest.NewRecorder()
 		h.Put(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -287,9 +256,7 @@ func TestHandler_Put(t *testing.T) {
 		_ = databaseAdapter.Create(productMock, productMock.TableName())
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", productMock.ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", productMock.ID.String())
 		w := httptest.NewRecorder()
 		h.Put(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -302,9 +269,7 @@ func TestHandler_Put(t *testing.T) {
 		rules.Migrate(conn, productMock)
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", productMock.ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", productMock.ID.String())
 		w := httptest.NewRecorder()
 		h.Put(w, r)
 		assert.Equal(t, http.StatusNotFound, w.Code)
@@ -316,9 +281,7 @@ func TestHandler_Put(t *testing.T) {
 		productMock := rules.GetMock()
 		h := NewHandler(databaseAdapter)
 		r, _ := http.NewRequest(http.MethodPut, "/product/"+productMock.ID.String(), bytes.NewReader(productMock.Bytes()))
-		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("ID", productMock.ID.String())
-		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = params.Set(r, "ID", productMock.ID.String())
 		w := httptest.NewRecorder()
 		h.Put(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
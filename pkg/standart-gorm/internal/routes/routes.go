@@ -1,3 +1,6 @@
+//go:build !gin && !echo && !stdlib
+// +build !gin,!echo,!stdlib
+
 package routes
 
 import (
@@ -8,7 +11,9 @@ import (
 	ServerConfig "github.com/wilian746/go-generator/pkg/standart-gorm/configs"
 	HealthHandler "github.com/wilian746/go-generator/pkg/standart-gorm/internal/handlers/health"
 	ProductHandler "github.com/wilian746/go-generator/pkg/standart-gorm/internal/handlers/product"
+	"github.com/wilian746/go-generator/pkg/standart-gorm/internal/utils/params"
 	"github.com/wilian746/go-generator/pkg/standart-gorm/pkg/repository/adapter"
+	"net/http"
 )
 
 const BasePath = "/api/v1"
@@ -25,7 +30,7 @@ func NewRouter() *Router {
 	}
 }
 
-func (r *Router) SetRouters(repository adapter.Interface) *chi.Mux {
+func (r *Router) SetRouters(repository adapter.Interface) http.Handler {
 	r.setConfigsRouters()
 
 	r.RouterSwagger()
@@ -69,9 +74,9 @@ func (r *Router) RouterProduct(repository adapter.Interface) {
 	r.router.Route(BasePath+"/product", func(route chi.Router) {
 		route.Post("/", handler.Post)
 		route.Get("/", handler.Get)
-		route.Get("/{ID}", handler.Get)
-		route.Put("/{ID}", handler.Put)
-		route.Delete("/{ID}", handler.Delete)
+		route.Get("/{ID}", withParams(handler.Get))
+		route.Put("/{ID}", withParams(handler.Put))
+		route.Delete("/{ID}", withParams(handler.Delete))
 		route.Options("/", handler.Options)
 	})
 }
@@ -105,3 +110,15 @@ func (r *Router) EnableRealIP() *Router {
 	r.router.Use(middleware.RealIP)
 	return r
 }
+
+// withParams copy the params of the path found by chi to the request, so the handlers read it with params.Get
+func withParams(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if ctx := chi.RouteContext(r.Context()); ctx != nil {
+			for index, key := range ctx.URLParams.Keys {
+				r = params.Set(r, key, ctx.URLParams.Values[index])
+			}
+		}
+		handler(w, r)
+	}
+}
@@ -0,0 +1,134 @@
+//go:build gin
+// +build gin
+
+package routes
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/go-chi/chi/middleware"
+	"github.com/swaggo/http-swagger"
+	ServerConfig "github.com/wilian746/go-generator/pkg/standart-gorm/configs"
+	HealthHandler "github.com/wilian746/go-generator/pkg/standart-gorm/internal/handlers/health"
+	ProductHandler "github.com/wilian746/go-generator/pkg/standart-gorm/internal/handlers/product"
+	"github.com/wilian746/go-generator/pkg/standart-gorm/internal/utils/params"
+	"github.com/wilian746/go-generator/pkg/standart-gorm/pkg/repository/adapter"
+	"net/http"
+)
+
+const BasePath = "/api/v1"
+
+type Router struct {
+	config      *Config
+	router      *gin.Engine
+	middlewares []func(http.Handler) http.Handler
+}
+
+func NewRouter() *Router {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	return &Router{
+		config: NewConfig().SetTimeout(ServerConfig.GetConfig().Timeout),
+		router: router,
+	}
+}
+
+func (r *Router) SetRouters(repository adapter.Interface) http.Handler {
+	r.setConfigsRouters()
+
+	r.RouterSwagger()
+	r.RouterHealth(repository)
+	r.RouterProduct(repository)
+
+	return r.handler()
+}
+
+func (r *Router) setConfigsRouters() {
+	r.EnableCORS()
+	r.EnableLogger()
+	r.EnableTimeout()
+	r.EnableRecover()
+	r.EnableRequestID()
+	r.EnableRealIP()
+}
+
+func (r *Router) RouterSwagger() {
+	swaggerHost := fmt.Sprintf("http://localhost:%v/swagger/doc.json", ServerConfig.GetConfig().Port)
+	r.router.GET("/swagger/*any", gin.WrapH(httpSwagger.Handler(
+		httpSwagger.URL(swaggerHost),
+	)))
+}
+
+func (r *Router) RouterHealth(repository adapter.Interface) {
+	handler := HealthHandler.NewHandler(repository)
+
+	route := r.router.Group(BasePath + "/health")
+	route.POST("", withParams(handler.Post))
+	route.GET("", withParams(handler.Get))
+	route.PUT("", withParams(handler.Put))
+	route.DELETE("", withParams(handler.Delete))
+	route.OPTIONS("", withParams(handler.Options))
+}
+
+func (r *Router) RouterProduct(repository adapter.Interface) {
+	handler := ProductHandler.NewHandler(repository)
+
+	route := r.router.Group(BasePath + "/product")
+	route.POST("", withParams(handler.Post))
+	route.GET("", withParams(handler.Get))
+	route.GET("/:ID", withParams(handler.Get))
+	route.PUT("/:ID", withParams(handler.Put))
+	route.DELETE("/:ID", withParams(handler.Delete))
+	route.OPTIONS("", withParams(handler.Options))
+}
+
+func (r *Router) EnableLogger() *Router {
+	r.middlewares = append(r.middlewares, middleware.Logger)
+	return r
+}
+
+func (r *Router) EnableTimeout() *Router {
+	r.middlewares = append(r.middlewares, middleware.Timeout(r.config.GetTimeout()))
+	return r
+}
+
+func (r *Router) EnableCORS() *Router {
+	r.middlewares = append(r.middlewares, r.config.Cors)
+	return r
+}
+
+func (r *Router) EnableRecover() *Router {
+	r.middlewares = append(r.middlewares, middleware.Recoverer)
+	return r
+}
+
+func (r *Router) EnableRequestID() *Router {
+	r.middlewares = append(r.middlewares, middleware.RequestID)
+	return r
+}
+
+func (r *Router) EnableRealIP() *Router {
+	r.middlewares = append(r.middlewares, middleware.RealIP)
+	return r
+}
+
+// handler wrap gin with the middlewares in the order that was enabled, the first enabled is the first executed
+func (r *Router) handler() http.Handler {
+	var handler http.Handler = r.router
+	for index := len(r.middlewares) - 1; index >= 0; index-- {
+		handler = r.middlewares[index](handler)
+	}
+	return handler
+}
+
+// withParams copy the params of the path found by gin to the request, so the handlers read it with params.Get
+func withParams(handler http.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		r := c.Request
+		for _, param := range c.Params {
+			r = params.Set(r, param.Key, param.Value)
+		}
+		handler(c.Writer, r)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/configs"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/docs"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/internal/entities/product"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/internal/routes"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/pkg/repository/adapter"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/pkg/repository/database"
+	"log"
+	"net/http"
+)
+
+// @title Standart Gorm V2
+// @version 1.0
+// @description This is a sample server using standart gorm v2 server.
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name Standart Gorm V2 Support
+// @contact.url https://github.com/wilian746/go-generator/issues
+// @contact.email support@swagger.io
+
+// @license.name MIT
+// @license.url https://github.com/wilian746/go-generator/blob/master/LICENSE
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+func main() {
+	configs := config.GetConfig()
+	entity := &product.Product{}
+	connection := database.GetConnection(configs.Dialect, configs.DatabaseURI)
+	if err := connection.Table(entity.TableName()).AutoMigrate(entity); err != nil {
+		log.Fatal("Error on migrate table ", err)
+	}
+	port := fmt.Sprintf(":%v", configs.Port)
+	router := routes.NewRouter().SetRouters(adapter.NewAdapter(connection))
+	log.Println("service running on port ", port)
+	setupSwagger()
+	server := http.ListenAndServe(port, router)
+	log.Fatal(server)
+}
+
+func setupSwagger() {
+	configs := config.GetConfig()
+	// If your change host to Ex.: 192.168.1.0 is necessary change manually you field of search
+	// to your host too in your browser
+	docs.SwaggerInfo.Host = configs.SwaggerHost
+	docs.SwaggerInfo.BasePath = routes.BasePath
+	log.Println("swagger running on url: ", fmt.Sprintf("http://%s/swagger/index.html", docs.SwaggerInfo.Host))
+}
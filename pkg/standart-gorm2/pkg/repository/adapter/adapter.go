@@ -0,0 +1,146 @@
+package adapter
+
+import (
+	"context"
+	"github.com/wilian746/go-generator/pkg/standart-gorm2/pkg/repository/response"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+	"reflect"
+)
+
+var ErrRecordNotFound = gorm.ErrRecordNotFound
+
+type Database struct {
+	connection *gorm.DB
+	ctx        context.Context
+	logMode    bool
+}
+
+type Interface interface {
+	GetLogMode() bool
+	SetLogMode(logMode bool) Interface
+	WithContext(ctx context.Context) Interface
+
+	Connection(tableName string) *gorm.DB
+
+	ParseGormQueryToDefaultResponse(result *gorm.DB) *response.Response
+
+	Health() bool
+
+	StartTransaction() Interface
+	CommitTransaction() *response.Response
+	RollbackTransaction() *response.Response
+
+	Find(query *gorm.DB, entity interface{}, tableName string) *response.Response
+	Create(entity interface{}, tableName string) *response.Response
+	Upsert(entity interface{}, tableName string, conflictColumns ...string) *response.Response
+	Update(condition, entity interface{}, tableName string) *response.Response
+	Delete(condition interface{}, tableName string) *response.Response
+}
+
+func NewAdapter(connection *gorm.DB) Interface {
+	return &Database{
+		connection: connection,
+		ctx:        context.Background(),
+	}
+}
+
+func (d *Database) GetLogMode() bool {
+	return d.logMode
+}
+
+func (d *Database) SetLogMode(logMode bool) Interface {
+	d.logMode = logMode
+	return d
+}
+
+func (d *Database) WithContext(ctx context.Context) Interface {
+	return &Database{
+		connection: d.connection,
+		ctx:        ctx,
+		logMode:    d.logMode,
+	}
+}
+
+func (d *Database) Connection(tableName string) *gorm.DB {
+	return d.connection.Session(&gorm.Session{
+		NewDB:   true,
+		Context: d.ctx,
+		Logger:  d.getLogger(),
+	}).Table(tableName)
+}
+
+func (d *Database) getLogger() logger.Interface {
+	if d.logMode {
+		return logger.Default.LogMode(logger.Info)
+	}
+	return logger.Default.LogMode(logger.Silent)
+}
+
+func (d *Database) ParseGormQueryToDefaultResponse(query *gorm.DB) *response.Response {
+	return response.NewDefaultResponse(query)
+}
+
+func (d *Database) Health() bool {
+	connection, err := d.connection.DB()
+	if err != nil {
+		return false
+	}
+	return connection.PingContext(d.ctx) == nil
+}
+
+func (d *Database) StartTransaction() Interface {
+	return &Database{
+		connection: d.Connection("").Begin(),
+		ctx:        d.ctx,
+		logMode:    d.logMode,
+	}
+}
+
+func (d *Database) CommitTransaction() *response.Response {
+	return d.ParseGormQueryToDefaultResponse(d.connection.Commit())
+}
+
+func (d *Database) RollbackTransaction() *response.Response {
+	return d.ParseGormQueryToDefaultResponse(d.connection.Rollback())
+}
+
+func (d *Database) Find(query *gorm.DB, entity interface{}, tableName string) *response.Response {
+	if isSlice(entity) {
+		return d.ParseGormQueryToDefaultResponse(query.Table(tableName).Find(entity))
+	}
+	return d.ParseGormQueryToDefaultResponse(query.Table(tableName).Take(entity))
+}
+
+func (d *Database) Create(entity interface{}, tableName string) *response.Response {
+	queryCreate := d.Connection(tableName).Create(entity)
+	return d.ParseGormQueryToDefaultResponse(queryCreate)
+}
+
+func (d *Database) Upsert(entity interface{}, tableName string, conflictColumns ...string) *response.Response {
+	columns := make([]clause.Column, len(conflictColumns))
+	for index, name := range conflictColumns {
+		columns[index] = clause.Column{Name: name}
+	}
+	queryUpsert := d.Connection(tableName).Clauses(clause.OnConflict{Columns: columns, UpdateAll: true}).Create(entity)
+	return d.ParseGormQueryToDefaultResponse(queryUpsert)
+}
+
+func (d *Database) Update(condition, entity interface{}, tableName string) *response.Response {
+	queryUpdate := d.Connection(tableName).Where(condition).Updates(entity)
+	return d.ParseGormQueryToDefaultResponse(queryUpdate)
+}
+
+func (d *Database) Delete(condition interface{}, tableName string) *response.Response {
+	queryDelete := d.Connection(tableName).Where(condition).Delete(map[string]interface{}{})
+	return d.ParseGormQueryToDefaultResponse(queryDelete)
+}
+
+func isSlice(entity interface{}) bool {
+	value := reflect.ValueOf(entity)
+	for value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	return value.Kind() == reflect.Slice
+}
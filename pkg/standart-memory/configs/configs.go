@@ -0,0 +1,19 @@
+package config
+
+import "github.com/wilian746/go-generator/pkg/standart-memory/internal/utils/environment"
+
+type Config struct {
+	Port         int
+	Timeout      int
+	SnapshotPath string
+	SwaggerHost  string
+}
+
+func GetConfig() Config {
+	return Config{
+		Port:         environment.GetEnvAndParseToInt("PORT", 8080),
+		Timeout:      environment.GetEnvAndParseToInt("TIMEOUT", 30),
+		SnapshotPath: environment.GetEnvString("SNAPSHOT_PATH", ""),
+		SwaggerHost:  environment.GetEnvString("SWAGGER_HOST", "localhost:8080"),
+	}
+}
@@ -0,0 +1,60 @@
+package routes
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/pkg/standart-memory/pkg/repository/adapter"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serve(repository adapter.Interface, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	NewRouter().SetRouters(repository).ServeHTTP(w, r)
+	return w
+}
+
+func TestNewRouter(t *testing.T) {
+	t.Run("Should not return empty instance", func(t *testing.T) {
+		assert.NotNil(t, NewRouter())
+	})
+}
+
+func TestRouter_SetRouters(t *testing.T) {
+	t.Run("Should set routes and not return panics", func(t *testing.T) {
+		r := NewRouter()
+		assert.NotPanics(t, func() {
+			mock := &adapter.Mock{}
+			r.SetRouters(mock)
+		})
+	})
+	t.Run("Should return no content when call options of product", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodOptions, BasePath+"/product", nil))
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+	t.Run("Should return method not allowed when call product with method not registered", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodPatch, BasePath+"/product", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+	t.Run("Should return not found when route not exists", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodGet, BasePath+"/not-exists", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should send param of path to handler of product", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+			w := serve(&adapter.Mock{}, httptest.NewRequest(method, BasePath+"/product/wrong", nil))
+			assert.Equal(t, http.StatusBadRequest, w.Code, method)
+			assert.Contains(t, w.Body.String(), "ID is not uuid valid", method)
+		}
+	})
+	t.Run("Should pass request by middleware of cors", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodOptions, BasePath+"/product", nil)
+		r.Header.Set("Origin", "http://localhost:3000")
+		w := serve(&adapter.Mock{}, r)
+		assert.Equal(t, "Origin", w.Header().Get("Vary"))
+	})
+	t.Run("Should return ok when call swagger", func(t *testing.T) {
+		w := serve(&adapter.Mock{}, httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"github.com/wilian746/go-generator/pkg/standart-sql/configs"
+	"github.com/wilian746/go-generator/pkg/standart-sql/docs"
+	"github.com/wilian746/go-generator/pkg/standart-sql/internal/routes"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/adapter"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/database"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/migration"
+	"log"
+	"net/http"
+)
+
+// @title Standart SQL
+// @version 1.0
+// @description This is a sample server using standart database/sql server.
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name Standart SQL Support
+// @contact.url https://github.com/wilian746/go-generator/issues
+// @contact.email support@swagger.io
+
+// @license.name MIT
+// @license.url https://github.com/wilian746/go-generator/blob/master/LICENSE
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name Authorization
+func main() {
+	configs := config.GetConfig()
+	connection := database.GetConnection(configs.Dialect, configs.DatabaseURI)
+	if err := migration.Up(connection, configs.Dialect, configs.MigrationsPath); err != nil {
+		log.Fatal("Error on run migrations ", err)
+	}
+	port := fmt.Sprintf(":%v", configs.Port)
+	router := routes.NewRouter().SetRouters(adapter.NewAdapter(connection, configs.Dialect))
+	log.Println("service running on port ", port)
+	setupSwagger()
+	server := http.ListenAndServe(port, router)
+	log.Fatal(server)
+}
+
+func setupSwagger() {
+	configs := config.GetConfig()
+	// If your change host to Ex.: 192.168.1.0 is necessary change manually you field of search
+	// to your host too in your browser
+	docs.SwaggerInfo.Host = configs.SwaggerHost
+	docs.SwaggerInfo.BasePath = routes.BasePath
+	log.Println("swagger running on url: ", fmt.Sprintf("http://%s/swagger/index.html", docs.SwaggerInfo.Host))
+}
@@ -0,0 +1,205 @@
+package adapter
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/entities"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/query"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/response"
+	"log"
+	"reflect"
+)
+
+var ErrRecordNotFound = errors.New("record not found")
+var ErrEntityInvalid = errors.New("entity must implement entities.Interface or be a pointer to slice of it")
+var ErrTransactionNotStarted = errors.New("transaction not started")
+
+type executor interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+type Database struct {
+	connection  *sql.DB
+	transaction *sql.Tx
+	executor    executor
+	dialect     string
+	logMode     bool
+	err         error
+}
+
+type Interface interface {
+	GetLogMode() bool
+	SetLogMode(logMode bool) Interface
+
+	Connection(tableName string) *query.Query
+
+	Health() bool
+
+	StartTransaction() Interface
+	CommitTransaction() *response.Response
+	RollbackTransaction() *response.Response
+
+	Find(query *query.Query, entity interface{}) *response.Response
+	Create(entity entities.Interface) *response.Response
+	Update(condition, values map[string]interface{}, tableName string) *response.Response
+	Delete(condition map[string]interface{}, tableName string) *response.Response
+}
+
+func NewAdapter(connection *sql.DB, dialect string) Interface {
+	return &Database{
+		connection: connection,
+		executor:   connection,
+		dialect:    dialect,
+	}
+}
+
+func (d *Database) GetLogMode() bool {
+	return d.logMode
+}
+
+func (d *Database) SetLogMode(logMode bool) Interface {
+	d.logMode = logMode
+	return d
+}
+
+func (d *Database) Connection(tableName string) *query.Query {
+	return query.NewQuery(d.dialect, tableName)
+}
+
+func (d *Database) Health() bool {
+	return d.connection.Ping() == nil
+}
+
+func (d *Database) StartTransaction() Interface {
+	transaction, err := d.connection.Begin()
+	if err != nil {
+		return &Database{connection: d.connection, dialect: d.dialect, logMode: d.logMode, err: err}
+	}
+	return &Database{
+		connection:  d.connection,
+		transaction: transaction,
+		executor:    transaction,
+		dialect:     d.dialect,
+		logMode:     d.logMode,
+	}
+}
+
+func (d *Database) CommitTransaction() *response.Response {
+	if d.transaction == nil {
+		return response.NewDefaultResponse(d.getTransactionError(), 0)
+	}
+	return response.NewDefaultResponse(d.transaction.Commit(), 0)
+}
+
+func (d *Database) RollbackTransaction() *response.Response {
+	if d.transaction == nil {
+		return response.NewDefaultResponse(d.getTransactionError(), 0)
+	}
+	return response.NewDefaultResponse(d.transaction.Rollback(), 0)
+}
+
+func (d *Database) getTransactionError() error {
+	if d.err != nil {
+		return d.err
+	}
+	return ErrTransactionNotStarted
+}
+
+func (d *Database) Find(query *query.Query, entity interface{}) *response.Response {
+	if model, ok := entity.(entities.Interface); ok {
+		return d.findOne(query, model)
+	}
+	value := reflect.ValueOf(entity)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Slice {
+		return response.NewDefaultResponse(ErrEntityInvalid, 0)
+	}
+	return d.findAll(query, value.Elem())
+}
+
+func (d *Database) findOne(query *query.Query, entity entities.Interface) *response.Response {
+	if d.err != nil {
+		return response.NewDefaultResponse(d.err, 0)
+	}
+	statement, args := query.Limit(1).Select(entity.Columns())
+	d.log(statement, args)
+	err := d.executor.QueryRow(statement, args...).Scan(entity.Fields()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return response.NewDefaultResponse(ErrRecordNotFound, 0)
+	}
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	return response.NewDefaultResponse(nil, 1)
+}
+
+func (d *Database) findAll(query *query.Query, slice reflect.Value) *response.Response {
+	if d.err != nil {
+		return response.NewDefaultResponse(d.err, 0)
+	}
+	elementType := slice.Type().Elem()
+	isPointer := elementType.Kind() == reflect.Ptr
+	if isPointer {
+		elementType = elementType.Elem()
+	}
+	model, ok := reflect.New(elementType).Interface().(entities.Interface)
+	if !ok {
+		return response.NewDefaultResponse(ErrEntityInvalid, 0)
+	}
+	result := reflect.MakeSlice(slice.Type(), 0, 0)
+	slice.Set(result)
+	statement, args := query.Select(model.Columns())
+	d.log(statement, args)
+	rows, err := d.executor.Query(statement, args...)
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		element := reflect.New(elementType)
+		if err := rows.Scan(element.Interface().(entities.Interface).Fields()...); err != nil {
+			return response.NewDefaultResponse(err, 0)
+		}
+		if !isPointer {
+			element = element.Elem()
+		}
+		result = reflect.Append(result, element)
+	}
+	slice.Set(result)
+	return response.NewDefaultResponse(rows.Err(), int64(result.Len()))
+}
+
+func (d *Database) Create(entity entities.Interface) *response.Response {
+	statement, args := d.Connection(entity.TableName()).Insert(entity.Columns(), entity.Values())
+	return d.exec(statement, args)
+}
+
+func (d *Database) Update(condition, values map[string]interface{}, tableName string) *response.Response {
+	statement, args := d.Connection(tableName).Where(condition).Update(values)
+	return d.exec(statement, args)
+}
+
+func (d *Database) Delete(condition map[string]interface{}, tableName string) *response.Response {
+	statement, args := d.Connection(tableName).Where(condition).Delete()
+	return d.exec(statement, args)
+}
+
+func (d *Database) exec(statement string, args []interface{}) *response.Response {
+	if d.err != nil {
+		return response.NewDefaultResponse(d.err, 0)
+	}
+	d.log(statement, args)
+	result, err := d.executor.Exec(statement, args...)
+	if err != nil {
+		return response.NewDefaultResponse(err, 0)
+	}
+	rowsAffected, err := result.RowsAffected()
+	return response.NewDefaultResponse(err, rowsAffected)
+}
+
+func (d *Database) log(statement string, args []interface{}) {
+	if d.logMode {
+		log.Println(statement, args)
+	}
+}
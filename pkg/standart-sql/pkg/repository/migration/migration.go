@@ -0,0 +1,123 @@
+package migration
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/query"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrMigrationDirty = errors.New("database is dirty, fix the last migration and the table schema_migrations manually")
+var ErrMigrationNameInvalid = errors.New("migration name must start with a version number")
+
+const TableName = "schema_migrations"
+
+type Migration struct {
+	Version uint64
+	Path    string
+}
+
+// Up applies the migrations in pathMigrations/<dialect> not yet applied, keeping the table
+// schema_migrations compatible with golang-migrate
+func Up(connection *sql.DB, dialect, pathMigrations string) error {
+	if _, err := connection.Exec(createTableStatement(dialect)); err != nil {
+		return err
+	}
+	current, err := getCurrentVersion(connection)
+	if err != nil {
+		return err
+	}
+	migrations, err := GetMigrations(filepath.Join(pathMigrations, GetFolderName(dialect)))
+	if err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		if migration.Version <= current {
+			continue
+		}
+		if err := apply(connection, dialect, migration); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func GetFolderName(dialect string) string {
+	if dialect == "mssql" {
+		return "sqlserver"
+	}
+	return dialect
+}
+
+func GetMigrations(path string) (migrations []Migration, err error) {
+	paths, err := filepath.Glob(filepath.Join(path, "*.up.sql"))
+	if err != nil {
+		return nil, err
+	}
+	for _, pathMigration := range paths {
+		name := filepath.Base(pathMigration)
+		version, err := strconv.ParseUint(strings.SplitN(name, "_", 2)[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMigrationNameInvalid, name)
+		}
+		migrations = append(migrations, Migration{Version: version, Path: pathMigration})
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+func getCurrentVersion(connection *sql.DB) (uint64, error) {
+	var version uint64
+	var dirty bool
+	err := connection.QueryRow(fmt.Sprintf("SELECT version, dirty FROM %s", TableName)).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, fmt.Errorf("%w: version %d", ErrMigrationDirty, version)
+	}
+	return version, nil
+}
+
+func apply(connection *sql.DB, dialect string, migration Migration) error {
+	content, err := ioutil.ReadFile(migration.Path)
+	if err != nil {
+		return err
+	}
+	if err := setVersion(connection, dialect, migration.Version, true); err != nil {
+		return err
+	}
+	if _, err := connection.Exec(string(content)); err != nil {
+		return fmt.Errorf("error on apply migration %s: %w", filepath.Base(migration.Path), err)
+	}
+	return setVersion(connection, dialect, migration.Version, false)
+}
+
+func setVersion(connection *sql.DB, dialect string, version uint64, dirty bool) error {
+	if _, err := connection.Exec(fmt.Sprintf("DELETE FROM %s", TableName)); err != nil {
+		return err
+	}
+	statement := fmt.Sprintf("INSERT INTO %s (version, dirty) VALUES (%s, %s)",
+		TableName, query.Placeholder(dialect, 1), query.Placeholder(dialect, 2))
+	_, err := connection.Exec(statement, int64(version), dirty)
+	return err
+}
+
+func createTableStatement(dialect string) string {
+	switch dialect {
+	case "mssql":
+		return fmt.Sprintf("IF OBJECT_ID('%[1]s', 'U') IS NULL CREATE TABLE %[1]s (version BIGINT NOT NULL PRIMARY KEY, dirty BIT NOT NULL)", TableName)
+	default:
+		return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)", TableName)
+	}
+}
@@ -0,0 +1,67 @@
+package migration
+
+import (
+	goErrors "errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/wilian746/go-generator/pkg/standart-sql/pkg/repository/database"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUp(t *testing.T) {
+	t.Run("Should apply migrations of sqlite3 and save version", func(t *testing.T) {
+		connection := database.GetConnection("sqlite3", ":memory:")
+		assert.NoError(t, Up(connection, "sqlite3", "../../../migrations"))
+		var version int64
+		assert.NoError(t, connection.QueryRow("SELECT version FROM schema_migrations").Scan(&version))
+		assert.Equal(t, int64(20200607175350), version)
+		_, err := connection.Exec("SELECT id, name, created_at, updated_at FROM products")
+		assert.NoError(t, err)
+	})
+	t.Run("Should not apply migrations already applied", func(t *testing.T) {
+		connection := database.GetConnection("sqlite3", ":memory:")
+		assert.NoError(t, Up(connection, "sqlite3", "../../../migrations"))
+		assert.NoError(t, Up(connection, "sqlite3", "../../../migrations"))
+	})
+	t.Run("Should return error and keep database dirty when migration is invalid", func(t *testing.T) {
+		path, err := ioutil.TempDir("", "migrations")
+		assert.NoError(t, err)
+		defer os.RemoveAll(path)
+		assert.NoError(t, os.MkdirAll(filepath.Join(path, "sqlite3"), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "sqlite3", "1_invalid.up.sql"), []byte("INVALID SQL"), 0644))
+		connection := database.GetConnection("sqlite3", ":memory:")
+		assert.Error(t, Up(connection, "sqlite3", path))
+		err = Up(connection, "sqlite3", path)
+		assert.True(t, goErrors.Is(err, ErrMigrationDirty))
+	})
+}
+
+func TestGetMigrations(t *testing.T) {
+	t.Run("Should return error when name not start with version", func(t *testing.T) {
+		path, err := ioutil.TempDir("", "migrations")
+		assert.NoError(t, err)
+		defer os.RemoveAll(path)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "create_table.up.sql"), []byte(""), 0644))
+		_, err = GetMigrations(path)
+		assert.True(t, goErrors.Is(err, ErrMigrationNameInvalid))
+	})
+	t.Run("Should return migrations sorted by version", func(t *testing.T) {
+		path, err := ioutil.TempDir("", "migrations")
+		assert.NoError(t, err)
+		defer os.RemoveAll(path)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "10_second.up.sql"), []byte(""), 0644))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "9_first.up.sql"), []byte(""), 0644))
+		migrations, err := GetMigrations(path)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{9, 10}, []uint64{migrations[0].Version, migrations[1].Version})
+	})
+}
+
+func TestGetFolderName(t *testing.T) {
+	t.Run("Should return sqlserver when dialect is mssql", func(t *testing.T) {
+		assert.Equal(t, "sqlserver", GetFolderName("mssql"))
+		assert.Equal(t, "postgres", GetFolderName("postgres"))
+	})
+}
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Query struct {
+	dialect   string
+	tableName string
+	columns   []string
+	args      []interface{}
+	limit     int
+}
+
+func NewQuery(dialect, tableName string) *Query {
+	return &Query{
+		dialect:   dialect,
+		tableName: tableName,
+	}
+}
+
+func (q *Query) TableName() string {
+	return q.tableName
+}
+
+func (q *Query) Where(condition map[string]interface{}) *Query {
+	for _, column := range sortedKeys(condition) {
+		q.columns = append(q.columns, column)
+		q.args = append(q.args, condition[column])
+	}
+	return q
+}
+
+func (q *Query) Limit(limit int) *Query {
+	q.limit = limit
+	return q
+}
+
+func (q *Query) Select(columns []string) (string, []interface{}) {
+	statement := fmt.Sprintf("SELECT %s FROM %s%s", strings.Join(columns, ", "), q.tableName, q.where(0))
+	if q.limit > 0 {
+		statement += q.limitClause()
+	}
+	return statement, q.args
+}
+
+func (q *Query) Insert(columns []string, values []interface{}) (string, []interface{}) {
+	placeholders := make([]string, len(columns))
+	for index := range columns {
+		placeholders[index] = Placeholder(q.dialect, index+1)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		q.tableName, strings.Join(columns, ", "), strings.Join(placeholders, ", ")), values
+}
+
+func (q *Query) Update(values map[string]interface{}) (string, []interface{}) {
+	sets := []string{}
+	args := []interface{}{}
+	for _, column := range sortedKeys(values) {
+		args = append(args, values[column])
+		sets = append(sets, fmt.Sprintf("%s = %s", column, Placeholder(q.dialect, len(args))))
+	}
+	statement := fmt.Sprintf("UPDATE %s SET %s%s", q.tableName, strings.Join(sets, ", "), q.where(len(args)))
+	return statement, append(args, q.args...)
+}
+
+func (q *Query) Delete() (string, []interface{}) {
+	return fmt.Sprintf("DELETE FROM %s%s", q.tableName, q.where(0)), q.args
+}
+
+func (q *Query) where(offset int) string {
+	if len(q.columns) == 0 {
+		return ""
+	}
+	conditions := make([]string, len(q.columns))
+	for index, column := range q.columns {
+		conditions[index] = fmt.Sprintf("%s = %s", column, Placeholder(q.dialect, offset+index+1))
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+func (q *Query) limitClause() string {
+	if q.dialect == "mssql" {
+		return fmt.Sprintf(" ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT %d ROWS ONLY", q.limit)
+	}
+	return fmt.Sprintf(" LIMIT %d", q.limit)
+}
+
+func Placeholder(dialect string, index int) string {
+	switch dialect {
+	case "postgres":
+		return fmt.Sprintf("$%d", index)
+	case "mssql":
+		return fmt.Sprintf("@p%d", index)
+	default:
+		return "?"
+	}
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}